@@ -0,0 +1,337 @@
+// Package anonymize removes private data from FIT files before they are
+// shared: positions within privacy zones, such as around home, and
+// optionally serial numbers and personal fields of the user profile.
+package anonymize
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/internal/activity"
+)
+
+// Zone is a circular privacy zone.
+type Zone struct {
+	Center fit.Position
+	Radius float64 // meters
+}
+
+// Contains reports whether p is within the zone.
+func (z Zone) Contains(p fit.Position) bool {
+	return !p.Invalid() && z.Center.Distance(p) <= z.Radius
+}
+
+type options struct {
+	zones       []Zone
+	obfuscate   bool
+	serials     bool
+	userProfile bool
+}
+
+// Option configures the anonymizer.
+type Option func(*options)
+
+// WithZones adds privacy zones. Positions within the zones are removed.
+func WithZones(zones ...Zone) Option {
+	return func(o *options) {
+		o.zones = append(o.zones, zones...)
+	}
+}
+
+// WithObfuscation configures the anonymizer to move record positions within
+// a privacy zone to where the track enters or leaves the zone, instead of
+// removing them. The track then appears to start or end at the edge of the
+// zone. Other positions within a zone, such as the start position of a lap,
+// are always removed.
+func WithObfuscation() Option {
+	return func(o *options) {
+		o.obfuscate = true
+	}
+}
+
+// WithSerialNumbers configures the anonymizer to clear the serial number of
+// the file id message, and the serial numbers and ANT device numbers of
+// device info messages.
+func WithSerialNumbers() Option {
+	return func(o *options) {
+		o.serials = true
+	}
+}
+
+// WithUserProfile configures the anonymizer to clear the personal fields of
+// user profile messages: name, gender, age, height, weight, heart rates,
+// step lengths and user ids. Display settings are kept.
+func WithUserProfile() Option {
+	return func(o *options) {
+		o.userProfile = true
+	}
+}
+
+// Result describes the changes made by Anonymize.
+type Result struct {
+	// PositionsRemoved and PositionsMoved are the number of positions
+	// removed and moved because they were within a privacy zone.
+	PositionsRemoved int
+	PositionsMoved   int
+}
+
+// Anonymize removes private data from file in place, as configured by the
+// options. If record positions are removed or moved, the bounding boxes of
+// sessions and segment laps are recomputed from the remaining positions.
+// The file can be encoded afterwards as usual. An error is returned
+// if a zone has an invalid center or a negative radius.
+func Anonymize(file *fit.File, opts ...Option) (Result, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	for _, z := range o.zones {
+		if z.Center.Invalid() || z.Radius < 0 {
+			return Result{}, errors.New("invalid privacy zone")
+		}
+	}
+
+	var (
+		res     Result
+		records []*fit.RecordMsg
+		boxes   []boundingBox
+	)
+	for _, msg := range file.Messages() {
+		switch m := msg.(type) {
+		case *fit.RecordMsg:
+			records = append(records, m)
+			continue
+		case *fit.FileIdMsg:
+			if o.serials {
+				m.SerialNumber = fit.NewFileIdMsg().SerialNumber
+			}
+		case *fit.DeviceInfoMsg:
+			if o.serials {
+				clean := fit.NewDeviceInfoMsg()
+				m.SerialNumber = clean.SerialNumber
+				m.AntDeviceNumber = clean.AntDeviceNumber
+			}
+		case *fit.UserProfileMsg:
+			if o.userProfile {
+				clearUserProfile(m)
+			}
+		case *fit.SessionMsg:
+			boxes = append(boxes, newBoundingBox(&m.NecLat, &m.NecLong, &m.SwcLat, &m.SwcLong, m.StartTime, activity.SessionEnd(m)))
+		case *fit.SegmentLapMsg:
+			boxes = append(boxes, newBoundingBox(&m.NecLat, &m.NecLong, &m.SwcLat, &m.SwcLong, m.StartTime, activity.SegmentLapEnd(m)))
+		}
+		res.PositionsRemoved += o.removePositions(msg)
+	}
+	before := res
+	o.anonymizeRecords(records, &res)
+	for _, b := range boxes {
+		o.updateBoundingBox(b, records, res != before)
+	}
+	return res, nil
+}
+
+// boundingBox is the bounding box of a session or segment lap, given by its
+// north east and south west corner fields.
+type boundingBox struct {
+	necLat, swcLat   *fit.Latitude
+	necLong, swcLong *fit.Longitude
+	start, end       time.Time
+
+	// valid reports whether the box was valid before anonymizing.
+	valid bool
+}
+
+func newBoundingBox(necLat *fit.Latitude, necLong *fit.Longitude, swcLat *fit.Latitude, swcLong *fit.Longitude, start, end time.Time) boundingBox {
+	return boundingBox{
+		necLat:  necLat,
+		necLong: necLong,
+		swcLat:  swcLat,
+		swcLong: swcLong,
+		start:   start,
+		end:     end,
+		valid:   !necLat.Invalid() && !necLong.Invalid() && !swcLat.Invalid() && !swcLong.Invalid(),
+	}
+}
+
+// updateBoundingBox updates the bounding box b after the positions of the
+// records have been anonymized. If record positions were changed, the box is
+// recomputed from the valid positions of the records from the start to the
+// end of the box, so that it does not reveal removed positions. The box is
+// cleared if it has no such positions, or if a corner is within a privacy
+// zone.
+func (o *options) updateBoundingBox(b boundingBox, records []*fit.RecordMsg, changed bool) {
+	if len(o.zones) == 0 {
+		return
+	}
+	ne := fit.Position{Lat: *b.necLat, Long: *b.necLong}
+	sw := fit.Position{Lat: *b.swcLat, Long: *b.swcLong}
+	if b.valid && changed {
+		ne, sw = recordsBoundingBox(records, b.start, b.end)
+	}
+	if ne.Invalid() || sw.Invalid() || o.inZone(ne) || o.inZone(sw) {
+		ne = fit.Position{Lat: fit.NewLatitudeInvalid(), Long: fit.NewLongitudeInvalid()}
+		sw = ne
+	}
+	*b.necLat, *b.necLong = ne.Lat, ne.Long
+	*b.swcLat, *b.swcLong = sw.Lat, sw.Long
+}
+
+// recordsBoundingBox returns the north east and south west corners of the
+// bounding box of the valid positions of the records from start to end, or
+// invalid positions if there are none.
+func recordsBoundingBox(records []*fit.RecordMsg, start, end time.Time) (fit.Position, fit.Position) {
+	ne := fit.Position{Lat: fit.NewLatitudeInvalid(), Long: fit.NewLongitudeInvalid()}
+	sw := ne
+	for _, r := range records {
+		if invalid(r) || r.Timestamp.Before(start) || r.Timestamp.After(end) {
+			continue
+		}
+		if ne.Invalid() {
+			ne = fit.Position{Lat: r.PositionLat, Long: r.PositionLong}
+			sw = ne
+			continue
+		}
+		if r.PositionLat.Semicircles() > ne.Lat.Semicircles() {
+			ne.Lat = r.PositionLat
+		}
+		if r.PositionLong.Semicircles() > ne.Long.Semicircles() {
+			ne.Long = r.PositionLong
+		}
+		if r.PositionLat.Semicircles() < sw.Lat.Semicircles() {
+			sw.Lat = r.PositionLat
+		}
+		if r.PositionLong.Semicircles() < sw.Long.Semicircles() {
+			sw.Long = r.PositionLong
+		}
+	}
+	return ne, sw
+}
+
+func (o *options) inZone(p fit.Position) bool {
+	for _, z := range o.zones {
+		if z.Contains(p) {
+			return true
+		}
+	}
+	return false
+}
+
+// anonymizeRecords removes or moves the positions of records within a
+// privacy zone. Records are moved to the closest position in time outside
+// of all zones.
+func (o *options) anonymizeRecords(records []*fit.RecordMsg, res *Result) {
+	inside := make([]bool, len(records))
+	for i, r := range records {
+		inside[i] = o.inZone(fit.Position{Lat: r.PositionLat, Long: r.PositionLong})
+	}
+
+	// For each record, the index of the last record outside the zones
+	// before it and the first after it.
+	before := make([]int, len(records))
+	after := make([]int, len(records))
+	last := -1
+	for i := range records {
+		before[i] = last
+		if !inside[i] && !invalid(records[i]) {
+			last = i
+		}
+	}
+	last = -1
+	for i := len(records) - 1; i >= 0; i-- {
+		after[i] = last
+		if !inside[i] && !invalid(records[i]) {
+			last = i
+		}
+	}
+
+	for i, r := range records {
+		if !inside[i] {
+			continue
+		}
+		j := -1
+		if o.obfuscate {
+			switch {
+			case before[i] < 0:
+				j = after[i]
+			case after[i] < 0:
+				j = before[i]
+			case r.Timestamp.Sub(records[before[i]].Timestamp) <= records[after[i]].Timestamp.Sub(r.Timestamp):
+				j = before[i]
+			default:
+				j = after[i]
+			}
+		}
+		if j < 0 {
+			r.PositionLat, r.PositionLong = fit.NewLatitudeInvalid(), fit.NewLongitudeInvalid()
+			res.PositionsRemoved++
+			continue
+		}
+		r.PositionLat, r.PositionLong = records[j].PositionLat, records[j].PositionLong
+		res.PositionsMoved++
+	}
+}
+
+func invalid(r *fit.RecordMsg) bool {
+	return r.PositionLat.Invalid() || r.PositionLong.Invalid()
+}
+
+var (
+	latitudeType  = reflect.TypeOf(fit.Latitude{})
+	longitudeType = reflect.TypeOf(fit.Longitude{})
+)
+
+// removePositions invalidates all positions of msg within a privacy zone,
+// e.g. the start and end positions of laps and sessions. A position is a
+// pair of latitude and longitude fields named with the same prefix and the
+// suffixes Lat and Long.
+func (o *options) removePositions(msg interface{}) int {
+	if len(o.zones) == 0 {
+		return 0
+	}
+	v := reflect.ValueOf(msg).Elem()
+	t := v.Type()
+	n := 0
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Type != latitudeType || !strings.HasSuffix(f.Name, "Lat") {
+			continue
+		}
+		longField := v.FieldByName(strings.TrimSuffix(f.Name, "Lat") + "Long")
+		if !longField.IsValid() || longField.Type() != longitudeType {
+			continue
+		}
+		lat := v.Field(i)
+		p := fit.Position{
+			Lat:  lat.Interface().(fit.Latitude),
+			Long: longField.Interface().(fit.Longitude),
+		}
+		if !o.inZone(p) {
+			continue
+		}
+		lat.Set(reflect.ValueOf(fit.NewLatitudeInvalid()))
+		longField.Set(reflect.ValueOf(fit.NewLongitudeInvalid()))
+		n++
+	}
+	return n
+}
+
+// clearUserProfile sets the personal fields of p to invalid values.
+func clearUserProfile(p *fit.UserProfileMsg) {
+	clean := fit.NewUserProfileMsg()
+	p.FriendlyName = clean.FriendlyName
+	p.Gender = clean.Gender
+	p.Age = clean.Age
+	p.Height = clean.Height
+	p.Weight = clean.Weight
+	p.RestingHeartRate = clean.RestingHeartRate
+	p.DefaultMaxRunningHeartRate = clean.DefaultMaxRunningHeartRate
+	p.DefaultMaxBikingHeartRate = clean.DefaultMaxBikingHeartRate
+	p.DefaultMaxHeartRate = clean.DefaultMaxHeartRate
+	p.LocalId = clean.LocalId
+	p.GlobalId = clean.GlobalId
+	p.UserRunningStepLength = clean.UserRunningStepLength
+	p.UserWalkingStepLength = clean.UserWalkingStepLength
+}
@@ -0,0 +1,257 @@
+package anonymize_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/anonymize"
+)
+
+func decodeEdge810(t *testing.T) *fit.File {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("..", "testdata", "dcrainmaker", "Edge810-Vector-2013-08-16-15-35-10.fit"))
+	if err != nil {
+		t.Fatalf("reading file failed: %v", err)
+	}
+	file, err := fit.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("decode: got error, want none; error is: %v", err)
+	}
+	return file
+}
+
+func TestAnonymize(t *testing.T) {
+	tests := []struct {
+		name      string
+		obfuscate bool
+	}{
+		{"remove", false},
+		{"obfuscate", true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			file := decodeEdge810(t)
+			activity, err := file.Activity()
+			if err != nil {
+				t.Fatalf("activity: got error, want none; error is: %v", err)
+			}
+
+			// The activity starts and ends close to home.
+			first := activity.Records[0]
+			home := anonymize.Zone{
+				Center: fit.Position{Lat: first.PositionLat, Long: first.PositionLong},
+				Radius: 500,
+			}
+			opts := []anonymize.Option{anonymize.WithZones(home), anonymize.WithSerialNumbers()}
+			if test.obfuscate {
+				opts = append(opts, anonymize.WithObfuscation())
+			}
+			res, err := anonymize.Anonymize(file, opts...)
+			if err != nil {
+				t.Fatalf("got error, want none; error is: %v", err)
+			}
+			// Only the start and end positions of laps and the session are
+			// removed when obfuscating.
+			if test.obfuscate && (res.PositionsMoved == 0 || res.PositionsRemoved == 0 || res.PositionsRemoved > 2*len(activity.Laps)+2) {
+				t.Errorf("got %+v, want moved positions and removed lap and session positions", res)
+			}
+			if !test.obfuscate && (res.PositionsMoved != 0 || res.PositionsRemoved < 10) {
+				t.Errorf("got %+v, want removed positions", res)
+			}
+
+			var buf bytes.Buffer
+			if err := fit.Encode(&buf, file, binary.LittleEndian); err != nil {
+				t.Fatalf("encode: got error, want none; error is: %v", err)
+			}
+			decoded, err := fit.Decode(&buf)
+			if err != nil {
+				t.Fatalf("decode: got error, want none; error is: %v", err)
+			}
+			if decoded.FileId.SerialNumber != fit.NewFileIdMsg().SerialNumber {
+				t.Errorf("file id serial number: got %d, want invalid", decoded.FileId.SerialNumber)
+			}
+			for _, msg := range decoded.Messages() {
+				if di, ok := msg.(*fit.DeviceInfoMsg); ok && di.SerialNumber != fit.NewDeviceInfoMsg().SerialNumber {
+					t.Errorf("device info serial number: got %d, want invalid", di.SerialNumber)
+				}
+			}
+
+			a, err := decoded.Activity()
+			if err != nil {
+				t.Fatalf("activity: got error, want none; error is: %v", err)
+			}
+			if len(a.Records) != len(activity.Records) {
+				t.Fatalf("got %d records, want %d", len(a.Records), len(activity.Records))
+			}
+			valid := 0
+			for _, r := range a.Records {
+				p := fit.Position{Lat: r.PositionLat, Long: r.PositionLong}
+				if p.Invalid() {
+					continue
+				}
+				valid++
+				if d := home.Center.Distance(p); d < home.Radius {
+					t.Fatalf("record at %v: position %v m from home", r.Timestamp, d)
+				}
+			}
+			if test.obfuscate && valid != len(a.Records) {
+				t.Errorf("got %d of %d records with position, want all", valid, len(a.Records))
+			}
+			s := a.Sessions[0]
+			if !s.StartPositionLat.Invalid() || !s.StartPositionLong.Invalid() {
+				t.Errorf("session start position: got %v, %v, want invalid", s.StartPositionLat, s.StartPositionLong)
+			}
+			if s.NecLat.Invalid() || s.NecLong.Invalid() {
+				t.Errorf("session north east corner outside zone removed")
+			}
+
+			// The bounding box is that of the remaining positions.
+			ne := [2]int32{math.MinInt32, math.MinInt32}
+			sw := [2]int32{math.MaxInt32, math.MaxInt32}
+			for _, r := range a.Records {
+				if r.PositionLat.Invalid() || r.PositionLong.Invalid() {
+					continue
+				}
+				lat, long := r.PositionLat.Semicircles(), r.PositionLong.Semicircles()
+				if lat > ne[0] {
+					ne[0] = lat
+				}
+				if long > ne[1] {
+					ne[1] = long
+				}
+				if lat < sw[0] {
+					sw[0] = lat
+				}
+				if long < sw[1] {
+					sw[1] = long
+				}
+			}
+			got := [4]int32{s.NecLat.Semicircles(), s.NecLong.Semicircles(), s.SwcLat.Semicircles(), s.SwcLong.Semicircles()}
+			if want := [4]int32{ne[0], ne[1], sw[0], sw[1]}; got != want {
+				t.Errorf("session bounding box: got %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestAnonymizeUserProfile(t *testing.T) {
+	file, err := fit.NewFile(fit.FileTypeActivity, fit.NewHeader(fit.V20, true))
+	if err != nil {
+		t.Fatalf("new file: got error, want none; error is: %v", err)
+	}
+	profile := fit.NewUserProfileMsg()
+	profile.FriendlyName = "Jane"
+	profile.Age = 42
+	profile.Weight = 650
+	profile.GlobalId = []byte{1, 2, 3, 4, 5, 6}
+	profile.DistSetting = fit.DisplayMeasureMetric
+	if err := file.Add(profile); err != nil {
+		t.Fatalf("add: got error, want none; error is: %v", err)
+	}
+
+	if _, err := anonymize.Anonymize(file, anonymize.WithUserProfile()); err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	activity, err := file.Activity()
+	if err != nil {
+		t.Fatalf("activity: got error, want none; error is: %v", err)
+	}
+	profile = activity.UserProfile
+	if profile.FriendlyName != "" || profile.Age != 0xFF || profile.Weight != 0xFFFF || profile.GlobalId != nil {
+		t.Errorf("got personal fields %+v, want invalid", profile)
+	}
+	if profile.DistSetting != fit.DisplayMeasureMetric {
+		t.Errorf("display setting: got %v, want kept", profile.DistSetting)
+	}
+
+	zone := anonymize.Zone{Center: fit.Position{Lat: fit.NewLatitudeInvalid(), Long: fit.NewLongitudeInvalid()}, Radius: 100}
+	if _, err := anonymize.Anonymize(file, anonymize.WithZones(zone)); err == nil {
+		t.Errorf("invalid zone: got no error, want one")
+	}
+}
+
+func TestAnonymizeBoundingBox(t *testing.T) {
+	file, err := fit.NewFile(fit.FileTypeActivity, fit.NewHeader(fit.V20, true))
+	if err != nil {
+		t.Fatalf("new file: got error, want none; error is: %v", err)
+	}
+	start := time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)
+	home := fit.NewPositionDegrees(59.9, 10.7)
+	position := func(i int) fit.Position {
+		return fit.NewPositionDegrees(59.9+float64(i)*0.001, 10.7+float64(i)*0.001)
+	}
+
+	// A track leaving home towards the north east, with a session and a
+	// segment lap for the whole track, and a segment lap for the first
+	// second within the privacy zone.
+	var msgs []interface{}
+	for i := 0; i < 20; i++ {
+		r := fit.NewRecordMsg()
+		r.Timestamp = start.Add(time.Duration(i) * time.Second)
+		p := position(i)
+		r.PositionLat, r.PositionLong = p.Lat, p.Long
+		msgs = append(msgs, r)
+	}
+	end := position(19)
+	session := fit.NewSessionMsg()
+	session.StartTime = start
+	session.TotalElapsedTime = 19000
+	session.NecLat, session.NecLong = end.Lat, end.Long
+	session.SwcLat, session.SwcLong = home.Lat, home.Long
+	msgs = append(msgs, session)
+	for _, elapsed := range []uint32{19000, 1000} {
+		sl := fit.NewSegmentLapMsg()
+		sl.StartTime = start
+		sl.TotalElapsedTime = elapsed
+		ne := position(int(elapsed / 1000))
+		sl.NecLat, sl.NecLong = ne.Lat, ne.Long
+		sl.SwcLat, sl.SwcLong = home.Lat, home.Long
+		msgs = append(msgs, sl)
+	}
+	for _, msg := range msgs {
+		if err := file.Add(msg); err != nil {
+			t.Fatalf("add: got error, want none; error is: %v", err)
+		}
+	}
+
+	zone := anonymize.Zone{Center: home, Radius: 300}
+	res, err := anonymize.Anonymize(file, anonymize.WithZones(zone))
+	if err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	a, err := file.Activity()
+	if err != nil {
+		t.Fatalf("activity: got error, want none; error is: %v", err)
+	}
+	removed := 0
+	for _, r := range a.Records {
+		if r.PositionLat.Invalid() {
+			removed++
+		}
+	}
+	if removed == 0 || removed > 5 {
+		t.Fatalf("got %d removed record positions, result %+v", removed, res)
+	}
+
+	// The south west corner is the first remaining position.
+	sw := position(removed)
+	for i, box := range [][4]fit.Position{
+		{{Lat: a.Sessions[0].NecLat, Long: a.Sessions[0].NecLong}, {Lat: a.Sessions[0].SwcLat, Long: a.Sessions[0].SwcLong}, end, sw},
+		{{Lat: a.SegmentLaps[0].NecLat, Long: a.SegmentLaps[0].NecLong}, {Lat: a.SegmentLaps[0].SwcLat, Long: a.SegmentLaps[0].SwcLong}, end, sw},
+	} {
+		if box[0] != box[2] || box[1] != box[3] {
+			t.Errorf("bounding box %d: got %v - %v, want %v - %v", i, box[1], box[0], box[3], box[2])
+		}
+	}
+
+	// A bounding box without remaining positions is cleared.
+	if sl := a.SegmentLaps[1]; !sl.NecLat.Invalid() || !sl.NecLong.Invalid() || !sl.SwcLat.Invalid() || !sl.SwcLong.Invalid() {
+		t.Errorf("bounding box within zone: got %v, %v - %v, %v, want invalid", sl.SwcLat, sl.SwcLong, sl.NecLat, sl.NecLong)
+	}
+}
@@ -0,0 +1,247 @@
+// Package catalog builds catalogs of directories of FIT files, e.g. years of
+// activities from several devices, with the file id and a summary of every
+// file.
+//
+// Catalogs are updated incrementally: files with the same path, size and
+// modification time as in the previous catalog are not read, and files with
+// the same contents as a file in the previous catalog, e.g. moved files, are
+// not decoded.
+package catalog
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/internal/field"
+)
+
+// Entry describes a FIT file. Values not present in the file are zero.
+type Entry struct {
+	// Path is the slash separated path of the file, relative to the
+	// cataloged directory. Size, ModTime and Hash, the hex encoded SHA-256
+	// hash of the contents, are used to detect changed files.
+	Path    string    `json:"path"`
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"mod_time"`
+	Hash    string    `json:"hash"`
+
+	// File id values. Manufacturer and product are names if known, e.g.
+	// "Garmin" and "Edge810", or else numbers.
+	FileType     string    `json:"file_type"`
+	Manufacturer string    `json:"manufacturer"`
+	Product      string    `json:"product"`
+	SerialNumber uint32    `json:"serial_number"`
+	TimeCreated  time.Time `json:"time_created"`
+
+	// Summary of the sessions of activity files. Sport is the sport of
+	// the sessions, or "Multisport" if they differ. Duration is the total
+	// elapsed time in seconds and Distance the total distance in meters.
+	Sport     string    `json:"sport,omitempty"`
+	StartTime time.Time `json:"start_time"`
+	Duration  float64   `json:"duration,omitempty"`
+	Distance  float64   `json:"distance,omitempty"`
+	Bounds    *Bounds   `json:"bounds,omitempty"`
+
+	// Error is the error decoding the file, if any. Values decoded before
+	// the error are set.
+	Error string `json:"error,omitempty"`
+}
+
+// Bounds is a bounding box in degrees.
+type Bounds struct {
+	South float64 `json:"south"`
+	West  float64 `json:"west"`
+	North float64 `json:"north"`
+	East  float64 `json:"east"`
+}
+
+// Catalog is a catalog of the FIT files in a directory.
+type Catalog struct {
+	// Entries are sorted by path.
+	Entries []Entry `json:"entries"`
+}
+
+// ReadJSON reads a catalog written by WriteJSON.
+func ReadJSON(r io.Reader) (*Catalog, error) {
+	var c Catalog
+	if err := json.NewDecoder(r).Decode(&c); err != nil {
+		return nil, fmt.Errorf("decoding catalog: %w", err)
+	}
+	return &c, nil
+}
+
+// WriteJSON writes the catalog as JSON.
+func (c *Catalog) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "\t")
+	return enc.Encode(c)
+}
+
+// Stats are the number of files handled in each way by Update.
+type Stats struct {
+	// Unchanged files had the same path, size and modification time as
+	// in the catalog, and were not read.
+	Unchanged int
+
+	// Reused files had the same contents as a file in the catalog, and
+	// were not decoded.
+	Reused int
+
+	// Indexed files were decoded.
+	Indexed int
+
+	// Removed files were in the catalog, but not found.
+	Removed int
+}
+
+// Update updates the catalog with the FIT files in the directory tree rooted
+// at root, which are the files with the extension .fit, in any case. Entries
+// for files no longer found are removed.
+//
+// Errors decoding files are recorded in their entries. An error is returned
+// if the directory can not be walked or a file can not be read.
+func (c *Catalog) Update(root string) (Stats, error) {
+	var stats Stats
+	byPath := make(map[string]Entry, len(c.Entries))
+	byHash := make(map[string]Entry, len(c.Entries))
+	for _, e := range c.Entries {
+		byPath[e.Path] = e
+		byHash[e.Hash] = e
+	}
+
+	var entries []Entry
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !strings.EqualFold(filepath.Ext(path), ".fit") {
+			return nil
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		if prev, found := byPath[rel]; found && prev.Size == info.Size() && prev.ModTime.Equal(info.ModTime()) {
+			entries = append(entries, prev)
+			stats.Unchanged++
+			return nil
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		sum := sha256.Sum256(data)
+		hash := hex.EncodeToString(sum[:])
+		e, found := byHash[hash]
+		if found {
+			stats.Reused++
+		} else {
+			e = Index(data)
+			stats.Indexed++
+		}
+		e.Path, e.Size, e.ModTime, e.Hash = rel, info.Size(), info.ModTime(), hash
+		entries = append(entries, e)
+		return nil
+	})
+	if err != nil {
+		return stats, err
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Path < entries[j].Path })
+	found := make(map[string]bool, len(entries))
+	for _, e := range entries {
+		found[e.Path] = true
+	}
+	for _, e := range c.Entries {
+		if !found[e.Path] {
+			stats.Removed++
+		}
+	}
+	c.Entries = entries
+	return stats, nil
+}
+
+// Index returns the entry for the FIT file data, with the file id and the
+// summary of the sessions of activity files set. The header and file id are
+// decoded first, and only session messages of the rest of the file.
+func Index(data []byte) Entry {
+	var e Entry
+	_, fileID, err := fit.DecodeHeaderAndFileID(bytes.NewReader(data))
+	if err != nil {
+		e.Error = err.Error()
+		return e
+	}
+	e.FileType = fileID.Type.String()
+	e.Manufacturer = fileID.Manufacturer.String()
+	e.Product = fmt.Sprint(fileID.GetProduct())
+	if fileID.SerialNumber != 0xFFFFFFFF {
+		e.SerialNumber = fileID.SerialNumber
+	}
+	if field.ValidTime(fileID.TimeCreated) {
+		e.TimeCreated = fileID.TimeCreated
+	}
+	if fileID.Type != fit.FileTypeActivity {
+		return e
+	}
+
+	file, err := fit.Decode(bytes.NewReader(data), fit.WithMessages(fit.MesgNumSession))
+	if err != nil {
+		e.Error = err.Error()
+	}
+	if file == nil {
+		return e
+	}
+	a, aerr := file.Activity()
+	if aerr != nil {
+		return e
+	}
+	summarize(&e, a.Sessions)
+	return e
+}
+
+func summarize(e *Entry, sessions []*fit.SessionMsg) {
+	var corners []fit.Position
+	for i, s := range sessions {
+		sport := s.Sport.String()
+		switch {
+		case i == 0:
+			e.Sport = sport
+		case sport != e.Sport:
+			e.Sport = fit.SportMultisport.String()
+		}
+		if field.ValidTime(s.StartTime) && (e.StartTime.IsZero() || s.StartTime.Before(e.StartTime)) {
+			e.StartTime = s.StartTime
+		}
+		if s.TotalElapsedTime != 0xFFFFFFFF {
+			e.Duration += s.GetTotalElapsedTimeScaled()
+		}
+		if s.TotalDistance != 0xFFFFFFFF {
+			e.Distance += s.GetTotalDistanceScaled()
+		}
+		corners = append(corners,
+			fit.Position{Lat: s.NecLat, Long: s.NecLong},
+			fit.Position{Lat: s.SwcLat, Long: s.SwcLong},
+		)
+	}
+	if b, ok := fit.NewBoundingBox(corners); ok {
+		e.Bounds = &Bounds{
+			South: b.South.Degrees(),
+			West:  b.West.Degrees(),
+			North: b.North.Degrees(),
+			East:  b.East.Degrees(),
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package catalog_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/tormoder/fit/catalog"
+)
+
+func copyFile(t *testing.T, dst string, src ...string) {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join(append([]string{"..", "testdata"}, src...)...))
+	if err != nil {
+		t.Fatalf("reading file failed: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(dst, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIndex(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "testdata", "dcrainmaker", "Edge810-Vector-2013-08-16-15-35-10.fit"))
+	if err != nil {
+		t.Fatalf("reading file failed: %v", err)
+	}
+	e := catalog.Index(data)
+	want := catalog.Entry{
+		FileType:     "Activity",
+		Manufacturer: "Garmin",
+		Product:      "Edge810",
+		SerialNumber: 3866465233,
+		TimeCreated:  time.Date(2013, 8, 16, 18, 5, 8, 0, time.UTC),
+		Sport:        "Cycling",
+		StartTime:    time.Date(2013, 8, 16, 18, 5, 10, 0, time.UTC),
+		Duration:     4700.05,
+		Distance:     41339.38,
+		Bounds: &catalog.Bounds{
+			South: 47.62048308737576,
+			West:  -52.834464479237795,
+			North: 47.682422921061516,
+			East:  -52.75467664003372,
+		},
+	}
+	if !reflect.DeepEqual(e, want) {
+		t.Errorf("got:\n%+v\nwant:\n%+v", e, want)
+	}
+
+	e = catalog.Index(data[:len(data)/2])
+	if e.Error == "" || e.Product != "Edge810" {
+		t.Errorf("truncated file: got error %q and product %q, want error and product", e.Error, e.Product)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	dir := t.TempDir()
+	copyFile(t, filepath.Join(dir, "a", "edge.fit"), "dcrainmaker", "Edge810-Vector-2013-08-16-15-35-10.fit")
+	copyFile(t, filepath.Join(dir, "b", "run.FIT"), "me", "activity-small-fenix2-run.fit")
+	copyFile(t, filepath.Join(dir, "settings.fit"), "fitsdk", "Settings.fit")
+	copyFile(t, filepath.Join(dir, "notes.txt"), "fitsdk", "source.txt")
+
+	var c catalog.Catalog
+	update := func(want catalog.Stats) {
+		t.Helper()
+		stats, err := c.Update(dir)
+		if err != nil {
+			t.Fatalf("got error, want none; error is: %v", err)
+		}
+		if stats != want {
+			t.Errorf("stats: got %+v, want %+v", stats, want)
+		}
+	}
+
+	update(catalog.Stats{Indexed: 3})
+	var paths []string
+	for _, e := range c.Entries {
+		paths = append(paths, e.Path)
+	}
+	if want := []string{"a/edge.fit", "b/run.FIT", "settings.fit"}; !reflect.DeepEqual(paths, want) {
+		t.Errorf("paths: got %v, want %v", paths, want)
+	}
+
+	// Round trip through JSON, as done between runs.
+	var buf bytes.Buffer
+	if err := c.WriteJSON(&buf); err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	read, err := catalog.ReadJSON(&buf)
+	if err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	c = *read
+	update(catalog.Stats{Unchanged: 3})
+
+	if err := os.Rename(filepath.Join(dir, "a", "edge.fit"), filepath.Join(dir, "edge.fit")); err != nil {
+		t.Fatal(err)
+	}
+	later := time.Now().Add(time.Hour)
+	if err := os.Chtimes(filepath.Join(dir, "settings.fit"), later, later); err != nil {
+		t.Fatal(err)
+	}
+	update(catalog.Stats{Unchanged: 1, Reused: 2, Removed: 1})
+
+	copyFile(t, filepath.Join(dir, "settings.fit"), "fitsdk", "Activity.fit")
+	if err := os.Remove(filepath.Join(dir, "b", "run.FIT")); err != nil {
+		t.Fatal(err)
+	}
+	update(catalog.Stats{Unchanged: 1, Indexed: 1, Removed: 1})
+	if got := c.Entries[1].FileType; got != "Activity" {
+		t.Errorf("changed file: got file type %q, want Activity", got)
+	}
+
+	buf.Reset()
+	if err := c.WriteCSV(&buf); err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	rows, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	if len(rows) != len(c.Entries)+1 {
+		t.Errorf("csv: got %d rows, want %d", len(rows), len(c.Entries)+1)
+	}
+}
@@ -0,0 +1,58 @@
+package catalog
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+)
+
+var csvHeader = []string{
+	"path", "size", "mod_time", "hash",
+	"file_type", "manufacturer", "product", "serial_number", "time_created",
+	"sport", "start_time", "duration", "distance",
+	"south", "west", "north", "east",
+	"error",
+}
+
+// WriteCSV writes the catalog as CSV, with a header row naming the columns
+// as the JSON fields written by WriteJSON. Zero times and serial numbers,
+// and missing bounds, are written as empty values.
+func (c *Catalog) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, e := range c.Entries {
+		serial := ""
+		if e.SerialNumber != 0 {
+			serial = strconv.FormatUint(uint64(e.SerialNumber), 10)
+		}
+		row := []string{
+			e.Path, strconv.FormatInt(e.Size, 10), formatTime(e.ModTime), e.Hash,
+			e.FileType, e.Manufacturer, e.Product, serial, formatTime(e.TimeCreated),
+			e.Sport, formatTime(e.StartTime), formatFloat(e.Duration), formatFloat(e.Distance),
+			"", "", "", "",
+			e.Error,
+		}
+		if b := e.Bounds; b != nil {
+			row[13], row[14], row[15], row[16] = formatFloat(b.South), formatFloat(b.West), formatFloat(b.North), formatFloat(b.East)
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
@@ -0,0 +1,247 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+type severity int
+
+const (
+	severityWarning severity = iota
+	severityError
+)
+
+func (s severity) String() string {
+	if s == severityError {
+		return "error"
+	}
+	return "warning"
+}
+
+// Checks, as reported with findings.
+const (
+	checkRead      = "read"
+	checkCRC       = "crc"
+	checkStructure = "structure"
+	checkDef       = "definition"
+	checkDeveloper = "developer"
+	checkDecode    = "decode"
+	checkRequired  = "required"
+	checkTimestamp = "timestamp"
+)
+
+// finding is a problem found by a check. Offset is the byte offset of the
+// record the finding applies to, or -1.
+type finding struct {
+	severity severity
+	check    string
+	offset   int64
+	msg      string
+}
+
+func (f finding) String() string {
+	if f.offset < 0 {
+		return fmt.Sprintf("%v: %s: %s", f.severity, f.check, f.msg)
+	}
+	return fmt.Sprintf("%v: %s: offset %#x: %s", f.severity, f.check, f.offset, f.msg)
+}
+
+// requiredMesgs lists the messages required in addition to file_id for the
+// file types that have required messages, as given by the FIT file type
+// descriptions.
+var requiredMesgs = map[fit.FileType][]fit.MesgNum{
+	fit.FileTypeActivity:      {fit.MesgNumActivity, fit.MesgNumSession, fit.MesgNumLap, fit.MesgNumRecord},
+	fit.FileTypeCourse:        {fit.MesgNumCourse, fit.MesgNumLap, fit.MesgNumRecord},
+	fit.FileTypeWorkout:       {fit.MesgNumWorkout, fit.MesgNumWorkoutStep},
+	fit.FileTypeSchedules:     {fit.MesgNumSchedule},
+	fit.FileTypeWeight:        {fit.MesgNumWeightScale},
+	fit.FileTypeTotals:        {fit.MesgNumTotals},
+	fit.FileTypeGoals:         {fit.MesgNumGoal},
+	fit.FileTypeBloodPressure: {fit.MesgNumBloodPressure},
+	fit.FileTypeMonitoringA:   {fit.MesgNumMonitoring},
+	fit.FileTypeMonitoringB:   {fit.MesgNumMonitoring},
+	fit.FileTypeSegment:       {fit.MesgNumSegmentId, fit.MesgNumSegmentLap, fit.MesgNumSegmentPoint},
+}
+
+// check runs all checks on the FIT file in data and returns the findings.
+func check(data []byte) []finding {
+	var c checker
+	if err := fit.CheckIntegrity(bytes.NewReader(data), false); err != nil {
+		c.add(severityError, checkCRC, -1, "%v", err)
+	}
+	c.checkRecords(data)
+	c.checkFile(data)
+	return c.findings
+}
+
+type checker struct {
+	findings []finding
+	errors   int
+}
+
+func (c *checker) add(s severity, check string, offset int64, format string, args ...interface{}) {
+	c.findings = append(c.findings, finding{
+		severity: s,
+		check:    check,
+		offset:   offset,
+		msg:      fmt.Sprintf(format, args...),
+	})
+	if s == severityError {
+		c.errors++
+	}
+}
+
+type devField struct {
+	index, num byte
+}
+
+// checkRecords reads the file record by record, validating definition
+// messages against the profile and verifying that every developer field
+// used by a data message has been described by a developer_data_id and a
+// field_description message.
+func (c *checker) checkRecords(data []byte) {
+	rr, err := fit.NewRawReader(bytes.NewReader(data))
+	if err != nil {
+		var ierr fit.IntegrityError
+		if !errors.As(err, &ierr) {
+			c.add(severityError, checkStructure, -1, "%v", err)
+		}
+		return
+	}
+
+	var (
+		devIDs     = make(map[byte]bool)
+		devFields  = make(map[devField]bool)
+		unresolved = make(map[devField]bool)
+
+		descIndex = fieldNum(fit.MesgNumFieldDescription, "developer_data_index")
+		descNum   = fieldNum(fit.MesgNumFieldDescription, "field_definition_number")
+		idIndex   = fieldNum(fit.MesgNumDeveloperDataId, "developer_data_index")
+	)
+	for {
+		rec, err := rr.Next()
+		if errors.Is(err, io.EOF) {
+			return
+		}
+		if err != nil {
+			// The file CRC is verified by the CRC check.
+			var ierr fit.IntegrityError
+			if !errors.As(err, &ierr) {
+				c.add(severityError, checkStructure, -1, "%v", err)
+			}
+			return
+		}
+
+		if rec.IsDefinition() {
+			if err := rec.Def.Validate(); err != nil {
+				c.add(severityError, checkDef, rec.Offset, "%v", err)
+			}
+			continue
+		}
+
+		switch rec.MesgNum() {
+		case fit.MesgNumDeveloperDataId:
+			if b, ok := rec.Field(idIndex); ok && len(b) == 1 {
+				devIDs[b[0]] = true
+			}
+		case fit.MesgNumFieldDescription:
+			index, iok := rec.Field(descIndex)
+			num, nok := rec.Field(descNum)
+			if iok && nok && len(index) == 1 && len(num) == 1 {
+				devFields[devField{index[0], num[0]}] = true
+			}
+		}
+
+		for _, dfd := range rec.Def.DevFields {
+			df := devField{dfd.DevDataIndex, dfd.Num}
+			if unresolved[df] {
+				continue
+			}
+			switch {
+			case !devIDs[df.index]:
+				c.add(severityError, checkDeveloper, rec.Offset,
+					"developer field %d: no developer_data_id message for developer data index %d", df.num, df.index)
+			case !devFields[df]:
+				c.add(severityError, checkDeveloper, rec.Offset,
+					"developer field %d: no field_description message for developer data index %d", df.num, df.index)
+			default:
+				continue
+			}
+			unresolved[df] = true
+		}
+	}
+}
+
+// checkFile decodes the file and verifies that the messages required by the
+// file type are present, and that timestamps of messages of the same type
+// are not decreasing. A file that only fails the CRC check is checked as
+// decoded.
+func (c *checker) checkFile(data []byte) {
+	file, err := fit.Decode(bytes.NewReader(data))
+	var ierr fit.IntegrityError
+	if err != nil && !errors.As(err, &ierr) {
+		// Errors found by the record checks also fail decoding. The
+		// messages of a file that fails to decode are incomplete, so
+		// they are not checked.
+		if c.errors == 0 {
+			c.add(severityError, checkDecode, -1, "%v", err)
+		}
+		return
+	}
+
+	counts := make(map[fit.MesgNum]int)
+	last := make(map[fit.MesgNum]time.Time)
+	backwards := make(map[fit.MesgNum]int)
+	var order []fit.MesgNum
+	for _, msg := range file.Messages() {
+		mn, found := fit.MesgNumOf(msg)
+		if !found {
+			continue
+		}
+		counts[mn]++
+
+		ts := reflect.ValueOf(msg).Elem().FieldByName("Timestamp")
+		if !ts.IsValid() {
+			continue
+		}
+		t, ok := ts.Interface().(time.Time)
+		if !ok || t.IsZero() || fit.IsBaseTime(t) {
+			continue
+		}
+		if prev, found := last[mn]; found && t.Before(prev) {
+			if backwards[mn] == 0 {
+				order = append(order, mn)
+			}
+			backwards[mn]++
+		}
+		last[mn] = t
+	}
+
+	for _, mn := range requiredMesgs[file.Type()] {
+		if counts[mn] == 0 {
+			c.add(severityError, checkRequired, -1,
+				"%v file has no %s message", file.Type(), fit.MesgName(mn))
+		}
+	}
+	for _, mn := range order {
+		c.add(severityWarning, checkTimestamp, -1,
+			"%d of %d %s messages have a timestamp earlier than the previous one",
+			backwards[mn], counts[mn], fit.MesgName(mn))
+	}
+}
+
+func fieldNum(mn fit.MesgNum, name string) byte {
+	for _, pf := range fit.ProfileFields(mn) {
+		if pf.Name == name {
+			return pf.Num
+		}
+	}
+	panic(fmt.Sprintf("no field %s in %s", name, fit.MesgName(mn)))
+}
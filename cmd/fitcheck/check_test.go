@@ -0,0 +1,59 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/tormoder/fit"
+)
+
+func readFile(t *testing.T, elem ...string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join(append([]string{"..", "..", "testdata"}, elem...)...))
+	if err != nil {
+		t.Fatalf("reading file failed: %v", err)
+	}
+	return data
+}
+
+func TestCheck(t *testing.T) {
+	// Make the developer fields of DeveloperData.fit unresolvable by
+	// moving their descriptions to another developer data index.
+	unresolved := readFile(t, "fitsdk", "DeveloperData.fit")
+	err := fit.Patch(unresolved, func(rec *fit.RawRecord) error {
+		if rec.MesgNum() != fit.MesgNumFieldDescription {
+			return nil
+		}
+		return rec.Set("DeveloperDataIndex", uint8(7))
+	})
+	if err != nil {
+		t.Fatalf("patch: got error, want none; error is: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		data   []byte
+		checks []string
+	}{
+		{"valid", readFile(t, "fitsdk", "Activity.fit"), nil},
+		{"workout", readFile(t, "fitsdk", "WorkoutRepeatSteps.fit"), nil},
+		{"file crc", readFile(t, "corrupt", "activity-filecrc.fit"), []string{checkCRC}},
+		{"unexpected eof", readFile(t, "corrupt", "activity-unexpected-eof.fit"), []string{checkCRC, checkStructure}},
+		{"missing messages", readFile(t, "fitsdk", "DeveloperData.fit"), []string{checkRequired, checkRequired, checkRequired}},
+		{"unresolved developer fields", unresolved, []string{checkDeveloper, checkRequired, checkRequired, checkRequired}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			findings := check(test.data)
+			if len(findings) != len(test.checks) {
+				t.Fatalf("got %d findings, want %d: %v", len(findings), len(test.checks), findings)
+			}
+			for i, f := range findings {
+				if f.check != test.checks[i] {
+					t.Errorf("finding %d: got check %q, want %q: %v", i, f.check, test.checks[i], f)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+// Exit codes.
+const (
+	exitOK       = 0
+	exitFindings = 1
+	exitUsage    = 2
+)
+
+func main() {
+	strict := flag.Bool(
+		"strict",
+		false,
+		"exit with a non-zero exit code for warnings as well as errors",
+	)
+	quiet := flag.Bool(
+		"q",
+		false,
+		"do not print individual findings, only the summary table when checking more than one file",
+	)
+
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: fitcheck [flags] [fit files or directories]\n")
+		flag.PrintDefaults()
+	}
+
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(exitUsage)
+	}
+
+	paths, err := fitFiles(flag.Args())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "fitcheck: %v\n", err)
+		os.Exit(exitUsage)
+	}
+
+	results := make([]result, len(paths))
+	for i, path := range paths {
+		results[i] = checkPath(path)
+		if !*quiet {
+			for _, f := range results[i].findings {
+				fmt.Printf("%s: %v\n", path, f)
+			}
+		}
+	}
+	if len(results) > 1 {
+		writeSummary(os.Stdout, results)
+	}
+
+	for _, r := range results {
+		if r.errors > 0 || (*strict && r.warnings > 0) {
+			os.Exit(exitFindings)
+		}
+	}
+	os.Exit(exitOK)
+}
+
+type result struct {
+	path             string
+	findings         []finding
+	errors, warnings int
+}
+
+func checkPath(path string) result {
+	r := result{path: path}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		r.findings = []finding{{severity: severityError, check: checkRead, offset: -1, msg: err.Error()}}
+	} else {
+		r.findings = check(data)
+	}
+	for _, f := range r.findings {
+		if f.severity == severityError {
+			r.errors++
+		} else {
+			r.warnings++
+		}
+	}
+	return r
+}
+
+// fitFiles returns the paths given as arguments, with directories replaced
+// by the FIT files found in them.
+func fitFiles(args []string) ([]string, error) {
+	var paths []string
+	for _, arg := range args {
+		fi, err := os.Stat(arg)
+		if err != nil {
+			return nil, err
+		}
+		if !fi.IsDir() {
+			paths = append(paths, arg)
+			continue
+		}
+		var found []string
+		err = filepath.Walk(arg, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if !info.IsDir() && strings.EqualFold(filepath.Ext(path), ".fit") {
+				found = append(found, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		sort.Strings(found)
+		paths = append(paths, found...)
+	}
+	return paths, nil
+}
+
+func writeSummary(w io.Writer, results []result) {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "\nFILE\tERRORS\tWARNINGS\tSTATUS")
+	var failed, errors, warnings int
+	for _, r := range results {
+		status := "ok"
+		if r.errors > 0 {
+			status = "FAIL"
+			failed++
+		}
+		errors += r.errors
+		warnings += r.warnings
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%s\n", r.path, r.errors, r.warnings, status)
+	}
+	fmt.Fprintf(tw, "%d files, %d failed\t%d\t%d\t\n", len(results), failed, errors, warnings)
+	tw.Flush()
+}
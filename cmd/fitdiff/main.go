@@ -0,0 +1,104 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/diff"
+)
+
+func main() {
+	tolerance := flag.Float64(
+		"tolerance",
+		0,
+		"absolute tolerance for floating point and scaled values",
+	)
+	fieldTolerances := flag.String(
+		"field-tolerance",
+		"",
+		"comma separated per field tolerances, e.g. \"altitude=0.5,record.distance=1\"",
+	)
+	ignore := flag.String(
+		"ignore",
+		"",
+		"comma separated fields not to compare, e.g. \"file_id.time_created,timestamp\"",
+	)
+
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: fitdiff [flags] [fit file a] [fit file b]\n")
+		flag.PrintDefaults()
+	}
+
+	flag.Parse()
+	if flag.NArg() != 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	opts := []diff.Option{diff.WithTolerance(*tolerance)}
+	for _, ft := range split(*fieldTolerances) {
+		i := strings.LastIndex(ft, "=")
+		if i < 0 {
+			fatalf("invalid field tolerance %q: want field=tolerance", ft)
+		}
+		t, err := strconv.ParseFloat(ft[i+1:], 64)
+		if err != nil {
+			fatalf("invalid field tolerance %q: %v", ft, err)
+		}
+		opts = append(opts, diff.WithFieldTolerance(ft[:i], t))
+	}
+	if fields := split(*ignore); len(fields) > 0 {
+		opts = append(opts, diff.WithIgnoredFields(fields...))
+	}
+
+	a, err := decode(flag.Arg(0))
+	if err != nil {
+		fatalf("%v", err)
+	}
+	b, err := decode(flag.Arg(1))
+	if err != nil {
+		fatalf("%v", err)
+	}
+
+	diffs := diff.Compare(a, b, opts...)
+	w := bufio.NewWriter(os.Stdout)
+	for _, d := range diffs {
+		fmt.Fprintln(w, d)
+	}
+	if err := w.Flush(); err != nil {
+		fatalf("error writing output: %v", err)
+	}
+	if len(diffs) > 0 {
+		os.Exit(1)
+	}
+}
+
+func decode(path string) (*fit.File, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading file: %w", err)
+	}
+	file, err := fit.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("error decoding %s: %w", path, err)
+	}
+	return file, nil
+}
+
+func split(list string) []string {
+	if list == "" {
+		return nil
+	}
+	return strings.Split(list, ",")
+}
+
+func fatalf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "fitdiff: "+format+"\n", args...)
+	os.Exit(2)
+}
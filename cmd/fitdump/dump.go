@@ -0,0 +1,199 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/internal/field"
+)
+
+// dumpText writes the header followed by every message with all valid
+// fields. Types are written using their names, and scale, offset and units
+// are applied.
+func dumpText(w io.Writer, file *fit.File) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "header: %v\n", file.Header)
+	fmt.Fprintf(tw, "file type: %v\n", file.Type())
+	for _, msg := range file.Messages() {
+		mn, found := fit.MesgNumOf(msg)
+		if !found {
+			return fmt.Errorf("unknown message type %T", msg)
+		}
+		fmt.Fprintf(tw, "\n%s (%d)\n", fit.MesgName(mn), mn)
+		msgv := reflect.ValueOf(msg).Elem()
+		for _, pf := range fit.ProfileFields(mn) {
+			s, valid := formatValue(pf, msgv.Field(pf.Index))
+			if !valid {
+				continue
+			}
+			fmt.Fprintf(tw, "  %s\t%s\n", pf.Name, s)
+		}
+	}
+	writeUnknown(tw, file)
+	return tw.Flush()
+}
+
+// dumpSummary writes the header, the file id and the number of messages of
+// every type, in the order the types first appear in the file.
+func dumpSummary(w io.Writer, file *fit.File) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "header: %v\n", file.Header)
+	fmt.Fprintf(tw, "file type: %v\n", file.Type())
+	fmt.Fprintf(tw, "manufacturer: %v\n", file.FileId.Manufacturer)
+	if product := file.FileId.GetProduct(); product != nil {
+		fmt.Fprintf(tw, "product: %v\n", product)
+	}
+	if sn := file.FileId.SerialNumber; sn != fit.NewFileIdMsg().SerialNumber {
+		fmt.Fprintf(tw, "serial number: %d\n", sn)
+	}
+	if t := file.FileId.TimeCreated; field.ValidTime(t) {
+		fmt.Fprintf(tw, "time created: %s\n", formatTime(t))
+	}
+
+	var (
+		order  []fit.MesgNum
+		counts = make(map[fit.MesgNum]int)
+		total  int
+		first  time.Time
+		last   time.Time
+	)
+	for _, msg := range file.Messages() {
+		mn, found := fit.MesgNumOf(msg)
+		if !found {
+			return fmt.Errorf("unknown message type %T", msg)
+		}
+		if counts[mn] == 0 {
+			order = append(order, mn)
+		}
+		counts[mn]++
+		total++
+
+		ts := reflect.ValueOf(msg).Elem().FieldByName("Timestamp")
+		if !ts.IsValid() {
+			continue
+		}
+		t, ok := ts.Interface().(time.Time)
+		if !ok || !field.ValidTime(t) {
+			continue
+		}
+		if first.IsZero() || t.Before(first) {
+			first = t
+		}
+		if last.IsZero() || t.After(last) {
+			last = t
+		}
+	}
+	if !first.IsZero() {
+		fmt.Fprintf(tw, "time span: %s - %s (%v)\n", formatTime(first), formatTime(last), last.Sub(first))
+	}
+
+	fmt.Fprintf(tw, "\nmessages:\n")
+	for _, mn := range order {
+		fmt.Fprintf(tw, "  %s (%d)\t%d\n", fit.MesgName(mn), mn, counts[mn])
+	}
+	fmt.Fprintf(tw, "  total\t%d\n", total)
+	writeUnknown(tw, file)
+	return tw.Flush()
+}
+
+// writeUnknown writes the unknown messages and fields recorded when
+// decoding, if any.
+func writeUnknown(w io.Writer, file *fit.File) {
+	if len(file.UnknownMessages) > 0 {
+		fmt.Fprintf(w, "\nunknown messages:\n")
+		for _, um := range file.UnknownMessages {
+			fmt.Fprintf(w, "  %s (%d)\t%d\n", mesgName(um.MesgNum), um.MesgNum, um.Count)
+		}
+	}
+	if len(file.UnknownFields) > 0 {
+		fmt.Fprintf(w, "\nunknown fields:\n")
+		for _, uf := range file.UnknownFields {
+			fmt.Fprintf(w, "  %s (%d) field %d\t%d\n", mesgName(uf.MesgNum), uf.MesgNum, uf.FieldNum, uf.Count)
+		}
+	}
+}
+
+type jsonHeader struct {
+	Header fit.Header
+}
+
+type jsonMessage struct {
+	Message string
+	Fields  interface{}
+}
+
+// dumpJSON writes the header followed by every message as JSON, one value
+// per line. Messages are encoded using their MarshalJSON methods.
+func dumpJSON(w io.Writer, file *fit.File) error {
+	enc := json.NewEncoder(w)
+	if err := enc.Encode(jsonHeader{Header: file.Header}); err != nil {
+		return err
+	}
+	for _, msg := range file.Messages() {
+		mn, found := fit.MesgNumOf(msg)
+		if !found {
+			return fmt.Errorf("unknown message type %T", msg)
+		}
+		if err := enc.Encode(jsonMessage{Message: fit.MesgName(mn), Fields: msg}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// formatValue returns the human readable representation of a field value,
+// with units for numeric values. It reports false if the field is invalid.
+func formatValue(pf fit.ProfileField, v reflect.Value) (string, bool) {
+	switch x := v.Interface().(type) {
+	case time.Time:
+		if !field.ValidTime(x) {
+			return "", false
+		}
+		return formatTime(x), true
+	case fit.Latitude:
+		return x.String(), !x.Invalid()
+	case fit.Longitude:
+		return x.String(), !x.Invalid()
+	case string:
+		return strconv.Quote(x), x != ""
+	}
+
+	if v.Kind() != reflect.Slice {
+		s, _, valid := field.Scalar(pf, v)
+		return withUnits(s, pf), valid
+	}
+	if pf.Type == fit.FitBaseTypeByte && v.Type().Elem().Kind() == reflect.Uint8 {
+		b := v.Bytes()
+		return hex.EncodeToString(b), len(b) > 0
+	}
+	elems := make([]string, v.Len())
+	valid := false
+	for i := range elems {
+		s, _, ok := field.Scalar(pf, v.Index(i))
+		if !ok {
+			s = "-"
+		}
+		elems[i] = s
+		valid = valid || ok
+	}
+	return withUnits("["+strings.Join(elems, " ")+"]", pf), valid
+}
+
+func withUnits(s string, pf fit.ProfileField) string {
+	if pf.Units == "" {
+		return s
+	}
+	return s + " " + pf.Units
+}
+
+func formatTime(t time.Time) string {
+	return t.Format(time.RFC3339)
+}
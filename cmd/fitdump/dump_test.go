@@ -0,0 +1,204 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+func readFile(t *testing.T, elem ...string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join(append([]string{"..", "..", "testdata"}, elem...)...))
+	if err != nil {
+		t.Fatalf("reading file failed: %v", err)
+	}
+	return data
+}
+
+func TestFormatValue(t *testing.T) {
+	rec := fit.NewRecordMsg()
+	rec.Timestamp = time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)
+	rec.PositionLat = fit.NewLatitudeDegrees(59.9)
+	rec.HeartRate = 150
+	rec.Distance = 123456
+	rec.Altitude = 3000
+
+	hr := fit.NewHrMsg()
+	hr.FilteredBpm = []uint8{120, 0xFF, 121}
+	hr.EventTimestamp = []uint32{1024, 2560}
+	hr.EventTimestamp12 = []byte{0x01, 0xab}
+
+	ev := fit.NewEventMsg()
+	ev.Event = fit.EventTimer
+
+	fid := fit.NewFileIdMsg()
+	fid.ProductName = "Edge"
+
+	tests := []struct {
+		name  string
+		msg   interface{}
+		field string
+		want  string
+		valid bool
+	}{
+		{"time", rec, "timestamp", "2020-06-01T10:00:00Z", true},
+		{"invalid time", fit.NewRecordMsg(), "timestamp", "", false},
+		{"latitude", rec, "position_lat", fit.NewLatitudeDegrees(59.9).String(), true},
+		{"invalid latitude", rec, "position_long", "", false},
+		{"units", rec, "heart_rate", "150 bpm", true},
+		{"invalid", rec, "cadence", "", false},
+		{"scale", rec, "distance", "1234.56 m", true},
+		{"scale and offset", rec, "altitude", "100 m", true},
+		{"type name", ev, "event", "Timer", true},
+		{"invalid type", ev, "event_type", "", false},
+		{"array", hr, "filtered_bpm", "[120 - 121] bpm", true},
+		{"scaled array", hr, "event_timestamp", "[1 2.5] s", true},
+		{"invalid array", fit.NewHrMsg(), "filtered_bpm", "", false},
+		{"bytes", hr, "event_timestamp_12", "01ab", true},
+		{"string", fid, "product_name", `"Edge"`, true},
+		{"empty string", fit.NewFileIdMsg(), "product_name", "", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mn, found := fit.MesgNumOf(test.msg)
+			if !found {
+				t.Fatalf("no message number for %T", test.msg)
+			}
+			var pf *fit.ProfileField
+			for _, f := range fit.ProfileFields(mn) {
+				if f.Name == test.field {
+					f := f
+					pf = &f
+				}
+			}
+			if pf == nil {
+				t.Fatalf("no field %q in %v", test.field, mn)
+			}
+			got, valid := formatValue(*pf, reflect.ValueOf(test.msg).Elem().Field(pf.Index))
+			if valid != test.valid {
+				t.Errorf("got valid %t, want %t", valid, test.valid)
+			}
+			if test.valid && got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestDumpFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    []string
+		mode    string
+		unknown bool
+		want    []string
+		notWant []string
+	}{
+		{
+			name:    "text",
+			file:    []string{"fitsdk", "WorkoutRepeatSteps.fit"},
+			mode:    modeText,
+			notWant: []string{"chained file"},
+			want: []string{
+				"file type: Workout\n",
+				"\nworkout (26)\n  num_valid_steps  4\n  wkt_name         \"Example 2\"\n",
+				"  duration_type   Time\n",
+			},
+		},
+		{
+			name: "summary",
+			file: []string{"fitsdk", "WorkoutRepeatSteps.fit"},
+			mode: modeSummary,
+			want: []string{
+				"manufacturer: Dynastream\n",
+				"serial number: 1234\n",
+				"time created: 2009-09-09T20:38:00Z\n",
+				"  workout_step (27)  5\n",
+				"  total              7\n",
+			},
+			notWant: []string{"time span"},
+		},
+		{
+			name: "summary time span",
+			file: []string{"fitsdk", "Activity.fit"},
+			mode: modeSummary,
+			want: []string{
+				"time span: 2012-04-09T21:22:26Z - 2012-04-09T21:24:51Z (2m25s)\n",
+				"  record (20)        14\n",
+			},
+		},
+		{
+			name:    "summary without unknown",
+			file:    []string{"fitsdk", "MonitoringFile.fit"},
+			mode:    modeSummary,
+			notWant: []string{"unknown"},
+		},
+		{
+			name:    "summary with unknown",
+			file:    []string{"fitsdk", "MonitoringFile.fit"},
+			mode:    modeSummary,
+			unknown: true,
+			want:    []string{"\nunknown fields:\n", "  monitoring_info (103) field 1  1\n"},
+		},
+		{
+			name: "json",
+			file: []string{"fitsdk", "WorkoutRepeatSteps.fit"},
+			mode: modeJSON,
+			want: []string{
+				`{"Header":{"Size":14,`,
+				"\n" + `{"Message":"workout","Fields":{"NumValidSteps":4,"WktName":"Example 2"}}` + "\n",
+			},
+		},
+		{
+			name: "chained",
+			file: []string{"chained", "activity-settings.fit"},
+			mode: modeSummary,
+			want: []string{
+				"chained file 1 of 2\nheader: ",
+				"file type: Activity\n",
+				"\n\nchained file 2 of 2\nheader: ",
+				"file type: Settings\n",
+			},
+		},
+		{
+			name: "chained json",
+			file: []string{"chained", "activity-settings.fit"},
+			mode: modeJSON,
+			want: []string{
+				`"Message":"activity"`,
+				"\n" + `{"Header":{"Size":12,"ProtocolVersion":16,"ProfileVersion":71,`,
+			},
+			notWant: []string{"chained file"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := dumpFile(&buf, readFile(t, test.file...), test.mode, test.unknown); err != nil {
+				t.Fatalf("got error, want none; error is: %v", err)
+			}
+			got := buf.String()
+			for _, w := range test.want {
+				if !strings.Contains(got, w) {
+					t.Errorf("output does not contain %q:\n%s", w, got)
+				}
+			}
+			for _, nw := range test.notWant {
+				if strings.Contains(got, nw) {
+					t.Errorf("output contains %q:\n%s", nw, got)
+				}
+			}
+		})
+	}
+
+	err := dumpFile(new(bytes.Buffer), readFile(t, "corrupt", "activity-filecrc.fit"), modeText, false)
+	if err == nil {
+		t.Errorf("corrupt file: got no error, want error")
+	}
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/tormoder/fit"
+)
+
+const (
+	modeText    = "text"
+	modeSummary = "summary"
+	modeRaw     = "raw"
+	modeJSON    = "json"
+)
+
+func main() {
+	mode := flag.String(
+		"mode",
+		modeText,
+		"output mode: 'text' for all messages, 'summary' for message counts, 'raw' for records with byte offsets or 'json' for JSON lines",
+	)
+	unknown := flag.Bool(
+		"unknown",
+		false,
+		"report unknown messages and fields in text and summary mode",
+	)
+
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: fitdump [flags] [path to fit file]\n")
+		flag.PrintDefaults()
+	}
+
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	data, err := os.ReadFile(flag.Arg(0))
+	if err != nil {
+		fatalf("error reading file: %v", err)
+	}
+
+	w := bufio.NewWriter(os.Stdout)
+	switch *mode {
+	case modeRaw:
+		err = dumpRaw(w, data)
+	case modeText, modeSummary, modeJSON:
+		err = dumpFile(w, data, *mode, *unknown)
+	default:
+		fmt.Fprintf(os.Stderr, "fitdump: unknown mode %q\n", *mode)
+		flag.Usage()
+		os.Exit(2)
+	}
+	if ferr := w.Flush(); err == nil {
+		err = ferr
+	}
+	if err != nil {
+		fatalf("%v", err)
+	}
+}
+
+// dumpFile decodes the FIT files chained in data, and writes them one after
+// the other in the given mode. In text and summary mode every file is
+// preceded by its position in the chain if there is more than one.
+func dumpFile(w io.Writer, data []byte, mode string, unknown bool) error {
+	var opts []fit.DecodeOption
+	if unknown {
+		opts = append(opts, fit.WithUnknownMessages(), fit.WithUnknownFields())
+	}
+	files, err := fit.DecodeChained(bytes.NewReader(data), opts...)
+	if err != nil {
+		return fmt.Errorf("error decoding file: %w", err)
+	}
+	for i, file := range files {
+		if len(files) > 1 && mode != modeJSON {
+			if i > 0 {
+				fmt.Fprintln(w)
+			}
+			fmt.Fprintf(w, "chained file %d of %d\n", i+1, len(files))
+		}
+		switch mode {
+		case modeSummary:
+			err = dumpSummary(w, file)
+		case modeJSON:
+			err = dumpJSON(w, file)
+		default:
+			err = dumpText(w, file)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func fatalf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "fitdump: "+format+"\n", args...)
+	os.Exit(1)
+}
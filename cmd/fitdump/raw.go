@@ -0,0 +1,113 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/tormoder/fit"
+)
+
+// dumpRaw writes every record of the FIT files chained in data with its
+// byte offset in data, without decoding it into a message. Definition
+// records are written with their field definitions, data records with the
+// raw bytes of every field as stored in the file.
+func dumpRaw(w io.Writer, data []byte) error {
+	r := bytes.NewReader(data)
+	for r.Len() > 0 {
+		start := r.Size() - int64(r.Len())
+		if start > 0 {
+			fmt.Fprintln(w)
+		}
+		if err := dumpRawFile(w, r, start); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dumpRawFile writes the records of the FIT file read from r, which starts
+// at the given offset.
+func dumpRawFile(w io.Writer, r io.Reader, start int64) error {
+	rr, err := fit.NewRawReader(r)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "header: %v\n", rr.Header())
+	for {
+		rec, err := rr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		def := rec.Def
+		offset := start + rec.Offset
+		mesg := fmt.Sprintf("%s (%d)", mesgName(def.MesgNum), def.MesgNum)
+		if rec.IsDefinition() {
+			fmt.Fprintf(w, "%08x  definition  local %2d  %s  %s\n", offset, def.LocalMesgNum, mesg, formatDefinition(def))
+			continue
+		}
+		kind := "data"
+		if rec.IsCompressed() {
+			kind = "data (compressed timestamp)"
+		}
+		fmt.Fprintf(w, "%08x  %s  local %2d  %s  %s\n", offset, kind, def.LocalMesgNum, mesg, formatData(rec))
+	}
+}
+
+func formatDefinition(def *fit.RawDefinition) string {
+	var sb strings.Builder
+	if def.Arch == binary.BigEndian {
+		sb.WriteString("big-endian")
+	} else {
+		sb.WriteString("little-endian")
+	}
+	for _, fd := range def.Fields {
+		fmt.Fprintf(&sb, " %s:%d:%v", fieldName(def.MesgNum, fd.Num), fd.Size, fd.BaseType)
+	}
+	for _, dfd := range def.DevFields {
+		fmt.Fprintf(&sb, " dev%d.%d:%d", dfd.DevDataIndex, dfd.Num, dfd.Size)
+	}
+	return sb.String()
+}
+
+func formatData(rec *fit.RawRecord) string {
+	var sb strings.Builder
+	b := rec.Bytes[1:]
+	for i, fd := range rec.Def.Fields {
+		if i > 0 {
+			sb.WriteByte(' ')
+		}
+		fmt.Fprintf(&sb, "%s=%s", fieldName(rec.Def.MesgNum, fd.Num), hex.EncodeToString(b[:fd.Size]))
+		b = b[fd.Size:]
+	}
+	for _, dfd := range rec.Def.DevFields {
+		fmt.Fprintf(&sb, " dev%d.%d=%s", dfd.DevDataIndex, dfd.Num, hex.EncodeToString(b[:dfd.Size]))
+		b = b[dfd.Size:]
+	}
+	return sb.String()
+}
+
+func mesgName(mn fit.MesgNum) string {
+	if name := fit.MesgName(mn); name != "" {
+		return name
+	}
+	return "unknown"
+}
+
+// fieldName returns the profile name of a field, or its number if the field
+// is not known.
+func fieldName(mn fit.MesgNum, num byte) string {
+	for _, pf := range fit.ProfileFields(mn) {
+		if pf.Num == num {
+			return pf.Name
+		}
+	}
+	return fmt.Sprint(num)
+}
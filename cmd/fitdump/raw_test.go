@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestDumpRaw(t *testing.T) {
+	var buf bytes.Buffer
+	if err := dumpRaw(&buf, readFile(t, "fitsdk", "WorkoutRepeatSteps.fit")); err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if !strings.HasPrefix(lines[0], "header: ") {
+		t.Errorf("got first line %q, want header", lines[0])
+	}
+	want := []string{
+		"0000000e  definition  local  0  file_id (0)  big-endian type:1:Enum manufacturer:2:Uint16 product:2:Uint16 serial_number:4:Uint32z time_created:4:Uint32",
+		"00000023  data  local  0  file_id (0)  type=05 manufacturer=000f product=0016 serial_number=000004d2 time_created=250ac628",
+	}
+	for i, w := range want {
+		if lines[i+1] != w {
+			t.Errorf("line %d:\ngot:  %s\nwant: %s", i+1, lines[i+1], w)
+		}
+	}
+	record := regexp.MustCompile(`^[0-9a-f]{8}  (definition|data)  local [ 0-9]{2}  [a-z_]+ \([0-9]+\)  `)
+	var data int
+	for _, line := range lines[1:] {
+		if !record.MatchString(line) {
+			t.Errorf("malformed record line %q", line)
+		}
+		if strings.Contains(line, "  data  ") {
+			data++
+		}
+	}
+	if data != 7 {
+		t.Errorf("got %d data records, want 7", data)
+	}
+
+	err := dumpRaw(new(bytes.Buffer), readFile(t, "corrupt", "activity-unexpected-eof.fit"))
+	if err == nil {
+		t.Errorf("truncated file: got no error, want error")
+	}
+}
+
+func TestDumpRawChained(t *testing.T) {
+	var buf bytes.Buffer
+	if err := dumpRaw(&buf, readFile(t, "chained", "activity-settings.fit")); err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	got := buf.String()
+	if n := strings.Count(got, "header: "); n != 2 {
+		t.Errorf("got %d headers, want 2", n)
+	}
+	// The second file starts after the 12 byte header, 757 bytes of data
+	// and the CRC of the first.
+	want := "\n\nheader: size: 12 | protover: 16 | profver: 71 | dsize: 68 | dtype: .FIT | crc: 0x0\n" +
+		"0000030f  definition  local  0  file_id (0)  "
+	if !strings.Contains(got, want) {
+		t.Errorf("output does not contain %q:\n%s", want, got)
+	}
+}
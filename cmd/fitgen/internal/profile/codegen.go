@@ -9,6 +9,7 @@ import (
 	"go/token"
 	"log"
 	"sort"
+	"strconv"
 	"time"
 
 	"github.com/tormoder/fit/internal/types"
@@ -56,6 +57,17 @@ func (g *codeGenerator) generateMsgs(msgs []*Msg) ([]byte, error) {
 	return g.Bytes(), nil
 }
 
+func (g *codeGenerator) generateMsgsJSON(msgs []*Msg) ([]byte, error) {
+	g.Buffer = new(bytes.Buffer)
+	g.genHeader()
+	g.genMsgsJSON(msgs)
+	err := g.formatCode()
+	if err != nil {
+		return nil, err
+	}
+	return g.Bytes(), nil
+}
+
 func (g *codeGenerator) generateProfile(types map[string]*Type, msgs []*Msg) ([]byte, error) {
 	g.Buffer = new(bytes.Buffer)
 	g.genHeader()
@@ -636,6 +648,9 @@ func (g *codeGenerator) genProfile(types map[string]*Type, msgs []*Msg) {
 	g.genAccumulators(msgs)
 	g.genFieldsArray(msgs)
 	g.genGetFieldArrayLookup()
+	g.genSubfieldsMap(msgs)
+	g.genTypeValuesMap(types)
+	g.genMsgNamesArray(msgs)
 	g.genMsgTypesArray(msgs)
 	g.genGetGlobalMesgNum()
 	g.genNewMesgFuncsArray(msgs)
@@ -713,7 +728,10 @@ func (g *codeGenerator) genFieldsArray(msgs []*Msg) {
 		g.p("MesgNum", msg.CCName, ": {")
 		for i := 0; i < len(msg.Fields); i++ {
 			f := msg.Fields[i]
-			g.p(f.DefNum, ": {", i, ", ", f.DefNum, ", ", f.FType.ValueString(), ", ", f.Length, "},")
+			g.p(
+				f.DefNum, ": {", i, ", ", f.DefNum, ", ", f.FType.ValueString(), ", ", f.Length, ", ",
+				strconv.Quote(f.Name), ", ", f.scaleValue(), ", ", f.offsetValue(), ", ", strconv.Quote(f.unitsValue()), "},",
+			)
 		}
 		g.p("},")
 		g.p()
@@ -735,6 +753,61 @@ func (g *codeGenerator) genGetFieldArrayLookup() {
 	g.p("}")
 }
 
+func (g *codeGenerator) genSubfieldsMap(msgs []*Msg) {
+	g.p()
+	g.p("var _subfields = map[MesgNum][]subfield{")
+	for _, msg := range msgs {
+		var sfields []string
+		for _, f := range msg.Fields {
+			for _, sf := range f.Subfields {
+				sfields = append(sfields, fmt.Sprint(
+					"{", f.DefNum, ", ", strconv.Quote(sf.Name), ", ", sf.scaleValue(), ", ",
+					sf.offsetValue(), ", ", strconv.Quote(sf.unitsValue()), "},",
+				))
+			}
+		}
+		if len(sfields) == 0 {
+			continue
+		}
+		g.p("MesgNum", msg.CCName, ": {")
+		for _, sf := range sfields {
+			g.p(sf)
+		}
+		g.p("},")
+	}
+	g.p("}")
+}
+
+func (g *codeGenerator) genTypeValuesMap(types map[string]*Type) {
+	tkeys := make([]string, 0, len(types))
+	for tkey := range types {
+		tkeys = append(tkeys, tkey)
+	}
+	sort.Strings(tkeys)
+
+	g.p()
+	g.p("var _typeValues = map[reflect.Type][]typeValue{")
+	for _, tkey := range tkeys {
+		t := types[tkey]
+		g.p("reflect.TypeOf(", t.Name, "(0)): {")
+		for _, v := range t.Values {
+			g.p("{", strconv.Quote(v.Name), ", ", v.Value, "},")
+		}
+		g.p("{\"Invalid\", ", t.BaseType.GoInvalidValue(), "},")
+		g.p("},")
+	}
+	g.p("}")
+}
+
+func (g *codeGenerator) genMsgNamesArray(msgs []*Msg) {
+	g.p()
+	g.p("var msgNames = [...]string{")
+	for _, msg := range msgs {
+		g.p("MesgNum", msg.CCName, ": ", strconv.Quote(msg.Name), ",")
+	}
+	g.p("}")
+}
+
 func (g *codeGenerator) genNewMesgFuncsArray(msgs []*Msg) {
 	g.p()
 	g.p("type newMesgFunc func() reflect.Value")
@@ -772,3 +845,147 @@ func (g *codeGenerator) genGetZeroValueMsgsArrayLookup() {
 	g.p("return newMesgFuncs[mn]().Elem()")
 	g.p("}")
 }
+
+func (g *codeGenerator) genMsgsJSON(msgs []*Msg) {
+	g.p("import (")
+	if anyFloatField(msgs) {
+		g.p("\"math\"")
+	}
+	g.p("\"reflect\"")
+	g.p(")")
+	for _, msg := range msgs {
+		g.genMarshalJSON(msg)
+		g.genUnmarshalJSON(msg)
+	}
+}
+
+func anyFloatField(msgs []*Msg) bool {
+	for _, msg := range msgs {
+		for _, f := range msg.Fields {
+			if f.FType.Kind() == types.NativeFit && f.FType.BaseType().Float() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (g *codeGenerator) genMarshalJSON(msg *Msg) {
+	g.p()
+	g.p("// MarshalJSON implements the json.Marshaler interface. Fields with")
+	g.p("// invalid values are omitted. Values are scaled and have units attached")
+	g.p("// if defined by the profile, types are encoded using their names and")
+	g.p("// coordinates are encoded in degrees.")
+	g.p("func (x *", msg.CCName, "Msg) MarshalJSON() ([]byte, error) {")
+	g.p("e := newJSONEncodeState()")
+	g.p("e.open()")
+	for _, f := range msg.Fields {
+		g.genMarshalJSONField(msg, f)
+	}
+	g.p("e.close()")
+	g.p("return e.done(), nil")
+	g.p("}")
+}
+
+func (g *codeGenerator) genMarshalJSONField(msg *Msg, f *Field) {
+	name := strconv.Quote(f.CCName)
+	units := strconv.Quote(f.unitsValue())
+	value := "x." + f.CCName
+
+	switch f.FType.Kind() {
+	case types.TimeUTC, types.TimeLocal:
+		if f.FType.Array() {
+			panic(fmt.Sprintf("genMarshalJSONField: msg %q: unhandled time array field %q", msg.CCName, f.CCName))
+		}
+		g.p("e.timeField(", name, ", ", value, ")")
+		return
+	case types.Lat:
+		g.p("e.latField(", name, ", ", value, ")")
+		return
+	case types.Lng:
+		g.p("e.lngField(", name, ", ", value, ")")
+		return
+	}
+
+	if f.FType.Array() {
+		g.genMarshalJSONArrayField(f, name, units, value)
+		return
+	}
+
+	bt := f.FType.BaseType()
+	switch {
+	case bt == types.BaseString:
+		g.p("if ", value, " != \"\" {")
+		g.p("e.stringField(", name, ", ", value, ")")
+		g.p("}")
+	case bt.Float():
+		g.p("if !math.IsNaN(float64(", value, ")) {")
+		g.p("e.floatField(", name, ", ", f.scaledExpr(value), ", ", units, ")")
+		g.p("}")
+	case f.Scale != "" || f.HasOffset():
+		g.p("if ", value, " != ", bt.GoInvalidValue(), " {")
+		g.p("e.floatField(", name, ", ", f.scaledExpr(value), ", ", units, ")")
+		g.p("}")
+	case f.isEnum():
+		g.p("if ", value, " != ", bt.GoInvalidValue(), " {")
+		g.p("e.enumField(", name, ", ", value, ".String(), uint64(", value, "))")
+		g.p("}")
+	case bt.Signed():
+		g.p("if ", value, " != ", bt.GoInvalidValue(), " {")
+		g.p("e.intField(", name, ", int64(", value, "), ", units, ")")
+		g.p("}")
+	default:
+		g.p("if ", value, " != ", bt.GoInvalidValue(), " {")
+		g.p("e.uintField(", name, ", uint64(", value, "), ", units, ")")
+		g.p("}")
+	}
+}
+
+func (g *codeGenerator) genMarshalJSONArrayField(f *Field, name, units, value string) {
+	bt := f.FType.BaseType()
+	g.p("if ", value, " != nil {")
+	g.p("e.key(", name, ")")
+	g.p("e.valueOpen(", units, ")")
+	g.p("e.arrayOpen()")
+	g.p("for i, v := range ", value, " {")
+	g.p("if i > 0 {")
+	g.p("e.c()")
+	g.p("}")
+	switch {
+	case bt == types.BaseString:
+		g.p("e.writeString(v)")
+	case bt.Float():
+		g.p("if math.IsNaN(float64(v)) {")
+		g.p("e.writeNull()")
+		g.p("continue")
+		g.p("}")
+		g.p("e.writeFloat(", f.scaledExpr("v"), ")")
+	default:
+		g.p("if v == ", bt.GoInvalidValue(), " {")
+		g.p("e.writeNull()")
+		g.p("continue")
+		g.p("}")
+		switch {
+		case f.Scale != "" || f.HasOffset():
+			g.p("e.writeFloat(", f.scaledExpr("v"), ")")
+		case bt.Signed():
+			g.p("e.writeInt(int64(v))")
+		default:
+			g.p("e.writeUint(uint64(v))")
+		}
+	}
+	g.p("}")
+	g.p("e.arrayClose()")
+	g.p("e.valueClose(", units, ")")
+	g.p("}")
+}
+
+func (g *codeGenerator) genUnmarshalJSON(msg *Msg) {
+	g.p()
+	g.p("// UnmarshalJSON implements the json.Unmarshaler interface. It accepts")
+	g.p("// the output of MarshalJSON. Fields not present are set invalid.")
+	g.p("func (x *", msg.CCName, "Msg) UnmarshalJSON(data []byte) error {")
+	g.p("*x = *New", msg.CCName, "Msg()")
+	g.p("return unmarshalMsgJSON(data, MesgNum", msg.CCName, ", reflect.ValueOf(x).Elem())")
+	g.p("}")
+}
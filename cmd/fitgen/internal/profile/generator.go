@@ -12,6 +12,7 @@ import (
 type Profile struct {
 	TypesSource            []byte
 	MessagesSource         []byte
+	MessagesJSONSource     []byte
 	ProfileSource          []byte
 	StringerInput          []string
 	MesgNumsWithoutMessage []string
@@ -180,6 +181,10 @@ func (g *Generator) genCode() error {
 	if err != nil {
 		return err
 	}
+	g.p.MessagesJSONSource, err = codeg.generateMsgsJSON(g.msgs)
+	if err != nil {
+		return err
+	}
 	g.p.ProfileSource, err = codeg.generateProfile(g.types, g.msgs)
 	return err
 }
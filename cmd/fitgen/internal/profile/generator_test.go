@@ -45,6 +45,8 @@ func writeProfile(p *profile.Profile, w io.Writer) error {
 	write(p.TypesSource)
 	write([]byte("\n// MESSAGES\n"))
 	write(p.MessagesSource)
+	write([]byte("\n// MESSAGES JSON\n"))
+	write(p.MessagesJSONSource)
 	write([]byte("\n// PROFILE\n"))
 	write(p.ProfileSource)
 	write([]byte("\n// FITSTRINGER TYPE INPUT\n"))
@@ -104,6 +106,7 @@ func readGoldenProfile(path string) (*profile.Profile, error) {
 	headings := []string{
 		"// TYPES",
 		"// MESSAGES",
+		"// MESSAGES JSON",
 		"// PROFILE",
 		"// FITSTRINGER TYPE INPUT",
 		"// MESSAGE NUMS WITHOUT MESSAGE",
@@ -151,6 +154,20 @@ func readGoldenProfile(path string) (*profile.Profile, error) {
 		return nil, fmt.Errorf("format Messages: %w", err)
 	}
 
+	for scanner.Scan() {
+		if strings.HasPrefix(scanner.Text(), headings[i+1]) {
+			i++
+			break
+		}
+		p.MessagesJSONSource = append(p.MessagesJSONSource, scanner.Bytes()...)
+	}
+
+	// Format
+	p.MessagesJSONSource, err = format.Source(p.MessagesJSONSource)
+	if err != nil {
+		return nil, fmt.Errorf("format Messages JSON: %w", err)
+	}
+
 	for scanner.Scan() {
 		if strings.HasPrefix(scanner.Text(), headings[i+1]) {
 			i++
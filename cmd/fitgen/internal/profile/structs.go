@@ -51,6 +51,60 @@ func (f *Field) HasOffset() bool {
 	return !(f.Offset == "0" || f.Offset == "")
 }
 
+// scaleValue returns the field scale as used in the profile field lookup
+// table. Fields without a scale have scale 1.
+func (f *Field) scaleValue() string {
+	if f.Scale == "" {
+		return "1"
+	}
+	return f.Scale
+}
+
+// offsetValue returns the field offset as used in the profile field lookup
+// table. Fields without an offset have offset 0.
+func (f *Field) offsetValue() string {
+	if !f.HasOffset() {
+		return "0"
+	}
+	return f.Offset
+}
+
+// unitsValue returns the field units as used in the profile field lookup
+// table. Units for fields with several components are listed per component
+// in the profile, and are not kept.
+func (f *Field) unitsValue() string {
+	if len(f.Components) > 1 {
+		return ""
+	}
+	return f.Units
+}
+
+// scaledExpr returns a Go expression converting v to a float64 with the
+// field scale and offset applied.
+func (f *Field) scaledExpr(v string) string {
+	expr := "float64(" + v + ")"
+	if f.Scale != "" {
+		expr += " / " + f.Scale
+	}
+	if f.HasOffset() {
+		expr += " - " + f.Offset
+	}
+	return expr
+}
+
+// isEnum reports whether the field type is a named profile type that is
+// encoded by name. Types with a base type larger than 16 bits are bit fields
+// or counters and are encoded as numbers.
+func (f *Field) isEnum() bool {
+	if f.FType.Kind() != types.NativeFit || f.FType.Array() {
+		return false
+	}
+	if f.TypeName == f.FType.BaseType().GoType() {
+		return false
+	}
+	return f.FType.BaseType().Size() <= 2
+}
+
 func (f *Field) HasComment() bool {
 	return f.Comment != ""
 }
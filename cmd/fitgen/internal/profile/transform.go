@@ -131,7 +131,7 @@ func TransformMsgs(pmsgs []*PMsg, ftypes map[string]*Type, handleHRSTQuirk bool,
 		logger.Println("transforming message:", msg.CCName)
 
 		for _, pfield := range pmsg.Fields {
-			f := &Field{data: pfield.Field}
+			f := &Field{data: withFieldQuirks(msg.Name, pfield.Field)}
 			skip, err := f.transform(false, ftypes, handleHRSTQuirk, logger)
 			if err != nil {
 				return nil, err
@@ -163,6 +163,56 @@ func TransformMsgs(pmsgs []*PMsg, ftypes map[string]*Type, handleHRSTQuirk bool,
 	return msgs, nil
 }
 
+// fieldQuirks lists fields that are not enabled in the example product
+// profile of the official workbook, but that the fit package depends on.
+// The value is used as the field's example column.
+var fieldQuirks = map[string]map[string]string{
+	"time_in_zone": {
+		"timestamp":                  "1",
+		"reference_mesg":             "1",
+		"reference_index":            "1",
+		"time_in_hr_zone":            "1",
+		"time_in_speed_zone":         "1",
+		"time_in_cadence_zone":       "1",
+		"time_in_power_zone":         "1",
+		"hr_zone_high_boundary":      "1",
+		"speed_zone_high_boundary":   "1",
+		"cadence_zone_high_bondary":  "1",
+		"power_zone_high_boundary":   "1",
+		"hr_calc_type":               "1",
+		"max_heart_rate":             "1",
+		"resting_heart_rate":         "1",
+		"threshold_heart_rate":       "1",
+		"pwr_calc_type":              "1",
+		"functional_threshold_power": "1",
+	},
+	"beat_intervals": {
+		"timestamp":    "1",
+		"timestamp_ms": "1",
+		"time":         "1",
+	},
+}
+
+func enabled(data []string) bool {
+	return data[mEXAMPLE] != "" && data[mEXAMPLE] != "0"
+}
+
+// withFieldQuirks returns the raw field data with the example column set
+// for fields listed in fieldQuirks.
+func withFieldQuirks(msgName string, data []string) []string {
+	if enabled(data) {
+		return data
+	}
+	example, found := fieldQuirks[msgName][data[mFNAME]]
+	if !found {
+		return data
+	}
+	quirked := make([]string, len(data))
+	copy(quirked, data)
+	quirked[mEXAMPLE] = example
+	return quirked
+}
+
 func (f *Field) setLength() {
 	if f.Array == "N" || f.FType.BaseType() == types.BaseString {
 		// Profile-defined length
@@ -177,7 +227,7 @@ func (f *Field) setLength() {
 }
 
 func (f *Field) transform(subfield bool, ftypes map[string]*Type, handleHRSTQuirk bool, logger *log.Logger) (skip bool, err error) {
-	if f.data[mEXAMPLE] == "" || f.data[mEXAMPLE] == "0" {
+	if !enabled(f.data) {
 		if f.data[mFNAME] == "heart_rate_source_type" && handleHRSTQuirk {
 			// Don't skip this specific field if we're instructed not to do so.
 			//
@@ -0,0 +1,82 @@
+package profile_test
+
+import (
+	"io"
+	"log"
+	"reflect"
+	"testing"
+
+	"github.com/tormoder/fit/cmd/fitgen/internal/profile"
+)
+
+// pfield returns a raw message sheet row for a field with the given
+// example column value.
+func pfield(defNum, name, ftype, array, example string) *profile.PField {
+	row := make([]string, 16)
+	row[1] = defNum
+	row[2] = name
+	row[3] = ftype
+	row[4] = array
+	row[15] = example
+	return &profile.PField{Field: row}
+}
+
+func pmsg(name string, fields ...*profile.PField) *profile.PMsg {
+	header := make([]string, 16)
+	header[0] = name
+	return &profile.PMsg{Header: header, Fields: fields}
+}
+
+func TestTransformMsgsFieldQuirks(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *profile.PMsg
+		want []string
+	}{
+		{
+			name: "time_in_zone",
+			msg: pmsg("time_in_zone",
+				pfield("2", "time_in_hr_zone", "uint32", "[N]", ""),
+				pfield("10", "power_zone_high_boundary", "uint16", "[N]", "0"),
+				pfield("16", "functional_threshold_power", "uint16", "", ""),
+				pfield("99", "not_a_quirk", "uint16", "", ""),
+			),
+			want: []string{"TimeInHrZone", "PowerZoneHighBoundary", "FunctionalThresholdPower"},
+		},
+		{
+			name: "beat_intervals",
+			msg: pmsg("beat_intervals",
+				pfield("253", "timestamp", "uint32", "", ""),
+				pfield("0", "timestamp_ms", "uint16", "", ""),
+				pfield("1", "time", "uint16", "[N]", ""),
+			),
+			want: []string{"Timestamp", "TimestampMs", "Time"},
+		},
+		{
+			name: "other message",
+			msg: pmsg("session",
+				pfield("2", "time_in_hr_zone", "uint32", "[N]", ""),
+				pfield("3", "total_cycles", "uint32", "", "1"),
+			),
+			want: []string{"TotalCycles"},
+		},
+	}
+
+	logger := log.New(io.Discard, "", 0)
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			msgs, err := profile.TransformMsgs([]*profile.PMsg{test.msg}, nil, false, logger)
+			if err != nil {
+				t.Fatalf("got error, want none; error is: %v", err)
+			}
+			var got []string
+			for _, f := range msgs[0].Fields {
+				got = append(got, f.CCName)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got fields %v, want %v", got, test.want)
+			}
+		})
+	}
+}
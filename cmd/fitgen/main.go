@@ -65,10 +65,11 @@ func main() {
 	l.Println("fit source output directory:", fitSrcDir)
 
 	var (
-		messagesOut    = filepath.Join(fitSrcDir, "messages.go")
-		typesOut       = filepath.Join(fitSrcDir, "types.go")
-		profileOut     = filepath.Join(fitSrcDir, "profile.go")
-		typesStringOut = filepath.Join(fitSrcDir, "types_string.go")
+		messagesOut     = filepath.Join(fitSrcDir, "messages.go")
+		messagesJSONOut = filepath.Join(fitSrcDir, "messages_json.go")
+		typesOut        = filepath.Join(fitSrcDir, "types.go")
+		profileOut      = filepath.Join(fitSrcDir, "profile.go")
+		typesStringOut  = filepath.Join(fitSrcDir, "types_string.go")
 	)
 
 	var (
@@ -134,6 +135,10 @@ func main() {
 		l.Fatalf("typegen: error writing messages output file: %v", err)
 	}
 
+	if err = os.WriteFile(messagesJSONOut, fitProfile.MessagesJSONSource, 0o600); err != nil {
+		l.Fatalf("typegen: error writing messages json output file: %v", err)
+	}
+
 	if err = os.WriteFile(profileOut, fitProfile.ProfileSource, 0o600); err != nil {
 		l.Fatalf("typegen: error writing profile output file: %v", err)
 	}
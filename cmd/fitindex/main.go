@@ -0,0 +1,112 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/tormoder/fit/catalog"
+)
+
+func main() {
+	catalogPath := flag.String(
+		"catalog",
+		"",
+		"path of JSON catalog to update, created if it does not exist (required)",
+	)
+	csvPath := flag.String(
+		"csv",
+		"",
+		"path to also write the catalog as CSV to, or - for stdout",
+	)
+	quiet := flag.Bool(
+		"q",
+		false,
+		"do not print update statistics",
+	)
+
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: fitindex [flags] [directory]\n")
+		flag.PrintDefaults()
+	}
+
+	flag.Parse()
+	if flag.NArg() != 1 || *catalogPath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	c, err := readCatalog(*catalogPath)
+	if err != nil {
+		fatalf("error reading catalog: %v", err)
+	}
+	stats, err := c.Update(flag.Arg(0))
+	if err != nil {
+		fatalf("error updating catalog: %v", err)
+	}
+	if err := write(*catalogPath, c.WriteJSON); err != nil {
+		fatalf("error writing catalog: %v", err)
+	}
+	if *csvPath != "" {
+		if err := write(*csvPath, c.WriteCSV); err != nil {
+			fatalf("error writing csv: %v", err)
+		}
+	}
+	if !*quiet {
+		fmt.Fprintf(os.Stderr, "%d files: %d unchanged, %d reused, %d indexed, %d removed\n",
+			len(c.Entries), stats.Unchanged, stats.Reused, stats.Indexed, stats.Removed)
+	}
+}
+
+func readCatalog(path string) (*catalog.Catalog, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return new(catalog.Catalog), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return catalog.ReadJSON(bufio.NewReader(f))
+}
+
+// write writes to the file at path, or stdout if path is "-", using fn. The
+// file is replaced only if fn succeeds.
+func write(path string, fn func(io.Writer) error) error {
+	if path == "-" {
+		w := bufio.NewWriter(os.Stdout)
+		if err := fn(w); err != nil {
+			return err
+		}
+		return w.Flush()
+	}
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	if err := fn(w); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func fatalf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "fitindex: "+format+"\n", args...)
+	os.Exit(1)
+}
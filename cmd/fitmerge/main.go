@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/edit"
+)
+
+func main() {
+	output := flag.String(
+		"o",
+		"",
+		"path of merged output fit file (required)",
+	)
+
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: fitmerge [flags] [activity fit files]\n")
+		flag.PrintDefaults()
+	}
+
+	flag.Parse()
+	if flag.NArg() < 2 || *output == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	files := make([]*fit.File, flag.NArg())
+	for i, path := range flag.Args() {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			fatalf("error reading file: %v", err)
+		}
+		files[i], err = fit.Decode(bytes.NewReader(data))
+		if err != nil {
+			fatalf("error decoding %s: %v", path, err)
+		}
+	}
+
+	merged, err := edit.Merge(files...)
+	if err != nil {
+		fatalf("error merging files: %v", err)
+	}
+	if err := write(*output, merged); err != nil {
+		fatalf("error writing %s: %v", *output, err)
+	}
+}
+
+func write(path string, file *fit.File) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	if err := fit.Encode(w, file, binary.LittleEndian); err != nil {
+		f.Close()
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func fatalf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "fitmerge: "+format+"\n", args...)
+	os.Exit(1)
+}
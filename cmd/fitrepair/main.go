@@ -0,0 +1,83 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/tormoder/fit/edit"
+)
+
+func main() {
+	output := flag.String(
+		"o",
+		"",
+		"path of repaired output fit file (default input path with suffix -repaired.fit)",
+	)
+	quiet := flag.Bool(
+		"q",
+		false,
+		"do not print the repairs made",
+	)
+
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: fitrepair [flags] [fit file]\n")
+		flag.PrintDefaults()
+	}
+
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	path := flag.Arg(0)
+	if *output == "" {
+		*output = strings.TrimSuffix(path, ".fit") + "-repaired.fit"
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		fatalf("error reading file: %v", err)
+	}
+	repaired, res, err := edit.Repair(data)
+	if err != nil {
+		fatalf("error repairing %s: %v", path, err)
+	}
+	if err := os.WriteFile(*output, repaired, 0644); err != nil {
+		fatalf("error writing %s: %v", *output, err)
+	}
+	if !*quiet {
+		report(res, *output)
+	}
+}
+
+func report(res edit.RepairResult, output string) {
+	if res.DataSize != res.HeaderDataSize {
+		fmt.Printf("data size: %d bytes readable, header says %d\n", res.DataSize, res.HeaderDataSize)
+	}
+	if res.DroppedBytes > 0 {
+		fmt.Printf("dropped %d bytes of incomplete or invalid data\n", res.DroppedBytes)
+	}
+	if res.CRCInvalid {
+		fmt.Println("fixed invalid or missing crc")
+	}
+	if res.TimerStopped {
+		fmt.Println("added timer stop event")
+	}
+	if res.LapsAdded > 0 {
+		fmt.Printf("added %d lap(s)\n", res.LapsAdded)
+	}
+	if res.SessionsAdded > 0 {
+		fmt.Printf("added %d session(s)\n", res.SessionsAdded)
+	}
+	if res.ActivityAdded {
+		fmt.Println("added activity message")
+	}
+	fmt.Printf("wrote %s\n", output)
+}
+
+func fatalf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "fitrepair: "+format+"\n", args...)
+	os.Exit(1)
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"runtime"
+	"time"
+)
+
+func main() {
+	addr := flag.String(
+		"addr",
+		"localhost:8080",
+		"address to listen on",
+	)
+	maxSize := flag.Int64(
+		"max-size",
+		32<<20,
+		"maximum size in bytes of a FIT file in a request",
+	)
+	maxConcurrent := flag.Int(
+		"max-concurrent",
+		runtime.NumCPU(),
+		"maximum number of requests handled concurrently",
+	)
+
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: fitserve [flags]\n")
+		flag.PrintDefaults()
+	}
+
+	flag.Parse()
+	if flag.NArg() != 0 || *maxSize <= 0 || *maxConcurrent <= 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           newServer(*maxSize, *maxConcurrent).handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       time.Minute,
+		WriteTimeout:      time.Minute,
+	}
+	log.Printf("fitserve: listening on %s", *addr)
+	log.Fatalf("fitserve: %v", srv.ListenAndServe())
+}
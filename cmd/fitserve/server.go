@@ -0,0 +1,190 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/csv"
+	"github.com/tormoder/fit/gpx"
+)
+
+// server handles requests with a FIT file as the request body. Every request
+// decodes its own copy of the file, so requests share no state except the
+// concurrency limit.
+type server struct {
+	maxSize int64
+
+	// sem limits the number of requests handled concurrently. Requests
+	// arriving when it is full are rejected.
+	sem chan struct{}
+}
+
+func newServer(maxSize int64, maxConcurrent int) *server {
+	return &server{
+		maxSize: maxSize,
+		sem:     make(chan struct{}, maxConcurrent),
+	}
+}
+
+func (s *server) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("/decode", s.fitHandler(decode))
+	mux.Handle("/probe", s.fitHandler(probe))
+	mux.Handle("/check", s.fitHandler(check))
+	mux.Handle("/convert/gpx", s.fitHandler(convertGPX))
+	mux.Handle("/convert/csv", s.fitHandler(convertCSV))
+	return mux
+}
+
+// errRequest is an error caused by the request, e.g. an invalid FIT file,
+// reported with status 422.
+type errRequest struct {
+	err error
+}
+
+func (e errRequest) Error() string {
+	return e.err.Error()
+}
+
+// fitHandler returns a handler calling fn with the FIT file in the request
+// body, enforcing the method, size and concurrency limits.
+func (s *server) fitHandler(fn func(w http.ResponseWriter, data []byte) error) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed, use POST"))
+			return
+		}
+		select {
+		case s.sem <- struct{}{}:
+			defer func() { <-s.sem }()
+		default:
+			w.Header().Set("Retry-After", "1")
+			writeError(w, http.StatusServiceUnavailable, errors.New("too many concurrent requests"))
+			return
+		}
+
+		tooLarge := fmt.Errorf("request body larger than %d bytes", s.maxSize)
+		if r.ContentLength > s.maxSize {
+			writeError(w, http.StatusRequestEntityTooLarge, tooLarge)
+			return
+		}
+		data, err := io.ReadAll(io.LimitReader(r.Body, s.maxSize+1))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("reading request body: %w", err))
+			return
+		}
+		if int64(len(data)) > s.maxSize {
+			writeError(w, http.StatusRequestEntityTooLarge, tooLarge)
+			return
+		}
+
+		if err := fn(w, data); err != nil {
+			var re errRequest
+			if errors.As(err, &re) {
+				writeError(w, http.StatusUnprocessableEntity, re.err)
+				return
+			}
+			writeError(w, http.StatusInternalServerError, err)
+		}
+	})
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, struct {
+		Error string `json:"error"`
+	}{err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func decodeFile(data []byte) (*fit.File, error) {
+	file, err := fit.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, errRequest{err}
+	}
+	return file, nil
+}
+
+// decode responds with the decoded file as JSON.
+func decode(w http.ResponseWriter, data []byte) error {
+	file, err := decodeFile(data)
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(file)
+	if err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, err = w.Write(b)
+	return err
+}
+
+// probe responds with the header and file id of the file as JSON, without
+// decoding the rest of the file.
+func probe(w http.ResponseWriter, data []byte) error {
+	h, fileID, err := fit.DecodeHeaderAndFileID(bytes.NewReader(data))
+	if err != nil {
+		return errRequest{err}
+	}
+	writeJSON(w, http.StatusOK, struct {
+		Header fit.Header
+		FileId *fit.FileIdMsg
+	}{h, &fileID})
+	return nil
+}
+
+// check responds with the result of an integrity check of the file. Failed
+// checks are not request errors.
+func check(w http.ResponseWriter, data []byte) error {
+	res := struct {
+		Valid bool   `json:"valid"`
+		Error string `json:"error,omitempty"`
+	}{Valid: true}
+	if err := fit.CheckIntegrity(bytes.NewReader(data), false); err != nil {
+		res.Valid, res.Error = false, err.Error()
+	}
+	writeJSON(w, http.StatusOK, res)
+	return nil
+}
+
+// convertGPX and convertCSV respond with the file converted to GPX or to the
+// FitCSVTool format. The conversion is buffered, so that conversion errors
+// can be reported.
+func convertGPX(w http.ResponseWriter, data []byte) error {
+	file, err := decodeFile(data)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := gpx.WriteGPX(&buf, file); err != nil {
+		return errRequest{err}
+	}
+	w.Header().Set("Content-Type", "application/gpx+xml")
+	_, err = buf.WriteTo(w)
+	return err
+}
+
+func convertCSV(w http.ResponseWriter, data []byte) error {
+	file, err := decodeFile(data)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := csv.Encode(&buf, file); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "text/csv")
+	_, err = buf.WriteTo(w)
+	return err
+}
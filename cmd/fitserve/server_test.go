@@ -0,0 +1,179 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/tormoder/fit"
+)
+
+func readFile(t *testing.T, elem ...string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join(append([]string{"..", "..", "testdata"}, elem...)...))
+	if err != nil {
+		t.Fatalf("reading file failed: %v", err)
+	}
+	return data
+}
+
+func post(t *testing.T, h http.Handler, path string, body []byte) *httptest.ResponseRecorder {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body)))
+	return rec
+}
+
+func TestEndpoints(t *testing.T) {
+	activity := readFile(t, "me", "activity-small-fenix2-run.fit")
+	corrupt := readFile(t, "corrupt", "activity-filecrc.fit")
+	h := newServer(1<<20, 4).handler()
+
+	tests := []struct {
+		path        string
+		body        []byte
+		status      int
+		contentType string
+		contains    string
+	}{
+		{"/decode", activity, http.StatusOK, "application/json", `"FileId"`},
+		{"/decode", corrupt, http.StatusUnprocessableEntity, "application/json", `"error":"integrity error`},
+		{"/probe", activity, http.StatusOK, "application/json", `"FileId":{"Type":"Activity","Manufacturer":"Garmin"`},
+		{"/probe", []byte("not a fit file"), http.StatusUnprocessableEntity, "application/json", `"error"`},
+		{"/check", activity, http.StatusOK, "application/json", `{"valid":true}`},
+		{"/check", corrupt, http.StatusOK, "application/json", `"valid":false`},
+		{"/convert/gpx", activity, http.StatusOK, "application/gpx+xml", "<trkpt"},
+		{"/convert/csv", activity, http.StatusOK, "text/csv", "Data,"},
+		{"/unknown", activity, http.StatusNotFound, "", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			rec := post(t, h, test.path, test.body)
+			if rec.Code != test.status {
+				t.Fatalf("status: got %d, want %d; body is: %s", rec.Code, test.status, rec.Body)
+			}
+			if ct := rec.Header().Get("Content-Type"); test.contentType != "" && ct != test.contentType {
+				t.Errorf("content type: got %q, want %q", ct, test.contentType)
+			}
+			if !strings.Contains(rec.Body.String(), test.contains) {
+				t.Errorf("body does not contain %q", test.contains)
+			}
+		})
+	}
+}
+
+func TestProbe(t *testing.T) {
+	activity := readFile(t, "me", "activity-small-fenix2-run.fit")
+	wantHeader, wantFileID, err := fit.DecodeHeaderAndFileID(bytes.NewReader(activity))
+	if err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+
+	rec := post(t, newServer(1<<20, 1).handler(), "/probe", activity)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status: got %d, want %d; body is: %s", rec.Code, http.StatusOK, rec.Body)
+	}
+	var got struct {
+		Header fit.Header
+		FileId fit.FileIdMsg
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	if got.Header != wantHeader {
+		t.Errorf("header: got %+v, want %+v", got.Header, wantHeader)
+	}
+	if !reflect.DeepEqual(got.FileId, wantFileID) {
+		t.Errorf("file id: got %+v, want %+v", got.FileId, wantFileID)
+	}
+}
+
+func TestLimits(t *testing.T) {
+	activity := readFile(t, "me", "activity-small-fenix2-run.fit")
+
+	rec := post(t, newServer(int64(len(activity)-1), 1).handler(), "/decode", activity)
+	if rec.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("too large: got status %d, want %d", rec.Code, http.StatusRequestEntityTooLarge)
+	}
+
+	// A request without content length is limited while reading.
+	req := httptest.NewRequest(http.MethodPost, "/decode", io.MultiReader(bytes.NewReader(activity)))
+	req.ContentLength = -1
+	rec = httptest.NewRecorder()
+	newServer(int64(len(activity)-1), 1).handler().ServeHTTP(rec, req)
+	if rec.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("too large, unknown length: got status %d, want %d", rec.Code, http.StatusRequestEntityTooLarge)
+	}
+
+	s := newServer(1<<20, 1)
+	s.sem <- struct{}{}
+	rec = post(t, s.handler(), "/decode", activity)
+	if rec.Code != http.StatusServiceUnavailable || rec.Header().Get("Retry-After") == "" {
+		t.Errorf("busy: got status %d, want %d with Retry-After", rec.Code, http.StatusServiceUnavailable)
+	}
+
+	rec = httptest.NewRecorder()
+	newServer(1<<20, 1).handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/decode", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("get: got status %d, want %d", rec.Code, http.StatusMethodNotAllowed)
+	}
+}
+
+func TestParallel(t *testing.T) {
+	files := [][]byte{
+		readFile(t, "me", "activity-small-fenix2-run.fit"),
+		readFile(t, "dcrainmaker", "Edge810-Vector-2013-08-16-15-35-10.fit"),
+	}
+	const n = 16
+	srv := httptest.NewServer(newServer(1<<20, n).handler())
+	defer srv.Close()
+
+	// Every response must equal the response to the same file sent alone.
+	want := make([]string, len(files))
+	for i, f := range files {
+		want[i] = post(t, newServer(1<<20, 1).handler(), "/decode", f).Body.String()
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan string, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			k := i % len(files)
+			resp, err := http.Post(srv.URL+"/decode", "application/octet-stream", bytes.NewReader(files[k]))
+			if err != nil {
+				errs <- err.Error()
+				return
+			}
+			defer resp.Body.Close()
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				errs <- err.Error()
+				return
+			}
+			if resp.StatusCode != http.StatusOK || string(body) != want[k] {
+				errs <- "response differs from sequential response"
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal([]byte(want[1]), &decoded); err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/edit"
+)
+
+func main() {
+	at := flag.String(
+		"at",
+		"",
+		"comma separated split times, as RFC 3339 times or durations from the first record, e.g. 1h15m",
+	)
+	laps := flag.String(
+		"laps",
+		"",
+		"comma separated indexes of laps to split at the start of, counting from 0",
+	)
+	prefix := flag.String(
+		"o",
+		"",
+		"output path prefix; parts are written to <prefix>-1.fit, <prefix>-2.fit and so on (default input path without extension)",
+	)
+
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: fitsplit [flags] [activity fit file]\n")
+		flag.PrintDefaults()
+	}
+
+	flag.Parse()
+	if flag.NArg() != 1 || (*at == "") == (*laps == "") {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	input := flag.Arg(0)
+	data, err := os.ReadFile(input)
+	if err != nil {
+		fatalf("error reading file: %v", err)
+	}
+	file, err := fit.Decode(bytes.NewReader(data))
+	if err != nil {
+		fatalf("error decoding file: %v", err)
+	}
+
+	var parts []*fit.File
+	if *laps != "" {
+		var indexes []int
+		for _, s := range strings.Split(*laps, ",") {
+			i, err := strconv.Atoi(strings.TrimSpace(s))
+			if err != nil {
+				fatalf("invalid lap index %q", s)
+			}
+			indexes = append(indexes, i)
+		}
+		parts, err = edit.SplitLaps(file, indexes...)
+	} else {
+		var times []time.Time
+		times, err = parseTimes(file, *at)
+		if err != nil {
+			fatalf("%v", err)
+		}
+		parts, err = edit.Split(file, times...)
+	}
+	if err != nil {
+		fatalf("error splitting file: %v", err)
+	}
+
+	if *prefix == "" {
+		*prefix = strings.TrimSuffix(input, filepath.Ext(input))
+	}
+	for i, part := range parts {
+		path := fmt.Sprintf("%s-%d.fit", *prefix, i+1)
+		if err := write(path, part); err != nil {
+			fatalf("error writing %s: %v", path, err)
+		}
+		fmt.Println(path)
+	}
+}
+
+// parseTimes parses comma separated RFC 3339 times or durations from the
+// first record of file.
+func parseTimes(file *fit.File, s string) ([]time.Time, error) {
+	a, err := file.Activity()
+	if err != nil {
+		return nil, err
+	}
+	if len(a.Records) == 0 {
+		return nil, fmt.Errorf("no records")
+	}
+	start := a.Records[0].Timestamp
+
+	var times []time.Time
+	for _, v := range strings.Split(s, ",") {
+		v = strings.TrimSpace(v)
+		if d, err := time.ParseDuration(v); err == nil {
+			times = append(times, start.Add(d))
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid split time %q: must be an RFC 3339 time or a duration", v)
+		}
+		times = append(times, t)
+	}
+	return times, nil
+}
+
+func write(path string, file *fit.File) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	if err := fit.Encode(w, file, binary.LittleEndian); err != nil {
+		f.Close()
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func fatalf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "fitsplit: "+format+"\n", args...)
+	os.Exit(1)
+}
@@ -0,0 +1,196 @@
+// Package course builds course files from routes, e.g. routes planned in
+// other applications and exported as GPX, for navigation on head units.
+//
+// Courses are built from an ordered list of points. The records of the
+// course get the distance along the route, altitudes interpolated where
+// missing, and timestamps for a constant speed. Course points are added for
+// turns and categorized climbs.
+package course
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/gpx"
+	"github.com/tormoder/fit/internal/activity"
+	"github.com/tormoder/fit/summary"
+)
+
+// Point is a point of a route. Altitude is in meters, or NaN if unknown.
+type Point struct {
+	Position fit.Position
+	Altitude float64
+}
+
+// Build returns a new course file for the route through points, which must
+// be at least two valid positions.
+func Build(points []Point, opts ...Option) (*fit.File, error) {
+	o := defaultOptions()
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if len(points) < 2 {
+		return nil, fmt.Errorf("course has %d points, need at least 2", len(points))
+	}
+	for i, p := range points {
+		if p.Position.Invalid() {
+			return nil, fmt.Errorf("point %d: invalid position", i)
+		}
+	}
+	speed := o.speed
+	if speed == 0 {
+		speed = defaultSpeeds[o.sport]
+		if speed == 0 {
+			speed = defaultSpeeds[fit.SportCycling]
+		}
+	}
+	if speed < 0 || math.IsNaN(speed) || math.IsInf(speed, 0) {
+		return nil, fmt.Errorf("invalid speed %v", speed)
+	}
+
+	dists := distances(points)
+	alts := altitudes(points, dists)
+	times := make([]time.Time, len(points))
+	for i, d := range dists {
+		times[i] = o.start.Add(time.Duration(math.Round(d/speed)) * time.Second)
+	}
+
+	records := make([]*fit.RecordMsg, len(points))
+	for i, p := range points {
+		r := fit.NewRecordMsg()
+		r.Timestamp = times[i]
+		r.PositionLat, r.PositionLong = p.Position.Lat, p.Position.Long
+		r.Distance = uint32(activity.Raw(dists[i], 100, 0, 0xFFFFFFFF))
+		r.EnhancedSpeed = uint32(activity.Raw(speed, 1000, 0, 0xFFFFFFFF))
+		r.Speed = uint16(activity.Raw(speed, 1000, 0, 0xFFFF))
+		r.EnhancedAltitude = uint32(activity.Raw(alts[i], 5, 500, 0xFFFFFFFF))
+		r.Altitude = uint16(activity.Raw(alts[i], 5, 500, 0xFFFF))
+		records[i] = r
+	}
+
+	start, end := times[0], times[len(times)-1]
+	events := []*fit.EventMsg{
+		activity.TimerEvent(start, fit.EventTypeStart),
+		activity.TimerEvent(end, fit.EventTypeStopDisableAll),
+	}
+
+	lap := summary.Compute(records, events, start, end).Lap()
+	lap.MessageIndex = 0
+	lap.Event = fit.EventLap
+	lap.EventType = fit.EventTypeStop
+	lap.LapTrigger = fit.LapTriggerManual
+	lap.Sport = o.sport
+
+	var cps []coursePoint
+	if o.turns {
+		cps = append(cps, turns(points, dists, o.turnAngle)...)
+	}
+	if o.climbs {
+		cps = append(cps, climbs(alts, dists)...)
+	}
+	sort.SliceStable(cps, func(i, j int) bool { return cps[i].index < cps[j].index })
+	coursePoints := make([]*fit.CoursePointMsg, len(cps))
+	for i, cp := range cps {
+		m := fit.NewCoursePointMsg()
+		m.MessageIndex = fit.MessageIndex(i)
+		m.Timestamp = times[cp.index]
+		m.PositionLat, m.PositionLong = points[cp.index].Position.Lat, points[cp.index].Position.Long
+		m.Distance = records[cp.index].Distance
+		m.Type = cp.typ
+		m.Name = cp.name
+		coursePoints[i] = m
+	}
+
+	course := fit.NewCourseMsg()
+	course.Name = o.name
+	course.Sport = o.sport
+	course.Capabilities = fit.CourseCapabilitiesValid | fit.CourseCapabilitiesTime |
+		fit.CourseCapabilitiesDistance | fit.CourseCapabilitiesPosition
+	if len(coursePoints) > 0 {
+		course.Capabilities |= fit.CourseCapabilitiesNavigation
+	}
+
+	file, err := fit.NewFile(fit.FileTypeCourse, fit.NewHeader(fit.V20, true))
+	if err != nil {
+		return nil, err
+	}
+	file.FileId.Manufacturer = fit.ManufacturerDevelopment
+	file.FileId.TimeCreated = time.Now().UTC().Truncate(time.Second)
+	cf, err := file.Course()
+	if err != nil {
+		return nil, err
+	}
+	cf.Course = course
+	cf.Lap = lap
+	cf.Records = records
+	cf.Events = events
+	cf.CoursePoints = coursePoints
+	return file, nil
+}
+
+// FromGPX returns a new course file for the first route, or else the first
+// track, with at least two points of a GPX document. The course is named by
+// the route or track unless a name is given as an option.
+func FromGPX(r io.Reader, opts ...Option) (*fit.File, error) {
+	routes, err := gpx.ReadRoutes(r)
+	if err != nil {
+		return nil, err
+	}
+	i := 0
+	for i < len(routes) && len(routes[i].Points) < 2 {
+		i++
+	}
+	if i == len(routes) {
+		return nil, errors.New("gpx document has no routes or tracks with at least 2 points")
+	}
+	route := routes[i]
+	points := make([]Point, len(route.Points))
+	for i, p := range route.Points {
+		points[i] = Point{Position: p.Position, Altitude: p.Elevation}
+	}
+	return Build(points, append([]Option{WithName(route.Name)}, opts...)...)
+}
+
+// distances returns the distance in meters along the route to each point.
+func distances(points []Point) []float64 {
+	dists := make([]float64, len(points))
+	for i := 1; i < len(points); i++ {
+		dists[i] = dists[i-1] + points[i-1].Position.Distance(points[i].Position)
+	}
+	return dists
+}
+
+// altitudes returns the altitudes of the points, with unknown altitudes
+// interpolated by distance from the closest known altitudes, or the closest
+// known altitude at the ends of the route. All altitudes are NaN if none are
+// known.
+func altitudes(points []Point, dists []float64) []float64 {
+	alts := make([]float64, len(points))
+	prev := -1
+	for i, p := range points {
+		alts[i] = p.Altitude
+		if math.IsNaN(p.Altitude) {
+			continue
+		}
+		for j := prev + 1; j < i; j++ {
+			if prev < 0 || dists[i] == dists[prev] {
+				alts[j] = p.Altitude
+				continue
+			}
+			f := (dists[j] - dists[prev]) / (dists[i] - dists[prev])
+			alts[j] = alts[prev] + f*(p.Altitude-alts[prev])
+		}
+		prev = i
+	}
+	if prev >= 0 {
+		for j := prev + 1; j < len(alts); j++ {
+			alts[j] = alts[prev]
+		}
+	}
+	return alts
+}
@@ -0,0 +1,243 @@
+package course_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/course"
+	"github.com/tormoder/fit/gpx"
+)
+
+var start = time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)
+
+// route returns a route of three legs of 1 km with points every 10 m: north,
+// east and north. The altitude is 100 m on the first leg, with every other
+// altitude unknown, rises to 200 m on the second leg and drops to 150 m on
+// the third.
+func route() []course.Point {
+	pos := fit.NewPositionDegrees(59.9, 10.7)
+	points := []course.Point{{Position: pos, Altitude: 100}}
+	for leg, bearing := range []float64{0, 90, 0} {
+		// Each point is placed from the start of the leg, so that the
+		// rounding of positions does not add up along the leg.
+		legStart := pos
+		for i := 1; i <= 100; i++ {
+			pos = legStart.Destination(bearing, float64(i)*10)
+			alt := math.NaN()
+			switch {
+			case leg == 0 && i%2 == 0:
+				alt = 100
+			case leg == 1:
+				alt = 100 + float64(i)
+			case leg == 2:
+				alt = 200 - float64(i)/2
+			}
+			points = append(points, course.Point{Position: pos, Altitude: alt})
+		}
+	}
+	return points
+}
+
+func TestBuild(t *testing.T) {
+	file, err := course.Build(route(), course.WithName("Loop"), course.WithSpeed(5), course.WithStartTime(start))
+	if err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+
+	// Encode and decode the file, to check that it is encodable.
+	var buf bytes.Buffer
+	if err := fit.Encode(&buf, file, binary.LittleEndian); err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	decoded, err := fit.Decode(&buf)
+	if err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	cf, err := decoded.Course()
+	if err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+
+	if c := cf.Course; c.Name != "Loop" || c.Sport != fit.SportCycling || c.Capabilities&fit.CourseCapabilitiesNavigation == 0 {
+		t.Errorf("course: got %+v", *c)
+	}
+	if len(cf.Records) != 301 {
+		t.Fatalf("got %d records, want 301", len(cf.Records))
+	}
+	for i, r := range cf.Records {
+		wantDist := float64(i) * 10
+		if d := r.GetDistanceScaled(); math.Abs(d-wantDist) > 0.05 {
+			t.Errorf("record %d: got distance %v, want %v", i, d, wantDist)
+		}
+		if want := start.Add(time.Duration(i*2) * time.Second); !r.Timestamp.Equal(want) {
+			t.Errorf("record %d: got time %v, want %v", i, r.Timestamp, want)
+		}
+		if i > 0 && i < 100 && r.GetEnhancedAltitudeScaled() != 100 {
+			t.Errorf("record %d: got altitude %v, want 100", i, r.GetEnhancedAltitudeScaled())
+		}
+	}
+
+	lap := cf.Lap
+	if got := lap.GetTotalDistanceScaled(); math.Abs(got-3000) > 0.05 {
+		t.Errorf("lap distance: got %v, want 3000", got)
+	}
+	if got := lap.GetTotalElapsedTimeScaled(); got != 600 {
+		t.Errorf("lap elapsed time: got %v, want 600", got)
+	}
+	if lap.TotalAscent != 100 || lap.TotalDescent != 50 {
+		t.Errorf("lap ascent and descent: got %d and %d, want 100 and 50", lap.TotalAscent, lap.TotalDescent)
+	}
+	if len(cf.Events) != 2 || cf.Events[0].EventType != fit.EventTypeStart || cf.Events[1].EventType != fit.EventTypeStopDisableAll {
+		t.Errorf("got events %+v, want timer start and stop", cf.Events)
+	}
+
+	type point struct {
+		typ  fit.CoursePoint
+		name string
+		dist float64
+	}
+	want := []point{
+		{fit.CoursePointRight, "Right", 1000},
+		{fit.CoursePointFourthCategory, "Cat 4 climb", 1000},
+		{fit.CoursePointLeft, "Left", 2000},
+		{fit.CoursePointSummit, "Summit", 2000},
+	}
+	if len(cf.CoursePoints) != len(want) {
+		t.Fatalf("got %d course points, want %d", len(cf.CoursePoints), len(want))
+	}
+	for i, cp := range cf.CoursePoints {
+		w := want[i]
+		if cp.Type != w.typ || cp.Name != w.name || math.Abs(cp.GetDistanceScaled()-w.dist) > 0.05 {
+			t.Errorf("course point %d: got %v %q at %v m, want %v %q at %v m", i, cp.Type, cp.Name, cp.GetDistanceScaled(), w.typ, w.name, w.dist)
+		}
+		if cp.MessageIndex != fit.MessageIndex(i) {
+			t.Errorf("course point %d: got message index %v", i, cp.MessageIndex)
+		}
+	}
+
+	file, err = course.Build(route(), course.WithoutTurns(), course.WithoutClimbs(), course.WithSport(fit.SportRunning))
+	if err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	cf, _ = file.Course()
+	if len(cf.CoursePoints) != 0 || cf.Course.Capabilities&fit.CourseCapabilitiesNavigation != 0 {
+		t.Errorf("without course points: got %d course points", len(cf.CoursePoints))
+	}
+	if got := cf.Lap.GetTotalElapsedTimeScaled(); got != 1080 {
+		t.Errorf("running lap elapsed time: got %v, want 1080", got)
+	}
+}
+
+func TestBuildActivity(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "testdata", "dcrainmaker", "Edge810-Vector-2013-08-16-15-35-10.fit"))
+	if err != nil {
+		t.Fatalf("reading file failed: %v", err)
+	}
+	file, err := fit.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	activity, err := file.Activity()
+	if err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	var points []course.Point
+	for _, r := range activity.Records {
+		pos := fit.Position{Lat: r.PositionLat, Long: r.PositionLong}
+		if !pos.Invalid() {
+			points = append(points, course.Point{Position: pos, Altitude: r.GetEnhancedAltitudeScaled()})
+		}
+	}
+
+	built, err := course.Build(points, course.WithStartTime(start))
+	if err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	cf, err := built.Course()
+	if err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	want := activity.Sessions[0].GetTotalDistanceScaled()
+	if got := cf.Lap.GetTotalDistanceScaled(); math.Abs(got-want)/want > 0.02 {
+		t.Errorf("distance: got %v, want %v within 2%%", got, want)
+	}
+	var turns int
+	for _, cp := range cf.CoursePoints {
+		if cp.Type != fit.CoursePointSummit && !strings.Contains(cp.Name, "climb") {
+			turns++
+		}
+	}
+	if turns == 0 || turns > len(points)/20 {
+		t.Errorf("got %d turns for %d points", turns, len(points))
+	}
+}
+
+func TestFromGPX(t *testing.T) {
+	file, err := course.Build(route(), course.WithName("Loop"), course.WithStartTime(start))
+	if err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := gpx.WriteGPX(&buf, file); err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	doc := buf.String()
+
+	read, err := course.FromGPX(strings.NewReader(doc), course.WithStartTime(start))
+	if err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	want, _ := file.Course()
+	got, _ := read.Course()
+	if got.Course.Name != "Loop" {
+		t.Errorf("got name %q, want Loop", got.Course.Name)
+	}
+	if len(got.Records) != len(want.Records) || len(got.CoursePoints) != len(want.CoursePoints) {
+		t.Errorf("got %d records and %d course points, want %d and %d",
+			len(got.Records), len(got.CoursePoints), len(want.Records), len(want.CoursePoints))
+	}
+	if d, w := got.Lap.GetTotalDistanceScaled(), want.Lap.GetTotalDistanceScaled(); math.Abs(d-w) > 0.1 {
+		t.Errorf("got distance %v, want %v", d, w)
+	}
+
+	read, err = course.FromGPX(strings.NewReader(doc), course.WithName("Renamed"))
+	if err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	if got, _ := read.Course(); got.Course.Name != "Renamed" {
+		t.Errorf("got name %q, want Renamed", got.Course.Name)
+	}
+
+	if _, err := course.FromGPX(strings.NewReader(`<gpx><trk><trkseg><trkpt lat="1" lon="2"/></trkseg></trk></gpx>`)); err == nil {
+		t.Error("one point: got no error, want error")
+	}
+}
+
+func TestBuildErrors(t *testing.T) {
+	p := course.Point{Position: fit.NewPositionDegrees(59.9, 10.7), Altitude: math.NaN()}
+	invalid := course.Point{Position: fit.Position{Lat: fit.NewLatitudeInvalid(), Long: fit.NewLongitudeInvalid()}}
+	tests := []struct {
+		name   string
+		points []course.Point
+		opts   []course.Option
+	}{
+		{"no points", nil, nil},
+		{"one point", []course.Point{p}, nil},
+		{"invalid position", []course.Point{p, invalid}, nil},
+		{"negative speed", []course.Point{p, p}, []course.Option{course.WithSpeed(-1)}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := course.Build(test.points, test.opts...); err == nil {
+				t.Error("got no error, want error")
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package course
+
+import (
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+type options struct {
+	name      string
+	sport     fit.Sport
+	speed     float64
+	start     time.Time
+	turns     bool
+	turnAngle float64
+	climbs    bool
+}
+
+func defaultOptions() options {
+	return options{
+		sport:     fit.SportCycling,
+		start:     time.Now().UTC().Truncate(time.Second),
+		turns:     true,
+		turnAngle: 45,
+		climbs:    true,
+	}
+}
+
+// defaultSpeeds are the default speeds in m/s by sport. Other sports use
+// the speed for cycling.
+var defaultSpeeds = map[fit.Sport]float64{
+	fit.SportCycling: 25 / 3.6,
+	fit.SportRunning: 10 / 3.6,
+	fit.SportWalking: 5 / 3.6,
+	fit.SportHiking:  4 / 3.6,
+}
+
+// Option configures Build.
+type Option func(*options)
+
+// WithName sets the name of the course. For courses read from GPX documents
+// the name of the route or track is used by default.
+func WithName(name string) Option {
+	return func(o *options) {
+		o.name = name
+	}
+}
+
+// WithSport sets the sport of the course. The default is cycling.
+func WithSport(sport fit.Sport) Option {
+	return func(o *options) {
+		o.sport = sport
+	}
+}
+
+// WithSpeed sets the speed in m/s used for the timestamps of the course,
+// which head units use for virtual partners and arrival times. The default
+// depends on the sport: 25 km/h for cycling, 10 km/h for running, 5 km/h for
+// walking and 4 km/h for hiking.
+func WithSpeed(speed float64) Option {
+	return func(o *options) {
+		o.speed = speed
+	}
+}
+
+// WithStartTime sets the time of the start of the course. The default is
+// the current time.
+func WithStartTime(t time.Time) Option {
+	return func(o *options) {
+		o.start = t
+	}
+}
+
+// WithTurnAngle sets the minimum change of direction in degrees for adding
+// a turn course point. The default is 45 degrees.
+func WithTurnAngle(degrees float64) Option {
+	return func(o *options) {
+		o.turnAngle = degrees
+	}
+}
+
+// WithoutTurns disables adding turn course points.
+func WithoutTurns() Option {
+	return func(o *options) {
+		o.turns = false
+	}
+}
+
+// WithoutClimbs disables adding climb and summit course points.
+func WithoutClimbs() Option {
+	return func(o *options) {
+		o.climbs = false
+	}
+}
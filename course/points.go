@@ -0,0 +1,164 @@
+package course
+
+import (
+	"math"
+
+	"github.com/tormoder/fit"
+)
+
+// coursePoint is a course point at the point of the route with the given
+// index.
+type coursePoint struct {
+	index int
+	typ   fit.CoursePoint
+	name  string
+}
+
+// turnDistance is the distance in meters before and after a point used for
+// the directions into and out of the point. It makes turns in dense tracks,
+// with points every few meters, be found as a single sharp change of
+// direction rather than many small ones. Turns closer than this are
+// reported as one.
+const turnDistance = 25.0
+
+// turns returns course points for the points where the direction of the
+// route changes by at least minAngle degrees.
+func turns(points []Point, dists []float64, minAngle float64) []coursePoint {
+	var (
+		cps       []coursePoint
+		best      = -1
+		bestAngle float64
+		last      int
+	)
+	flush := func() {
+		if best >= 0 {
+			typ, name := turnType(bestAngle)
+			cps = append(cps, coursePoint{index: best, typ: typ, name: name})
+		}
+		best = -1
+	}
+
+	a, b := 0, 0
+	for i := 1; i < len(points)-1; i++ {
+		for a+1 < i && dists[i]-dists[a+1] >= turnDistance {
+			a++
+		}
+		if b < i+1 {
+			b = i + 1
+		}
+		for b < len(points)-1 && dists[b]-dists[i] < turnDistance {
+			b++
+		}
+		if dists[i] == dists[a] || dists[b] == dists[i] {
+			continue
+		}
+		in := points[a].Position.Bearing(points[i].Position)
+		out := points[i].Position.Bearing(points[b].Position)
+		angle := math.Mod(out-in+540, 360) - 180
+		if math.Abs(angle) < minAngle {
+			continue
+		}
+		if best >= 0 && dists[i]-dists[last] >= turnDistance {
+			flush()
+		}
+		if best < 0 || math.Abs(angle) > math.Abs(bestAngle) {
+			best, bestAngle = i, angle
+		}
+		last = i
+	}
+	flush()
+	return cps
+}
+
+// turnType returns the course point type and name of a turn by the given
+// angle in degrees, negative for left turns.
+func turnType(angle float64) (fit.CoursePoint, string) {
+	left := angle < 0
+	switch abs := math.Abs(angle); {
+	case abs < 60:
+		if left {
+			return fit.CoursePointSlightLeft, "Slight left"
+		}
+		return fit.CoursePointSlightRight, "Slight right"
+	case abs < 120:
+		if left {
+			return fit.CoursePointLeft, "Left"
+		}
+		return fit.CoursePointRight, "Right"
+	case abs < 160:
+		if left {
+			return fit.CoursePointSharpLeft, "Sharp left"
+		}
+		return fit.CoursePointSharpRight, "Sharp right"
+	default:
+		return fit.CoursePointUTurn, "U-turn"
+	}
+}
+
+// Climbs are found as rises in altitude not interrupted by descents of more
+// than climbTolerance meters, with an average grade of at least
+// minClimbGrade percent. They are categorized by their score, the distance
+// in meters times the average grade in percent, as done by Strava.
+const (
+	climbTolerance = 10.0
+	minClimbGrade  = 3.0
+)
+
+var climbCategories = []struct {
+	score float64
+	typ   fit.CoursePoint
+	name  string
+}{
+	{64000, fit.CoursePointHorsCategory, "HC climb"},
+	{48000, fit.CoursePointFirstCategory, "Cat 1 climb"},
+	{32000, fit.CoursePointSecondCategory, "Cat 2 climb"},
+	{16000, fit.CoursePointThirdCategory, "Cat 3 climb"},
+	{8000, fit.CoursePointFourthCategory, "Cat 4 climb"},
+}
+
+// climbs returns course points for the start, by category, and the summit
+// of the categorized climbs of the route.
+func climbs(alts, dists []float64) []coursePoint {
+	if len(alts) == 0 || math.IsNaN(alts[0]) {
+		return nil
+	}
+	var cps []coursePoint
+	climb := func(lo, hi int) {
+		dist := dists[hi] - dists[lo]
+		if dist <= 0 {
+			return
+		}
+		grade := (alts[hi] - alts[lo]) / dist * 100
+		if grade < minClimbGrade {
+			return
+		}
+		for _, c := range climbCategories {
+			if dist*grade >= c.score {
+				cps = append(cps,
+					coursePoint{index: lo, typ: c.typ, name: c.name},
+					coursePoint{index: hi, typ: fit.CoursePointSummit, name: "Summit"},
+				)
+				return
+			}
+		}
+	}
+
+	// lo is the lowest point before the climb, and hi the highest point
+	// of the climb so far.
+	lo, hi := 0, 0
+	for i := 1; i < len(alts); i++ {
+		switch {
+		case alts[i] > alts[hi]:
+			hi = i
+		case alts[i] < alts[hi]-climbTolerance:
+			climb(lo, hi)
+			lo, hi = i, i
+		case alts[i] <= alts[lo]:
+			// The rise so far is within the tolerance, and too small
+			// to be a climb.
+			lo, hi = i, i
+		}
+	}
+	climb(lo, hi)
+	return cps
+}
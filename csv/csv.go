@@ -0,0 +1,42 @@
+// Package csv implements encoding and decoding of FIT files in the CSV format
+// used by the FitCSVTool of the FIT SDK.
+//
+// Every row starts with the row type (Definition or Data), the local message
+// number and the profile name of the message, e.g. "record". This is followed
+// by a field name, value and units triple for every valid field in the
+// message. Definition rows hold the number of values in each field instead of
+// the field value.
+//
+// Values are written as stored in the FIT file, except that scale and offset
+// is applied to scaled fields: types are written as numbers, date_time fields
+// as seconds since the FIT epoch and coordinates in semicircles. Values of
+// array fields are separated by '|'.
+package csv
+
+import (
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+const (
+	rowDefinition = "Definition"
+	rowData       = "Data"
+	rowHeader     = "Type"
+
+	// Messages the FitCSVTool was unable to identify.
+	mesgUnknown = "unknown"
+
+	arraySep = "|"
+
+	// The FitCSVTool starts its output with a UTF-8 byte order mark.
+	byteOrderMark = "\ufeff"
+
+	maxLocalMesgs = 16
+)
+
+var timeBase = time.Date(1989, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+func isScaled(pf fit.ProfileField) bool {
+	return pf.Scale != 1 || pf.Offset != 0
+}
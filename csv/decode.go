@@ -0,0 +1,253 @@
+package csv
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+// Decode reads a FIT file in the FitCSVTool format from r. The returned file
+// can be written using fit.Encode.
+//
+// Only data rows are used; definition rows and the header row are skipped.
+// The first data row must hold the file_id message. Subfields, as written by
+// the FitCSVTool for dynamic fields, are decoded into their main field. Rows
+// for unknown messages and fields with names not found in the profile, for
+// example developer fields, are ignored. Units are not checked.
+func Decode(r io.Reader) (*fit.File, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	d := decoder{byName: make(map[fit.MesgNum]map[string]fit.ProfileField)}
+	var file *fit.File
+	for {
+		rec, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := cr.FieldPos(0)
+		if line == 1 {
+			rec[0] = strings.TrimPrefix(rec[0], byteOrderMark)
+		}
+
+		switch rec[0] {
+		case rowHeader, rowDefinition:
+			continue
+		case rowData:
+		default:
+			return nil, fmt.Errorf("line %d: unknown row type %q", line, rec[0])
+		}
+		if len(rec) < 3 {
+			return nil, fmt.Errorf("line %d: too few columns", line)
+		}
+		if rec[2] == mesgUnknown {
+			continue
+		}
+
+		msg, err := d.decodeMesg(rec[2], rec[3:])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		if file == nil {
+			fileID, ok := msg.(*fit.FileIdMsg)
+			if !ok {
+				return nil, fmt.Errorf("line %d: first data message must be file_id, got %s", line, rec[2])
+			}
+			file, err = fit.NewFile(fileID.Type, fit.NewHeader(fit.V20, true))
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+			file.FileId = *fileID
+			continue
+		}
+		if err = file.Add(msg); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+
+	if file == nil {
+		return nil, errors.New("no file_id message found")
+	}
+	return file, nil
+}
+
+type decoder struct {
+	byName map[fit.MesgNum]map[string]fit.ProfileField
+}
+
+func (d *decoder) fields(mn fit.MesgNum) map[string]fit.ProfileField {
+	if m, found := d.byName[mn]; found {
+		return m
+	}
+	m := make(map[string]fit.ProfileField)
+	byNum := make(map[byte]fit.ProfileField)
+	for _, pf := range fit.ProfileFields(mn) {
+		m[pf.Name] = pf
+		byNum[pf.Num] = pf
+	}
+	for _, sf := range fit.ProfileSubfields(mn) {
+		pf, found := byNum[sf.Num]
+		if _, dup := m[sf.Name]; !found || dup {
+			continue
+		}
+		pf.Name, pf.Scale, pf.Offset, pf.Units = sf.Name, sf.Scale, sf.Offset, sf.Units
+		m[sf.Name] = pf
+	}
+	d.byName[mn] = m
+	return m
+}
+
+func (d *decoder) decodeMesg(name string, cols []string) (interface{}, error) {
+	mn, found := fit.MesgNumByName(name)
+	if !found {
+		return nil, fmt.Errorf("unknown message %q", name)
+	}
+	msg, _ := fit.NewMesg(mn)
+	msgv := reflect.ValueOf(msg).Elem()
+
+	fields := d.fields(mn)
+	for i := 0; i+1 < len(cols); i += 3 {
+		fname, value := cols[i], cols[i+1]
+		if fname == "" || value == "" {
+			continue
+		}
+		pf, found := fields[fname]
+		if !found {
+			continue
+		}
+		if err := parseValue(pf, msgv.Field(pf.Index), value); err != nil {
+			return nil, fmt.Errorf("%s: field %s: %w", name, fname, err)
+		}
+	}
+	return msg, nil
+}
+
+func parseValue(pf fit.ProfileField, v reflect.Value, s string) error {
+	switch v.Interface().(type) {
+	case time.Time:
+		secs, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(timeBase.Add(time.Duration(secs) * time.Second)))
+		return nil
+	case fit.Latitude:
+		semi, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(fit.NewLatitude(int32(semi))))
+		return nil
+	case fit.Longitude:
+		semi, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(fit.NewLongitude(int32(semi))))
+		return nil
+	}
+
+	if v.Kind() != reflect.Slice {
+		// The FitCSVTool appends values expanded from components to the
+		// value of a field that is also present in the message. The first
+		// value is the one in the message.
+		if i := strings.Index(s, arraySep); i >= 0 && v.Kind() != reflect.String {
+			s = s[:i]
+		}
+		return parseScalar(pf, v, s)
+	}
+	elems := strings.Split(s, arraySep)
+	slice := reflect.MakeSlice(v.Type(), len(elems), len(elems))
+	for i, elem := range elems {
+		ev := slice.Index(i)
+		if elem == "" {
+			ev.Set(reflect.ValueOf(pf.Invalid()).Convert(ev.Type()))
+			continue
+		}
+		if err := parseScalar(pf, ev, elem); err != nil {
+			return err
+		}
+	}
+	v.Set(slice)
+	return nil
+}
+
+func parseScalar(pf fit.ProfileField, v reflect.Value, s string) error {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return err
+		}
+		v.SetFloat((f + pf.Offset) * pf.Scale)
+		return nil
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil || isScaled(pf) {
+			f, ferr := parseUnscaled(pf, s)
+			if ferr != nil {
+				return ferr
+			}
+			if f < math.MinInt64 || f >= math.MaxInt64 {
+				return fmt.Errorf("value out of range: %s", s)
+			}
+			i = int64(f)
+		}
+		if v.OverflowInt(i) {
+			return fmt.Errorf("value out of range: %s", s)
+		}
+		v.SetInt(i)
+		return nil
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(s, 10, 64)
+		if err != nil || isScaled(pf) {
+			f, ferr := parseUnscaled(pf, s)
+			if ferr != nil {
+				return ferr
+			}
+			if f < 0 || f >= math.MaxUint64 {
+				return fmt.Errorf("value out of range: %s", s)
+			}
+			u = uint64(f)
+		}
+		if v.OverflowUint(u) {
+			return fmt.Errorf("value out of range: %s", s)
+		}
+		v.SetUint(u)
+		return nil
+	default:
+		return fmt.Errorf("unsupported field type %v", v.Type())
+	}
+}
+
+// parseUnscaled parses a possibly scaled value and returns the raw value,
+// rounded to the nearest integer. Unscaled integer fields written with a
+// decimal point, e.g. "1.0", are accepted as long as they are whole numbers.
+func parseUnscaled(pf fit.ProfileField, s string) (float64, error) {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, err
+	}
+	if !isScaled(pf) {
+		if f != math.Trunc(f) {
+			return 0, fmt.Errorf("not an integer: %s", s)
+		}
+		return f, nil
+	}
+	return math.Round((f + pf.Offset) * pf.Scale), nil
+}
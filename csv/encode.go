@@ -0,0 +1,218 @@
+package csv
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+type row struct {
+	typ    string
+	local  byte
+	mesg   string
+	fields []rowField
+}
+
+type rowField struct {
+	name, value, units string
+}
+
+// Encode writes file to w in the FitCSVTool format. Messages are written in
+// the order given by file.Messages. A definition row is written before the
+// first data row of every message type, and whenever the set of valid fields
+// changes. Unknown messages, unknown fields and developer data are not
+// written.
+func Encode(w io.Writer, file *fit.File) error {
+	var (
+		rows      []row
+		locals    = make(map[fit.MesgNum]byte)
+		layouts   [maxLocalMesgs]string
+		maxFields int
+	)
+
+	for _, msg := range file.Messages() {
+		mn, found := fit.MesgNumOf(msg)
+		if !found {
+			return fmt.Errorf("unknown message type %T", msg)
+		}
+		def, data := encodeMesg(mn, reflect.ValueOf(msg).Elem())
+
+		local, found := locals[mn]
+		if !found {
+			local = byte(len(locals) % maxLocalMesgs)
+			locals[mn] = local
+		}
+		def.local, data.local = local, local
+
+		if layout := layoutKey(def); layouts[local] != layout {
+			rows = append(rows, def)
+			layouts[local] = layout
+		}
+		rows = append(rows, data)
+
+		if len(data.fields) > maxFields {
+			maxFields = len(data.fields)
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	bw.WriteString(rowHeader + ",Local Number,Message,")
+	for i := 1; i <= maxFields; i++ {
+		fmt.Fprintf(bw, "Field %d,Value %d,Units %d,", i, i, i)
+	}
+	bw.WriteByte('\n')
+
+	for _, r := range rows {
+		bw.WriteString(r.typ)
+		bw.WriteByte(',')
+		bw.WriteString(strconv.Itoa(int(r.local)))
+		bw.WriteByte(',')
+		bw.WriteString(r.mesg)
+		bw.WriteByte(',')
+		for _, f := range r.fields {
+			bw.WriteString(quoteIfNeeded(f.name))
+			bw.WriteByte(',')
+			if r.typ == rowData {
+				bw.WriteString(quote(f.value))
+			} else {
+				bw.WriteString(f.value)
+			}
+			bw.WriteByte(',')
+			bw.WriteString(quoteIfNeeded(f.units))
+			bw.WriteByte(',')
+		}
+		bw.WriteByte('\n')
+	}
+
+	return bw.Flush()
+}
+
+func encodeMesg(mn fit.MesgNum, msgv reflect.Value) (def, data row) {
+	name := fit.MesgName(mn)
+	def = row{typ: rowDefinition, mesg: name}
+	data = row{typ: rowData, mesg: name}
+	for _, pf := range fit.ProfileFields(mn) {
+		value, count, valid := formatValue(pf, msgv.Field(pf.Index))
+		if !valid {
+			continue
+		}
+		def.fields = append(def.fields, rowField{name: pf.Name, value: strconv.Itoa(count)})
+		data.fields = append(data.fields, rowField{name: pf.Name, value: value, units: pf.Units})
+	}
+	return def, data
+}
+
+func layoutKey(def row) string {
+	var sb strings.Builder
+	sb.WriteString(def.mesg)
+	for _, f := range def.fields {
+		sb.WriteByte(',')
+		sb.WriteString(f.name)
+		sb.WriteByte(':')
+		sb.WriteString(f.value)
+	}
+	return sb.String()
+}
+
+// formatValue returns the string representation of a field value and the
+// number of values in the field. It reports false if the field is invalid.
+func formatValue(pf fit.ProfileField, v reflect.Value) (string, int, bool) {
+	switch x := v.Interface().(type) {
+	case time.Time:
+		if x.IsZero() || fit.IsBaseTime(x) {
+			return "", 0, false
+		}
+		_, offs := x.Zone()
+		secs := int64(x.Sub(timeBase)/time.Second) + int64(offs)
+		return strconv.FormatInt(secs, 10), 1, true
+	case fit.Latitude:
+		if x.Invalid() {
+			return "", 0, false
+		}
+		return strconv.FormatInt(int64(x.Semicircles()), 10), 1, true
+	case fit.Longitude:
+		if x.Invalid() {
+			return "", 0, false
+		}
+		return strconv.FormatInt(int64(x.Semicircles()), 10), 1, true
+	case string:
+		if x == "" {
+			return "", 0, false
+		}
+		return x, len(x) + 1, true
+	}
+
+	if v.Kind() != reflect.Slice {
+		s, valid := formatScalar(pf, v)
+		return s, 1, valid
+	}
+	if v.Len() == 0 {
+		return "", 0, false
+	}
+	elems := make([]string, v.Len())
+	valid := false
+	for i := range elems {
+		// Invalid array elements are written as empty values. Arrays
+		// with only invalid elements are left out, as by the FitCSVTool.
+		var ok bool
+		elems[i], ok = formatScalar(pf, v.Index(i))
+		valid = valid || ok
+	}
+	return strings.Join(elems, arraySep), len(elems), valid
+}
+
+func formatScalar(pf fit.ProfileField, v reflect.Value) (string, bool) {
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		if math.IsNaN(f) {
+			return "", false
+		}
+		return formatFloat(f/pf.Scale - pf.Offset), true
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if pf.IsInvalid(v) {
+			return "", false
+		}
+		i := v.Int()
+		if isScaled(pf) {
+			return formatFloat(float64(i)/pf.Scale - pf.Offset), true
+		}
+		return strconv.FormatInt(i, 10), true
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if pf.IsInvalid(v) {
+			return "", false
+		}
+		u := v.Uint()
+		if isScaled(pf) {
+			return formatFloat(float64(u)/pf.Scale - pf.Offset), true
+		}
+		return strconv.FormatUint(u, 10), true
+	case reflect.String:
+		s := v.String()
+		return s, s != ""
+	default:
+		return "", false
+	}
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func quote(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
+
+func quoteIfNeeded(s string) string {
+	if strings.ContainsAny(s, "\",\r\n") {
+		return quote(s)
+	}
+	return s
+}
@@ -0,0 +1,327 @@
+// Package diff compares FIT files at the message level, e.g. to see what
+// changed between files recorded with different firmware versions, or
+// between files encoded with different versions of this library.
+//
+// Messages are aligned by type and, within a type, by message index or
+// timestamp, so that a message inserted in one file does not make all
+// following messages differ. Field values are compared as scaled values
+// using the FIT profile, with optional tolerances for floating point values.
+// Unknown messages and fields are not compared.
+package diff
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+
+	"github.com/tormoder/fit"
+)
+
+// Kind is the kind of a difference.
+type Kind int
+
+// Kinds of differences.
+const (
+	Added Kind = iota
+	Removed
+	Changed
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Added:
+		return "added"
+	case Removed:
+		return "removed"
+	case Changed:
+		return "changed"
+	}
+	return "Kind(" + strconv.Itoa(int(k)) + ")"
+}
+
+// Difference is a difference between two FIT files: a message only found in
+// the second file (Added), a message only found in the first (Removed), or a
+// field of a message found in both with different values (Changed).
+type Difference struct {
+	Kind Kind
+
+	// Message is the profile name of the message, e.g. "record", or
+	// "header" for the file header. Key identifies the message among the
+	// messages of its type, e.g. "timestamp=2013-08-16T15:35:10Z",
+	// "message_index=2" or "#3" for the third message of a type with
+	// neither message index nor timestamp. Keys shared by several messages
+	// get an occurrence suffix, e.g. "timestamp=2013-08-16T15:35:10Z#2".
+	Message string
+	Key     string
+
+	// Field is the profile name of the changed field, and Units its
+	// units. A and B are the scaled values of the field in the first and
+	// second file, formatted as text, or empty if invalid. They are empty
+	// for added and removed messages.
+	Field string
+	Units string
+	A, B  string
+}
+
+func (d Difference) String() string {
+	msg := d.Message
+	if d.Key != "" {
+		msg += "[" + d.Key + "]"
+	}
+	switch d.Kind {
+	case Added:
+		return "+ " + msg
+	case Removed:
+		return "- " + msg
+	}
+	return fmt.Sprintf("~ %s.%s: %s -> %s", msg, d.Field, d.formatted(d.A), d.formatted(d.B))
+}
+
+func (d Difference) formatted(v string) string {
+	if v == "" {
+		return "invalid"
+	}
+	if d.Units == "" {
+		return v
+	}
+	return v + " " + d.Units
+}
+
+type options struct {
+	tolerance       float64
+	fieldTolerances map[string]float64
+	ignored         map[string]bool
+}
+
+// Option configures Compare.
+type Option func(*options)
+
+// WithTolerance sets the absolute tolerance for floating point values:
+// fields with a floating point base type, scaled fields and positions, in
+// degrees. Scaled values differing by at most the tolerance are taken to be
+// equal. The default tolerance is zero.
+func WithTolerance(tolerance float64) Option {
+	return func(o *options) {
+		o.tolerance = tolerance
+	}
+}
+
+// WithFieldTolerance sets the absolute tolerance for fields with the given
+// profile name, e.g. "altitude", overriding the tolerance set by
+// WithTolerance. The field name may be qualified by the message name, e.g.
+// "record.altitude", to only apply to fields of that message.
+func WithFieldTolerance(field string, tolerance float64) Option {
+	return func(o *options) {
+		o.fieldTolerances[field] = tolerance
+	}
+}
+
+// WithIgnoredFields sets fields that are not compared, given by profile
+// name, e.g. "time_created", optionally qualified by message name, e.g.
+// "file_id.time_created". Ignored fields are still used to align messages.
+func WithIgnoredFields(fields ...string) Option {
+	return func(o *options) {
+		for _, f := range fields {
+			o.ignored[f] = true
+		}
+	}
+}
+
+// Compare returns the differences between the files a and b: the header
+// protocol and profile versions, and the messages of the files. Differences
+// are ordered by message type, in the order the types first appear in a and
+// then b. Within a type, removed and changed messages are given in the order
+// they appear in a, followed by the added messages in the order they appear
+// in b.
+func Compare(a, b *fit.File, opts ...Option) []Difference {
+	o := options{
+		fieldTolerances: make(map[string]float64),
+		ignored:         make(map[string]bool),
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	diffs := compareHeaders(a.Header, b.Header)
+	ma, mb := groupMessages(a), groupMessages(b)
+	for _, mn := range mesgNums(ma, mb) {
+		diffs = append(diffs, compareMessages(mn, ma.msgs[mn], mb.msgs[mn], &o)...)
+	}
+	return diffs
+}
+
+func compareHeaders(a, b fit.Header) []Difference {
+	var diffs []Difference
+	if a.ProtocolVersion != b.ProtocolVersion {
+		diffs = append(diffs, Difference{
+			Kind:    Changed,
+			Message: "header",
+			Field:   "protocol_version",
+			A:       strconv.Itoa(int(a.ProtocolVersion)),
+			B:       strconv.Itoa(int(b.ProtocolVersion)),
+		})
+	}
+	if a.ProfileVersion != b.ProfileVersion {
+		diffs = append(diffs, Difference{
+			Kind:    Changed,
+			Message: "header",
+			Field:   "profile_version",
+			A:       strconv.Itoa(int(a.ProfileVersion)),
+			B:       strconv.Itoa(int(b.ProfileVersion)),
+		})
+	}
+	return diffs
+}
+
+// message is a message with its alignment key.
+type message struct {
+	key string
+	v   reflect.Value
+}
+
+// grouped holds the messages of a file by type, and the types in the order
+// they first appear.
+type grouped struct {
+	order []fit.MesgNum
+	msgs  map[fit.MesgNum][]message
+}
+
+func groupMessages(file *fit.File) grouped {
+	g := grouped{msgs: make(map[fit.MesgNum][]message)}
+	seen := make(map[fit.MesgNum]map[string]int)
+	for _, m := range file.Messages() {
+		mn, found := fit.MesgNumOf(m)
+		if !found {
+			continue
+		}
+		if seen[mn] == nil {
+			g.order = append(g.order, mn)
+			seen[mn] = make(map[string]int)
+		}
+		v := reflect.ValueOf(m).Elem()
+		key := messageKey(mn, v, len(g.msgs[mn]))
+		seen[mn][key]++
+		if n := seen[mn][key]; n > 1 {
+			key += "#" + strconv.Itoa(n)
+		}
+		g.msgs[mn] = append(g.msgs[mn], message{key: key, v: v})
+	}
+	return g
+}
+
+// messageKey returns the alignment key of the message v with message number
+// mn, the i'th message of its type: the message index if valid, else the
+// timestamp if valid, else the position.
+func messageKey(mn fit.MesgNum, v reflect.Value, i int) string {
+	var timestamp string
+	for _, pf := range fit.ProfileFields(mn) {
+		switch pf.Name {
+		case "message_index":
+			if val := fieldValue(pf, v.Field(pf.Index)); val.valid {
+				return "message_index=" + val.text
+			}
+		case "timestamp":
+			if val := fieldValue(pf, v.Field(pf.Index)); val.valid {
+				timestamp = "timestamp=" + val.text
+			}
+		}
+	}
+	if timestamp != "" {
+		return timestamp
+	}
+	return "#" + strconv.Itoa(i+1)
+}
+
+// mesgNums returns the message types of a and b, in the order they first
+// appear in a and then b.
+func mesgNums(a, b grouped) []fit.MesgNum {
+	mns := append([]fit.MesgNum(nil), a.order...)
+	for _, mn := range b.order {
+		if _, found := a.msgs[mn]; !found {
+			mns = append(mns, mn)
+		}
+	}
+	return mns
+}
+
+func compareMessages(mn fit.MesgNum, a, b []message, o *options) []Difference {
+	name := fit.MesgName(mn)
+	inB := make(map[string]reflect.Value, len(b))
+	for _, m := range b {
+		inB[m.key] = m.v
+	}
+	inA := make(map[string]bool, len(a))
+
+	var diffs []Difference
+	for _, m := range a {
+		inA[m.key] = true
+		bv, found := inB[m.key]
+		if !found {
+			diffs = append(diffs, Difference{Kind: Removed, Message: name, Key: m.key})
+			continue
+		}
+		diffs = append(diffs, compareFields(mn, name, m.key, m.v, bv, o)...)
+	}
+	for _, m := range b {
+		if !inA[m.key] {
+			diffs = append(diffs, Difference{Kind: Added, Message: name, Key: m.key})
+		}
+	}
+	return diffs
+}
+
+func compareFields(mn fit.MesgNum, name, key string, a, b reflect.Value, o *options) []Difference {
+	var diffs []Difference
+	for _, pf := range fit.ProfileFields(mn) {
+		qualified := name + "." + pf.Name
+		if o.ignored[pf.Name] || o.ignored[qualified] {
+			continue
+		}
+		tolerance := o.tolerance
+		if t, found := o.fieldTolerances[pf.Name]; found {
+			tolerance = t
+		}
+		if t, found := o.fieldTolerances[qualified]; found {
+			tolerance = t
+		}
+		va, vb := fieldValue(pf, a.Field(pf.Index)), fieldValue(pf, b.Field(pf.Index))
+		if va.equal(vb, tolerance) {
+			continue
+		}
+		diffs = append(diffs, Difference{
+			Kind:    Changed,
+			Message: name,
+			Key:     key,
+			Field:   pf.Name,
+			Units:   pf.Units,
+			A:       va.text,
+			B:       vb.text,
+		})
+	}
+	return diffs
+}
+
+// equal reports whether v and w are equal. Numbers of floating values are
+// compared with the given tolerance.
+func (v value) equal(w value, tolerance float64) bool {
+	if !v.valid || !w.valid {
+		return v.valid == w.valid
+	}
+	if !v.float || !w.float || len(v.nums) != len(w.nums) {
+		return v.text == w.text
+	}
+	for i, x := range v.nums {
+		y := w.nums[i]
+		if math.IsNaN(x) || math.IsNaN(y) {
+			if math.IsNaN(x) != math.IsNaN(y) {
+				return false
+			}
+			continue
+		}
+		if math.Abs(x-y) > tolerance {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,160 @@
+package diff_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/diff"
+)
+
+func decodeEdge810(t *testing.T) (*fit.File, *fit.ActivityFile) {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("..", "testdata", "dcrainmaker", "Edge810-Vector-2013-08-16-15-35-10.fit"))
+	if err != nil {
+		t.Fatalf("reading file failed: %v", err)
+	}
+	file, err := fit.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("decode: got error, want none; error is: %v", err)
+	}
+	activity, err := file.Activity()
+	if err != nil {
+		t.Fatalf("activity: got error, want none; error is: %v", err)
+	}
+	return file, activity
+}
+
+func TestCompare(t *testing.T) {
+	a, _ := decodeEdge810(t)
+
+	tests := []struct {
+		name   string
+		change func(a *fit.ActivityFile)
+		opts   []diff.Option
+		want   []diff.Difference
+	}{
+		{
+			name:   "equal",
+			change: func(a *fit.ActivityFile) {},
+		},
+		{
+			name: "changed",
+			change: func(a *fit.ActivityFile) {
+				a.Records[10].HeartRate = 200
+				a.Records[20].Altitude = 0xFFFF
+			},
+			want: []diff.Difference{
+				{
+					Kind:    diff.Changed,
+					Message: "record",
+					Key:     "timestamp=2013-08-16T18:05:20Z",
+					Field:   "heart_rate",
+					Units:   "bpm",
+					A:       "74",
+					B:       "200",
+				},
+				{
+					Kind:    diff.Changed,
+					Message: "record",
+					Key:     "timestamp=2013-08-16T18:05:30Z",
+					Field:   "altitude",
+					Units:   "m",
+					A:       "131.4",
+				},
+			},
+		},
+		{
+			name: "added and removed",
+			change: func(a *fit.ActivityFile) {
+				a.Laps = a.Laps[:len(a.Laps)-1]
+				e := fit.NewEventMsg()
+				e.Timestamp = a.Events[0].Timestamp
+				e.Event = fit.EventTimer
+				e.EventType = fit.EventTypeMarker
+				a.Events = append(a.Events, e)
+			},
+			want: []diff.Difference{
+				{
+					Kind:    diff.Removed,
+					Message: "lap",
+					Key:     "message_index=7",
+				},
+				{
+					Kind:    diff.Added,
+					Message: "event",
+					Key:     "timestamp=2013-08-16T18:05:10Z#2",
+				},
+			},
+		},
+		{
+			name: "tolerance",
+			change: func(a *fit.ActivityFile) {
+				a.Records[10].Altitude += 5
+				a.Records[11].Distance += 100
+			},
+			opts: []diff.Option{
+				diff.WithTolerance(1.5),
+				diff.WithFieldTolerance("record.distance", 2),
+			},
+		},
+		{
+			name: "ignored",
+			change: func(a *fit.ActivityFile) {
+				a.Records[10].HeartRate = 200
+				a.Records[11].Cadence = 0
+			},
+			opts: []diff.Option{diff.WithIgnoredFields("heart_rate", "record.cadence")},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b, ab := decodeEdge810(t)
+			test.change(ab)
+			got := diff.Compare(a, b, test.opts...)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got:\n%v\nwant:\n%v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestDifferenceString(t *testing.T) {
+	tests := []struct {
+		d    diff.Difference
+		want string
+	}{
+		{
+			d:    diff.Difference{Kind: diff.Added, Message: "lap", Key: "message_index=2"},
+			want: "+ lap[message_index=2]",
+		},
+		{
+			d:    diff.Difference{Kind: diff.Removed, Message: "event", Key: "#3"},
+			want: "- event[#3]",
+		},
+		{
+			d: diff.Difference{
+				Kind:    diff.Changed,
+				Message: "record",
+				Key:     "timestamp=2013-08-16T18:05:20Z",
+				Field:   "altitude",
+				Units:   "m",
+				A:       "185.2",
+			},
+			want: "~ record[timestamp=2013-08-16T18:05:20Z].altitude: 185.2 m -> invalid",
+		},
+		{
+			d:    diff.Difference{Kind: diff.Changed, Message: "header", Field: "profile_version", A: "100", B: "2093"},
+			want: "~ header.profile_version: 100 -> 2093",
+		},
+	}
+	for _, test := range tests {
+		if got := test.d.String(); got != test.want {
+			t.Errorf("got %q, want %q", got, test.want)
+		}
+	}
+}
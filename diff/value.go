@@ -0,0 +1,80 @@
+package diff
+
+import (
+	"encoding/hex"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/internal/field"
+)
+
+// value is the scaled value of a field.
+type value struct {
+	valid bool
+
+	// float reports whether the value is a floating point value, compared
+	// using nums. Invalid array elements are NaN.
+	float bool
+	nums  []float64
+
+	text string
+}
+
+// fieldValue returns the scaled value of the field v described by pf.
+func fieldValue(pf fit.ProfileField, v reflect.Value) value {
+	switch x := v.Interface().(type) {
+	case time.Time:
+		if !field.ValidTime(x) {
+			return value{}
+		}
+		return value{valid: true, text: x.UTC().Format(time.RFC3339)}
+	case fit.Latitude:
+		if x.Invalid() {
+			return value{}
+		}
+		return value{valid: true, float: true, nums: []float64{x.Degrees()}, text: x.String()}
+	case fit.Longitude:
+		if x.Invalid() {
+			return value{}
+		}
+		return value{valid: true, float: true, nums: []float64{x.Degrees()}, text: x.String()}
+	case string:
+		return value{valid: x != "", text: strconv.Quote(x)}
+	}
+
+	if v.Kind() != reflect.Slice {
+		s, f, valid := field.Scalar(pf, v)
+		if !valid {
+			return value{}
+		}
+		val := value{valid: true, float: field.IsFloat(pf, v), text: s}
+		if val.float {
+			val.nums = []float64{f}
+		}
+		return val
+	}
+	if pf.Type == fit.FitBaseTypeByte && v.Type().Elem().Kind() == reflect.Uint8 {
+		b := v.Bytes()
+		return value{valid: len(b) > 0, text: hex.EncodeToString(b)}
+	}
+
+	val := value{float: v.Len() > 0 && field.IsFloat(pf, v.Index(0))}
+	elems := make([]string, v.Len())
+	for i := range elems {
+		s, f, ok := field.Scalar(pf, v.Index(i))
+		if !ok {
+			s, f = "-", math.NaN()
+		}
+		elems[i] = s
+		if val.float {
+			val.nums = append(val.nums, f)
+		}
+		val.valid = val.valid || ok
+	}
+	val.text = "[" + strings.Join(elems, " ") + "]"
+	return val
+}
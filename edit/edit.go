@@ -0,0 +1,260 @@
+// Package edit merges and splits activity files, e.g. to join the two halves
+// of a ride recorded before and after a battery swap, or to cut a commute out
+// of a long activity. It also repairs files that were not completely written.
+//
+// The resulting files are standalone activity files: accumulated record
+// values such as distance are rebased, message indexes are renumbered, and
+// laps and sessions that are joined or cut are recomputed from the records
+// using package summary. Time in zone messages are not kept, since they
+// would be stale.
+package edit
+
+import (
+	"sort"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/internal/activity"
+	"github.com/tormoder/fit/summary"
+)
+
+// newActivity returns a new activity file with the header, file id and file
+// creator of src.
+func newActivity(src *fit.File) (*fit.File, *fit.ActivityFile, error) {
+	f, err := fit.NewFile(fit.FileTypeActivity, src.Header)
+	if err != nil {
+		return nil, nil, err
+	}
+	f.FileId = src.FileId
+	if src.FileCreator != nil {
+		fc := *src.FileCreator
+		f.FileCreator = &fc
+	}
+	a, err := f.Activity()
+	if err != nil {
+		return nil, nil, err
+	}
+	return f, a, nil
+}
+
+// accumulated holds the last valid values of the accumulated fields of
+// records.
+type accumulated struct {
+	distance         uint32
+	totalCycles      uint32
+	accumulatedPower uint32
+	calories         uint16
+}
+
+func (acc *accumulated) update(r *fit.RecordMsg) {
+	if r.Distance != 0xFFFFFFFF {
+		acc.distance = r.Distance
+	}
+	if r.TotalCycles != 0xFFFFFFFF {
+		acc.totalCycles = r.TotalCycles
+	}
+	if r.AccumulatedPower != 0xFFFFFFFF {
+		acc.accumulatedPower = r.AccumulatedPower
+	}
+	if r.Calories != 0xFFFF {
+		acc.calories = r.Calories
+	}
+}
+
+// add adds acc to the valid accumulated fields of r.
+func (acc accumulated) add(r *fit.RecordMsg) {
+	if r.Distance != 0xFFFFFFFF {
+		r.Distance += acc.distance
+	}
+	if r.TotalCycles != 0xFFFFFFFF {
+		r.TotalCycles += acc.totalCycles
+	}
+	if r.AccumulatedPower != 0xFFFFFFFF {
+		r.AccumulatedPower += acc.accumulatedPower
+	}
+	if r.Calories != 0xFFFF {
+		r.Calories += acc.calories
+	}
+}
+
+// sub subtracts acc from the valid accumulated fields of r, down to zero.
+func (acc accumulated) sub(r *fit.RecordMsg) {
+	if r.Distance != 0xFFFFFFFF {
+		r.Distance -= min32(r.Distance, acc.distance)
+	}
+	if r.TotalCycles != 0xFFFFFFFF {
+		r.TotalCycles -= min32(r.TotalCycles, acc.totalCycles)
+	}
+	if r.AccumulatedPower != 0xFFFFFFFF {
+		r.AccumulatedPower -= min32(r.AccumulatedPower, acc.accumulatedPower)
+	}
+	if r.Calories != 0xFFFF {
+		r.Calories -= uint16(min32(uint32(r.Calories), uint32(acc.calories)))
+	}
+}
+
+func min32(a, b uint32) uint32 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// computeLap returns lap recomputed from the records for the period from
+// start to end, keeping its message index, event, trigger, sport and
+// intensity.
+func computeLap(a *fit.ActivityFile, lap *fit.LapMsg, start, end time.Time) *fit.LapMsg {
+	period := *lap
+	period.StartTime = start
+	period.TotalElapsedTime = milliseconds(end.Sub(start))
+	tmp := &fit.ActivityFile{Records: a.Records, Events: a.Events, Laps: []*fit.LapMsg{&period}}
+	return summary.Laps(tmp)[0]
+}
+
+// computeSession returns session recomputed from the records for the period
+// from start to end, keeping its message index, event, trigger and sport.
+func computeSession(a *fit.ActivityFile, session *fit.SessionMsg, start, end time.Time) *fit.SessionMsg {
+	period := *session
+	period.StartTime = start
+	period.TotalElapsedTime = milliseconds(end.Sub(start))
+	tmp := &fit.ActivityFile{Records: a.Records, Events: a.Events, Sessions: []*fit.SessionMsg{&period}}
+	return summary.Sessions(tmp)[0]
+}
+
+func milliseconds(d time.Duration) uint32 {
+	return uint32(d / time.Millisecond)
+}
+
+// renumber sets the message indexes of the laps, sessions, lengths and
+// segment laps of a to their positions, and links sessions to the laps and
+// laps to the lengths within them.
+func renumber(a *fit.ActivityFile) {
+	for i, l := range a.Laps {
+		l.MessageIndex = fit.MessageIndex(i)
+	}
+	for i, s := range a.Sessions {
+		s.MessageIndex = fit.MessageIndex(i)
+	}
+	for i, l := range a.Lengths {
+		l.MessageIndex = fit.MessageIndex(i)
+	}
+	for i, l := range a.SegmentLaps {
+		l.MessageIndex = fit.MessageIndex(i)
+	}
+
+	for _, s := range a.Sessions {
+		first, n := within(len(a.Laps), func(i int) time.Time { return a.Laps[i].StartTime }, s.StartTime, activity.SessionEnd(s))
+		s.FirstLapIndex, s.NumLaps = uint16(first), uint16(n)
+	}
+	if len(a.Lengths) == 0 {
+		return
+	}
+	for _, l := range a.Laps {
+		first, n := within(len(a.Lengths), func(i int) time.Time { return a.Lengths[i].StartTime }, l.StartTime, activity.LapEnd(l))
+		l.FirstLengthIndex, l.NumLengths = uint16(first), uint16(n)
+	}
+}
+
+// within returns the index of the first of n sorted times given by t that is
+// within the period from start to end, inclusive, and the number of times
+// within the period.
+func within(n int, t func(int) time.Time, start, end time.Time) (int, int) {
+	first := sort.Search(n, func(i int) bool { return !t(i).Before(start) })
+	last := first
+	for last < n && !t(last).After(end) {
+		last++
+	}
+	return first, last - first
+}
+
+// activityMsg returns an activity message for sessions ending at end. The
+// type, event and local time offset are taken from src, if not nil.
+func activityMsg(src *fit.ActivityMsg, sessions []*fit.SessionMsg, end time.Time) *fit.ActivityMsg {
+	am := fit.NewActivityMsg()
+	if src != nil {
+		*am = *src
+		if !src.LocalTimestamp.IsZero() && !fit.IsBaseTime(src.LocalTimestamp) {
+			am.LocalTimestamp = src.LocalTimestamp.Add(end.Sub(src.Timestamp))
+		}
+	} else {
+		am.Type = fit.ActivityModeManual
+		am.Event = fit.EventActivity
+		am.EventType = fit.EventTypeStop
+	}
+	am.Timestamp = end
+	am.NumSessions = uint16(len(sessions))
+
+	var timer uint64
+	for _, s := range sessions {
+		if s.TotalTimerTime != 0xFFFFFFFF {
+			timer += uint64(s.TotalTimerTime)
+		}
+	}
+	am.TotalTimerTime = 0xFFFFFFFF
+	if timer < 0xFFFFFFFF {
+		am.TotalTimerTime = uint32(timer)
+	}
+	return am
+}
+
+// copy helpers return shallow copies of messages, so that the messages of
+// the source files are never modified.
+
+func copyRecord(r *fit.RecordMsg) *fit.RecordMsg {
+	c := *r
+	return &c
+}
+
+func copyEvent(e *fit.EventMsg) *fit.EventMsg {
+	c := *e
+	return &c
+}
+
+func copyLap(l *fit.LapMsg) *fit.LapMsg {
+	c := *l
+	return &c
+}
+
+func copySession(s *fit.SessionMsg) *fit.SessionMsg {
+	c := *s
+	return &c
+}
+
+func copyLength(l *fit.LengthMsg) *fit.LengthMsg {
+	c := *l
+	return &c
+}
+
+func copySegmentLap(l *fit.SegmentLapMsg) *fit.SegmentLapMsg {
+	c := *l
+	return &c
+}
+
+// copyCommon copies the messages of src that describe the whole activity:
+// device infos, user profile, sport, zone targets and workout.
+func copyCommon(dst, src *fit.ActivityFile) {
+	for _, di := range src.DeviceInfos {
+		c := *di
+		dst.DeviceInfos = append(dst.DeviceInfos, &c)
+	}
+	if src.UserProfile != nil {
+		c := *src.UserProfile
+		dst.UserProfile = &c
+	}
+	if src.Sport != nil {
+		c := *src.Sport
+		dst.Sport = &c
+	}
+	for _, zt := range src.ZoneTargets {
+		c := *zt
+		dst.ZoneTargets = append(dst.ZoneTargets, &c)
+	}
+	for _, w := range src.Workouts {
+		c := *w
+		dst.Workouts = append(dst.Workouts, &c)
+	}
+	for _, ws := range src.WorkoutSteps {
+		c := *ws
+		dst.WorkoutSteps = append(dst.WorkoutSteps, &c)
+	}
+}
@@ -0,0 +1,183 @@
+package edit_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/edit"
+)
+
+func decodeEdge810(t *testing.T) (*fit.File, *fit.ActivityFile) {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("..", "testdata", "dcrainmaker", "Edge810-Vector-2013-08-16-15-35-10.fit"))
+	if err != nil {
+		t.Fatalf("reading file failed: %v", err)
+	}
+	file, err := fit.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("decode: got error, want none; error is: %v", err)
+	}
+	activity, err := file.Activity()
+	if err != nil {
+		t.Fatalf("activity: got error, want none; error is: %v", err)
+	}
+	return file, activity
+}
+
+// roundTrip encodes and decodes file, verifying that it is a valid
+// standalone activity file.
+func roundTrip(t *testing.T, file *fit.File) *fit.ActivityFile {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := fit.Encode(&buf, file, binary.LittleEndian); err != nil {
+		t.Fatalf("encode: got error, want none; error is: %v", err)
+	}
+	decoded, err := fit.Decode(&buf)
+	if err != nil {
+		t.Fatalf("decode: got error, want none; error is: %v", err)
+	}
+	a, err := decoded.Activity()
+	if err != nil {
+		t.Fatalf("activity: got error, want none; error is: %v", err)
+	}
+	if a.Activity == nil || len(a.Sessions) == 0 || len(a.Laps) == 0 || len(a.Records) == 0 {
+		t.Fatalf("got activity with missing messages")
+	}
+	if int(a.Activity.NumSessions) != len(a.Sessions) {
+		t.Errorf("activity number of sessions: got %d, want %d", a.Activity.NumSessions, len(a.Sessions))
+	}
+	for i, s := range a.Sessions {
+		if int(s.MessageIndex) != i {
+			t.Errorf("session %d: got message index %d", i, s.MessageIndex)
+		}
+	}
+	for i, l := range a.Laps {
+		if int(l.MessageIndex) != i {
+			t.Errorf("lap %d: got message index %d", i, l.MessageIndex)
+		}
+	}
+	return a
+}
+
+func sessionDistance(a *fit.ActivityFile) float64 {
+	var d float64
+	for _, s := range a.Sessions {
+		d += s.GetTotalDistanceScaled()
+	}
+	return d
+}
+
+func TestSplitMerge(t *testing.T) {
+	file, activity := decodeEdge810(t)
+	wantDistance := activity.Sessions[0].GetTotalDistanceScaled()
+
+	parts, err := edit.SplitLaps(file, 4)
+	if err != nil {
+		t.Fatalf("split: got error, want none; error is: %v", err)
+	}
+	if len(parts) != 2 {
+		t.Fatalf("got %d parts, want 2", len(parts))
+	}
+
+	var records, laps int
+	var distance float64
+	for i, part := range parts {
+		a := roundTrip(t, part)
+		records += len(a.Records)
+		laps += len(a.Laps)
+		distance += sessionDistance(a)
+		if d := a.Records[0].GetDistanceScaled(); d > 20 {
+			t.Errorf("part %d: first record distance: got %v m, want close to zero", i, d)
+		}
+		if len(a.Sessions) != 1 || a.Sessions[0].NumLaps != 4 {
+			t.Errorf("part %d: got %d sessions, want 1 with 4 laps", i, len(a.Sessions))
+		}
+	}
+	if records != len(activity.Records) {
+		t.Errorf("records: got %d, want %d", records, len(activity.Records))
+	}
+	if laps != len(activity.Laps) {
+		t.Errorf("laps: got %d, want %d", laps, len(activity.Laps))
+	}
+	if math.Abs(distance-wantDistance) > 0.01*wantDistance {
+		t.Errorf("total distance of parts: got %v m, want %v m", distance, wantDistance)
+	}
+	if parts[1].FileId.TimeCreated.Equal(parts[0].FileId.TimeCreated) {
+		t.Errorf("parts have the same time created")
+	}
+
+	// Merging the parts, given in reverse order, gives back the activity.
+	merged, err := edit.Merge(parts[1], parts[0])
+	if err != nil {
+		t.Fatalf("merge: got error, want none; error is: %v", err)
+	}
+	a := roundTrip(t, merged)
+	if len(a.Records) != len(activity.Records) || len(a.Laps) != len(activity.Laps) || len(a.Sessions) != 1 {
+		t.Fatalf("got %d records, %d laps and %d sessions, want %d, %d and 1",
+			len(a.Records), len(a.Laps), len(a.Sessions), len(activity.Records), len(activity.Laps))
+	}
+	for i, r := range a.Records {
+		if r.Distance != activity.Records[i].Distance {
+			t.Fatalf("record %d: got distance %d, want %d", i, r.Distance, activity.Records[i].Distance)
+		}
+	}
+	s := a.Sessions[0]
+	if s.NumLaps != uint16(len(a.Laps)) {
+		t.Errorf("session laps: got %d, want %d", s.NumLaps, len(a.Laps))
+	}
+	if d := s.GetTotalDistanceScaled(); math.Abs(d-wantDistance) > 0.01*wantDistance {
+		t.Errorf("session distance: got %v m, want %v m", d, wantDistance)
+	}
+	wantTimer := activity.Sessions[0].GetTotalTimerTimeScaled()
+	if tt := s.GetTotalTimerTimeScaled(); math.Abs(tt-wantTimer) > 10 {
+		t.Errorf("session timer time: got %v s, want %v s", tt, wantTimer)
+	}
+}
+
+func TestSplitWithinLap(t *testing.T) {
+	file, activity := decodeEdge810(t)
+	lap := activity.Laps[2]
+	at := lap.StartTime.Add(time.Duration(lap.TotalElapsedTime) * time.Millisecond / 2)
+
+	parts, err := edit.Split(file, at)
+	if err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	first, second := roundTrip(t, parts[0]), roundTrip(t, parts[1])
+	if got := len(first.Laps) + len(second.Laps); got != len(activity.Laps)+1 {
+		t.Errorf("got %d laps, want %d", got, len(activity.Laps)+1)
+	}
+	last := first.Laps[len(first.Laps)-1]
+	if !last.Timestamp.Before(at) {
+		t.Errorf("cut lap: got end %v, want before %v", last.Timestamp, at)
+	}
+	if !second.Laps[0].StartTime.Before(at.Add(2 * time.Second)) {
+		t.Errorf("cut lap: got start %v, want at %v", second.Laps[0].StartTime, at)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	file, activity := decodeEdge810(t)
+	start := activity.Records[0].Timestamp
+	if _, err := edit.Merge(file, file); err == nil {
+		t.Errorf("merge overlapping: got no error, want one")
+	}
+	if _, err := edit.Merge(); err == nil {
+		t.Errorf("merge none: got no error, want one")
+	}
+	if _, err := edit.Split(file, start.Add(time.Hour), start.Add(time.Minute)); err == nil {
+		t.Errorf("split decreasing: got no error, want one")
+	}
+	if _, err := edit.Split(file, start.Add(-time.Hour)); err == nil {
+		t.Errorf("split before start: got no error, want one")
+	}
+	if _, err := edit.SplitLaps(file, 0); err == nil {
+		t.Errorf("split at first lap: got no error, want one")
+	}
+}
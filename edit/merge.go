@@ -0,0 +1,175 @@
+package edit
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/internal/activity"
+)
+
+type source struct {
+	file       *fit.File
+	activity   *fit.ActivityFile
+	start, end time.Time
+}
+
+// Merge returns a new activity file joining the activities of files, which
+// must not overlap in time. The activities are joined in the order of their
+// first record, and the header, file id and file creator of the first one
+// are used.
+//
+// Distance, total cycles, accumulated power and calories of records continue
+// from the previous activity. Laps are kept as they are. The last session of
+// an activity and the first session of the next are joined if they have the
+// same sport and sub sport, and the joined session is recomputed from the
+// records. Other sessions are kept. If the timer was running at the end of an
+// activity, a timer stop event is added so that the time between activities
+// is not counted as timer time.
+//
+// Hr, hrv and beat interval messages of all activities are kept, as are
+// device infos, except duplicates. The user profile, sport, zone targets and
+// workout are those of the first activity.
+func Merge(files ...*fit.File) (*fit.File, error) {
+	if len(files) == 0 {
+		return nil, errors.New("no files to merge")
+	}
+	srcs := make([]source, len(files))
+	for i, f := range files {
+		a, err := f.Activity()
+		if err != nil {
+			return nil, fmt.Errorf("file %d: %w", i+1, err)
+		}
+		if len(a.Records) == 0 {
+			return nil, fmt.Errorf("file %d: no records", i+1)
+		}
+		srcs[i] = source{
+			file:     f,
+			activity: a,
+			start:    a.Records[0].Timestamp,
+			end:      a.Records[len(a.Records)-1].Timestamp,
+		}
+	}
+	sort.SliceStable(srcs, func(i, j int) bool { return srcs[i].start.Before(srcs[j].start) })
+	for i := 1; i < len(srcs); i++ {
+		if !srcs[i].start.After(srcs[i-1].end) {
+			return nil, fmt.Errorf(
+				"activities overlap: activity starting at %v starts before the previous one ends at %v",
+				srcs[i].start, srcs[i-1].end)
+		}
+	}
+
+	out, a, err := newActivity(srcs[0].file)
+	if err != nil {
+		return nil, err
+	}
+	copyCommon(a, srcs[0].activity)
+
+	var (
+		acc         accumulated
+		recompute   []bool
+		lastSport   *fit.SessionMsg
+		srcActivity *fit.ActivityMsg
+	)
+	for i, src := range srcs {
+		sa := src.activity
+		base := acc
+		for _, r := range sa.Records {
+			c := copyRecord(r)
+			base.add(c)
+			acc.update(c)
+			a.Records = append(a.Records, c)
+		}
+
+		for _, e := range sa.Events {
+			a.Events = append(a.Events, copyEvent(e))
+		}
+		if i < len(srcs)-1 && timerRunning(sa.Events, src.end) {
+			a.Events = append(a.Events, activity.TimerEvent(src.end, fit.EventTypeStopAll))
+		}
+
+		for _, l := range sa.Laps {
+			a.Laps = append(a.Laps, copyLap(l))
+		}
+		for j, s := range sa.Sessions {
+			n := len(a.Sessions)
+			if j == 0 && lastSport != nil && s.Sport == lastSport.Sport && s.SubSport == lastSport.SubSport {
+				prev := a.Sessions[n-1]
+				prev.Timestamp = s.Timestamp
+				prev.TotalElapsedTime = milliseconds(activity.SessionEnd(s).Sub(prev.StartTime))
+				recompute[n-1] = true
+				continue
+			}
+			a.Sessions = append(a.Sessions, copySession(s))
+			recompute = append(recompute, false)
+		}
+		lastSport = nil
+		if len(sa.Sessions) > 0 {
+			lastSport = sa.Sessions[len(sa.Sessions)-1]
+		}
+
+		for _, l := range sa.Lengths {
+			a.Lengths = append(a.Lengths, copyLength(l))
+		}
+		for _, l := range sa.SegmentLaps {
+			a.SegmentLaps = append(a.SegmentLaps, copySegmentLap(l))
+		}
+		if i > 0 {
+			for _, di := range sa.DeviceInfos {
+				if !hasDeviceInfo(a.DeviceInfos, di) {
+					c := *di
+					a.DeviceInfos = append(a.DeviceInfos, &c)
+				}
+			}
+		}
+		for _, hr := range sa.Hrs {
+			c := *hr
+			a.Hrs = append(a.Hrs, &c)
+		}
+		for _, hrv := range sa.Hrvs {
+			c := *hrv
+			a.Hrvs = append(a.Hrvs, &c)
+		}
+		for _, bi := range sa.BeatIntervals {
+			c := *bi
+			a.BeatIntervals = append(a.BeatIntervals, &c)
+		}
+		if sa.Activity != nil {
+			srcActivity = sa.Activity
+		}
+	}
+
+	for i, s := range a.Sessions {
+		if recompute[i] {
+			a.Sessions[i] = computeSession(a, s, s.StartTime, activity.SessionEnd(s))
+		}
+	}
+	renumber(a)
+	a.Activity = activityMsg(srcActivity, a.Sessions, srcs[len(srcs)-1].end)
+	return out, nil
+}
+
+// hasDeviceInfo reports whether dis holds a device info equal to di, except
+// for the timestamp. Files split from the same activity share their device
+// infos.
+func hasDeviceInfo(dis []*fit.DeviceInfoMsg, di *fit.DeviceInfoMsg) bool {
+	x := *di
+	for _, d := range dis {
+		y := *d
+		y.Timestamp = x.Timestamp
+		if reflect.DeepEqual(x, y) {
+			return true
+		}
+	}
+	return false
+}
+
+// timerRunning reports whether the timer was running at t according to the
+// timer events. It reports false if there are no timer events.
+func timerRunning(events []*fit.EventMsg, t time.Time) bool {
+	_, found := activity.TimerPeriods(events).Containing(t)
+	return found
+}
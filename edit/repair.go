@@ -0,0 +1,301 @@
+package edit
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/dyncrc16"
+	"github.com/tormoder/fit/internal/activity"
+)
+
+// RepairResult describes the repairs made by Repair.
+type RepairResult struct {
+	// HeaderDataSize is the data size given by the header of the file, and
+	// DataSize the size of the data that could be read, excluding added
+	// messages.
+	HeaderDataSize uint32
+	DataSize       uint32
+
+	// DroppedBytes is the number of bytes after the data that could be
+	// read, excluding the file CRC, that were dropped.
+	DroppedBytes int
+
+	// CRCInvalid reports whether the header or file CRC of the file was
+	// invalid or missing.
+	CRCInvalid bool
+
+	// TimerStopped reports whether a timer stop event was added at the
+	// last record because the timer was never stopped.
+	TimerStopped bool
+
+	// LapsAdded and SessionsAdded are the number of laps and sessions
+	// added for records not covered by any, and ActivityAdded reports
+	// whether a missing activity message was added.
+	LapsAdded     int
+	SessionsAdded int
+	ActivityAdded bool
+}
+
+// Repair repairs a FIT file that was not completely written, e.g. because
+// the device crashed or ran out of battery, and returns the repaired file.
+//
+// The longest prefix of the file data consisting of complete and valid
+// records is kept as is. For activity files, a timer stop event is added at
+// the last record if the timer was never stopped, and laps, a session and an
+// activity message are added for the records not covered by any. These are
+// computed from the records and timer events using package summary. Finally
+// the header data size and the header and file CRCs are set.
+//
+// Only the first of chained FIT files is repaired. An error is returned if
+// the header is invalid, or if the repaired file can not be decoded.
+func Repair(data []byte) ([]byte, RepairResult, error) {
+	var res RepairResult
+	if len(data) == 0 {
+		return nil, res, errors.New("no data")
+	}
+	hsize := int(data[0])
+	if hsize != 12 && hsize != 14 {
+		return nil, res, fmt.Errorf("invalid header: illegal header size: %d", hsize)
+	}
+	if len(data) < hsize {
+		return nil, res, errors.New("file shorter than header")
+	}
+
+	// Read the header with the data size set to all remaining bytes and
+	// the header CRC cleared, so that the records can be read even if the
+	// header is wrong.
+	hdr := make([]byte, hsize)
+	copy(hdr, data)
+	if hsize >= 14 {
+		hdr[12], hdr[13] = 0, 0
+	}
+	binary.LittleEndian.PutUint32(hdr[4:8], uint32(len(data)-hsize))
+	h, err := fit.DecodeHeader(bytes.NewReader(hdr))
+	if err != nil {
+		return nil, res, fmt.Errorf("invalid header: %w", err)
+	}
+	res.HeaderDataSize = binary.LittleEndian.Uint32(data[4:8])
+	res.CRCInvalid = fit.CheckIntegrity(bytes.NewReader(data), false) != nil
+
+	ends, last := recordEnds(append(hdr, data[hsize:]...), hsize)
+	if hds := int64(res.HeaderDataSize); hds > 0 && ends[hds] {
+		res.DataSize = res.HeaderDataSize
+	} else {
+		res.DataSize = uint32(last)
+	}
+	end := hsize + int(res.DataSize)
+	if dropped := len(data) - end - 2; dropped > 0 {
+		res.DroppedBytes = dropped
+	}
+	records := data[hsize:end]
+
+	file, err := fit.Decode(bytes.NewReader(assemble(h, records)))
+	if err != nil {
+		return nil, res, fmt.Errorf("decoding valid data: %w", err)
+	}
+	if file.Type() == fit.FileTypeActivity {
+		added, err := complete(file, &res)
+		if err != nil {
+			return nil, res, err
+		}
+		records = append(records[:len(records):len(records)], added...)
+	}
+	out := assemble(h, records)
+	if _, err = fit.Decode(bytes.NewReader(out)); err != nil {
+		return nil, res, fmt.Errorf("decoding repaired file: %w", err)
+	}
+	return out, res, nil
+}
+
+// recordEnds reads the records of the FIT file in data, with a header of
+// size hsize, and returns the offsets relative to the start of the data at
+// which a record ends, and the last of them. Reading stops at the first
+// incomplete or invalid record.
+func recordEnds(data []byte, hsize int) (map[int64]bool, int64) {
+	ends := map[int64]bool{0: true}
+	var last int64
+	rr, err := fit.NewRawReader(bytes.NewReader(data))
+	if err != nil {
+		return ends, last
+	}
+	for {
+		rec, err := rr.Next()
+		if err != nil {
+			return ends, last
+		}
+		if rec.IsDefinition() && rec.Def.Validate() != nil {
+			return ends, last
+		}
+		last = rec.Offset + int64(len(rec.Bytes)) - int64(hsize)
+		ends[last] = true
+	}
+}
+
+// complete returns the encoded messages missing from the activity file,
+// computed from its records and timer events, and records them in res.
+func complete(file *fit.File, res *RepairResult) ([]byte, error) {
+	a, err := file.Activity()
+	if err != nil {
+		return nil, err
+	}
+	if len(a.Records) == 0 {
+		return nil, nil
+	}
+	end := a.Records[len(a.Records)-1].Timestamp
+	sport, subSport := activitySport(a)
+
+	var events []*fit.EventMsg
+	if periods := activity.TimerPeriods(a.Events); len(periods) > 0 && periods[len(periods)-1].End.IsZero() {
+		events = append(events, activity.TimerEvent(end, fit.EventTypeStopAll))
+		res.TimerStopped = true
+	}
+	tmp := &fit.ActivityFile{
+		Records: a.Records,
+		Events:  append(append([]*fit.EventMsg(nil), a.Events...), events...),
+	}
+
+	var laps []*fit.LapMsg
+	var lapsEnd time.Time
+	for _, l := range a.Laps {
+		lapsEnd = later(lapsEnd, l.Timestamp, activity.LapEnd(l))
+	}
+	if start, ok := firstAfter(a.Records, lapsEnd); ok {
+		l := fit.NewLapMsg()
+		l.MessageIndex = fit.MessageIndex(len(a.Laps))
+		l.Event = fit.EventLap
+		l.EventType = fit.EventTypeStop
+		l.LapTrigger = fit.LapTriggerSessionEnd
+		l.Sport, l.SubSport = sport, subSport
+		laps = append(laps, computeLap(tmp, l, start, end))
+		res.LapsAdded++
+	}
+
+	var sessions []*fit.SessionMsg
+	var sessionsEnd time.Time
+	for _, s := range a.Sessions {
+		sessionsEnd = later(sessionsEnd, s.Timestamp, activity.SessionEnd(s))
+	}
+	if start, ok := firstAfter(a.Records, sessionsEnd); ok {
+		allLaps := append(append([]*fit.LapMsg(nil), a.Laps...), laps...)
+		s := fit.NewSessionMsg()
+		s.MessageIndex = fit.MessageIndex(len(a.Sessions))
+		s.Event = fit.EventSession
+		s.EventType = fit.EventTypeStop
+		s.Trigger = fit.SessionTriggerActivityEnd
+		s.Sport, s.SubSport = sport, subSport
+		first, n := within(len(allLaps), func(i int) time.Time { return allLaps[i].StartTime }, start, end)
+		s.FirstLapIndex, s.NumLaps = uint16(first), uint16(n)
+		sessions = append(sessions, computeSession(tmp, s, start, end))
+		res.SessionsAdded++
+	}
+
+	var activity *fit.ActivityMsg
+	if a.Activity == nil {
+		allSessions := append(append([]*fit.SessionMsg(nil), a.Sessions...), sessions...)
+		activity = activityMsg(nil, allSessions, end)
+		res.ActivityAdded = true
+	}
+
+	// Events, laps, sessions and the activity are encoded separately to
+	// write them in the order devices do.
+	var out []byte
+	for _, fill := range []func(*fit.ActivityFile){
+		func(a *fit.ActivityFile) { a.Events = events },
+		func(a *fit.ActivityFile) { a.Laps = laps },
+		func(a *fit.ActivityFile) { a.Sessions = sessions },
+		func(a *fit.ActivityFile) { a.Activity = activity },
+	} {
+		b, err := encodeMessages(file, fill)
+		if err != nil {
+			return nil, fmt.Errorf("encoding added messages: %w", err)
+		}
+		out = append(out, b...)
+	}
+	return out, nil
+}
+
+// encodeMessages returns the definition and data records of the messages
+// set by fill on an empty activity file, excluding the file id.
+func encodeMessages(src *fit.File, fill func(*fit.ActivityFile)) ([]byte, error) {
+	f, a, err := newActivity(src)
+	if err != nil {
+		return nil, err
+	}
+	f.FileCreator = nil
+	fill(a)
+	var buf bytes.Buffer
+	if err := fit.Encode(&buf, f, binary.LittleEndian); err != nil {
+		return nil, err
+	}
+	rr, err := fit.NewRawReader(&buf)
+	if err != nil {
+		return nil, err
+	}
+	var out []byte
+	for {
+		rec, err := rr.Next()
+		if err == io.EOF {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if rec.MesgNum() != fit.MesgNumFileId {
+			out = append(out, rec.Bytes...)
+		}
+	}
+}
+
+// activitySport returns the sport and sub sport of the activity, taken from
+// the sport message, or else from the last session or lap.
+func activitySport(a *fit.ActivityFile) (fit.Sport, fit.SubSport) {
+	switch {
+	case a.Sport != nil:
+		return a.Sport.Sport, a.Sport.SubSport
+	case len(a.Sessions) > 0:
+		s := a.Sessions[len(a.Sessions)-1]
+		return s.Sport, s.SubSport
+	case len(a.Laps) > 0:
+		l := a.Laps[len(a.Laps)-1]
+		return l.Sport, l.SubSport
+	}
+	return fit.SportGeneric, fit.SubSportGeneric
+}
+
+// firstAfter returns the time of the first record after t, if any. All
+// records are after the zero time.
+func firstAfter(records []*fit.RecordMsg, t time.Time) (time.Time, bool) {
+	i := sort.Search(len(records), func(i int) bool { return records[i].Timestamp.After(t) })
+	if i == len(records) {
+		return time.Time{}, false
+	}
+	return records[i].Timestamp, true
+}
+
+// later returns the latest of the given times.
+func later(t time.Time, ts ...time.Time) time.Time {
+	for _, u := range ts {
+		if u.After(t) {
+			t = u
+		}
+	}
+	return t
+}
+
+// assemble returns a FIT file with header h and the given records, with the
+// data size and CRCs set.
+func assemble(h fit.Header, records []byte) []byte {
+	h.DataSize = uint32(len(records))
+	hdr, _ := h.MarshalBinary()
+	out := make([]byte, 0, len(hdr)+len(records)+2)
+	out = append(out, hdr...)
+	out = append(out, records...)
+	crc := dyncrc16.Checksum(out)
+	return append(out, byte(crc), byte(crc>>8))
+}
@@ -0,0 +1,149 @@
+package edit_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/edit"
+)
+
+func TestRepair(t *testing.T) {
+	edge810, err := os.ReadFile(filepath.Join("..", "testdata", "dcrainmaker", "Edge810-Vector-2013-08-16-15-35-10.fit"))
+	if err != nil {
+		t.Fatalf("reading file failed: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		data     func(t *testing.T) []byte
+		want     edit.RepairResult
+		laps     int
+		sessions int
+	}{
+		{
+			name: "file crc",
+			data: readCorrupt("activity-filecrc.fit"),
+			want: edit.RepairResult{
+				HeaderDataSize: 757,
+				DataSize:       757,
+				CRCInvalid:     true,
+			},
+			laps:     1,
+			sessions: 1,
+		},
+		{
+			name: "unexpected eof",
+			data: readCorrupt("activity-unexpected-eof.fit"),
+			want: edit.RepairResult{
+				HeaderDataSize: 757,
+				DataSize:       739,
+				DroppedBytes:   15,
+				CRCInvalid:     true,
+				ActivityAdded:  true,
+			},
+			laps:     1,
+			sessions: 1,
+		},
+		{
+			name: "truncated",
+			data: func(t *testing.T) []byte { return edge810[:len(edge810)/2] },
+			want: edit.RepairResult{
+				HeaderDataSize: 148021,
+				DataSize:       73984,
+				DroppedBytes:   18,
+				CRCInvalid:     true,
+				TimerStopped:   true,
+				LapsAdded:      1,
+				SessionsAdded:  1,
+				ActivityAdded:  true,
+			},
+			laps:     5,
+			sessions: 1,
+		},
+		{
+			name: "zero data size",
+			data: func(t *testing.T) []byte {
+				data := append([]byte(nil), edge810...)
+				data[4], data[5], data[6], data[7] = 0, 0, 0, 0
+				return data
+			},
+			want: edit.RepairResult{
+				DataSize:   148021,
+				CRCInvalid: true,
+			},
+			laps:     8,
+			sessions: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			out, res, err := edit.Repair(test.data(t))
+			if err != nil {
+				t.Fatalf("got error, want none; error is: %v", err)
+			}
+			if res != test.want {
+				t.Errorf("result:\ngot:  %+v\nwant: %+v", res, test.want)
+			}
+			if err := fit.CheckIntegrity(bytes.NewReader(out), false); err != nil {
+				t.Fatalf("integrity: got error, want none; error is: %v", err)
+			}
+			file, err := fit.Decode(bytes.NewReader(out))
+			if err != nil {
+				t.Fatalf("decode: got error, want none; error is: %v", err)
+			}
+			a, err := file.Activity()
+			if err != nil {
+				t.Fatalf("activity: got error, want none; error is: %v", err)
+			}
+			if a.Activity == nil {
+				t.Fatalf("got no activity message")
+			}
+			if len(a.Laps) != test.laps || len(a.Sessions) != test.sessions {
+				t.Errorf("got %d laps and %d sessions, want %d and %d",
+					len(a.Laps), len(a.Sessions), test.laps, test.sessions)
+			}
+			for _, s := range a.Sessions {
+				if s.NumLaps == 0 || int(s.FirstLapIndex)+int(s.NumLaps) > len(a.Laps) {
+					t.Errorf("session %d: got laps [%d, %d), want within [0, %d)",
+						s.MessageIndex, s.FirstLapIndex, s.FirstLapIndex+s.NumLaps, len(a.Laps))
+				}
+			}
+			if int(a.Activity.NumSessions) != len(a.Sessions) {
+				t.Errorf("activity number of sessions: got %d, want %d", a.Activity.NumSessions, len(a.Sessions))
+			}
+		})
+	}
+}
+
+func readCorrupt(name string) func(t *testing.T) []byte {
+	return func(t *testing.T) []byte {
+		data, err := os.ReadFile(filepath.Join("..", "testdata", "corrupt", name))
+		if err != nil {
+			t.Fatalf("reading file failed: %v", err)
+		}
+		return data
+	}
+}
+
+func TestRepairErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"no data", nil},
+		{"short header", []byte{14, 0x10, 0, 0}},
+		{"header size 1", []byte{1}},
+		{"header size 12 without header", []byte{12, 0, 0}},
+		{"header size 13", append([]byte{13}, make([]byte, 20)...)},
+		{"header size 255", append([]byte{255}, make([]byte, 300)...)},
+	}
+	for _, test := range tests {
+		if _, _, err := edit.Repair(test.data); err == nil {
+			t.Errorf("%s: got no error, want one", test.name)
+		}
+	}
+}
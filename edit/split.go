@@ -0,0 +1,204 @@
+package edit
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/internal/activity"
+)
+
+// Split splits an activity file at the given times, which must be
+// increasing, into standalone activity files. The first file holds the
+// records before the first time, the second the records from the first time
+// up to the second, and so on. Every file must get at least one record.
+//
+// Distance, total cycles, accumulated power and calories of records start
+// from zero in every file. Laps and sessions within a file are kept as they
+// are, and laps and sessions that are cut are recomputed from the records.
+// If the timer was running at a cut, timer stop and start events are added
+// at the last and first record of the files on either side.
+//
+// The device infos, user profile, sport, zone targets and workout are copied
+// to every file. Every file but the first gets the time of its first record
+// as the time created of its file id, so that the files are not taken to be
+// duplicates by services identifying files by serial number and time
+// created.
+func Split(file *fit.File, at ...time.Time) ([]*fit.File, error) {
+	a, err := file.Activity()
+	if err != nil {
+		return nil, err
+	}
+	if len(a.Records) == 0 {
+		return nil, errors.New("no records")
+	}
+	for i := 1; i < len(at); i++ {
+		if !at[i].After(at[i-1]) {
+			return nil, errors.New("split times must be increasing")
+		}
+	}
+
+	// Hrv messages have no timestamps. They are placed in time by the
+	// accumulated beat intervals from the first record.
+	hrvTimes := make([]time.Time, len(a.Hrvs))
+	t := a.Records[0].Timestamp
+	for i, hrv := range a.Hrvs {
+		hrvTimes[i] = t
+		for _, v := range hrv.Time {
+			if v != 0xFFFF {
+				t = t.Add(time.Duration(v) * time.Millisecond)
+			}
+		}
+	}
+
+	files := make([]*fit.File, len(at)+1)
+	for k := range files {
+		var from, to time.Time
+		if k > 0 {
+			from = at[k-1]
+		}
+		if k < len(at) {
+			to = at[k]
+		}
+		inPart := func(t time.Time) bool {
+			return (from.IsZero() || !t.Before(from)) && (to.IsZero() || t.Before(to))
+		}
+
+		out, pa, err := newActivity(file)
+		if err != nil {
+			return nil, err
+		}
+		copyCommon(pa, a)
+
+		var base accumulated
+		for _, r := range a.Records {
+			if !from.IsZero() && r.Timestamp.Before(from) {
+				base.update(r)
+				continue
+			}
+			if !inPart(r.Timestamp) {
+				continue
+			}
+			c := copyRecord(r)
+			base.sub(c)
+			pa.Records = append(pa.Records, c)
+		}
+		if len(pa.Records) == 0 {
+			return nil, fmt.Errorf("split part %d has no records", k+1)
+		}
+		start, end := pa.Records[0].Timestamp, pa.Records[len(pa.Records)-1].Timestamp
+		if k > 0 {
+			out.FileId.TimeCreated = start
+		}
+
+		for _, e := range a.Events {
+			if inPart(e.Timestamp) {
+				pa.Events = append(pa.Events, copyEvent(e))
+			}
+		}
+		if k > 0 && timerRunning(a.Events, start) {
+			pa.Events = append(pa.Events, activity.TimerEvent(start, fit.EventTypeStart))
+		}
+		if k < len(at) && timerRunning(a.Events, end) {
+			pa.Events = append(pa.Events, activity.TimerEvent(end, fit.EventTypeStopAll))
+		}
+		sort.SliceStable(pa.Events, func(i, j int) bool { return pa.Events[i].Timestamp.Before(pa.Events[j].Timestamp) })
+
+		// contained reports whether the period from s to e is within the
+		// part, and clip returns the part of it overlapping the records, if
+		// the period overlaps the part.
+		contained := func(s, e time.Time) bool {
+			return inPart(s) && (to.IsZero() || !e.After(to))
+		}
+		clip := func(s, e time.Time) (time.Time, time.Time, bool) {
+			if (!to.IsZero() && !s.Before(to)) || (!from.IsZero() && !e.After(from)) {
+				return s, e, false
+			}
+			if s.Before(start) {
+				s = start
+			}
+			if e.After(end) {
+				e = end
+			}
+			return s, e, !e.Before(s)
+		}
+		for _, l := range a.Laps {
+			ls, le := l.StartTime, activity.LapEnd(l)
+			if contained(ls, le) {
+				pa.Laps = append(pa.Laps, copyLap(l))
+				continue
+			}
+			if cs, ce, ok := clip(ls, le); ok {
+				pa.Laps = append(pa.Laps, computeLap(pa, l, cs, ce))
+			}
+		}
+		for _, s := range a.Sessions {
+			ss, se := s.StartTime, activity.SessionEnd(s)
+			if contained(ss, se) {
+				pa.Sessions = append(pa.Sessions, copySession(s))
+				continue
+			}
+			if cs, ce, ok := clip(ss, se); ok {
+				pa.Sessions = append(pa.Sessions, computeSession(pa, s, cs, ce))
+			}
+		}
+
+		for _, l := range a.Lengths {
+			if inPart(l.StartTime) {
+				pa.Lengths = append(pa.Lengths, copyLength(l))
+			}
+		}
+		for _, l := range a.SegmentLaps {
+			if inPart(l.StartTime) {
+				pa.SegmentLaps = append(pa.SegmentLaps, copySegmentLap(l))
+			}
+		}
+		// Hr messages without a timestamp belong with the previous one.
+		hrTime := a.Records[0].Timestamp
+		for _, hr := range a.Hrs {
+			if !hr.Timestamp.IsZero() && !fit.IsBaseTime(hr.Timestamp) {
+				hrTime = hr.Timestamp
+			}
+			if inPart(hrTime) {
+				c := *hr
+				pa.Hrs = append(pa.Hrs, &c)
+			}
+		}
+		for i, hrv := range a.Hrvs {
+			if inPart(hrvTimes[i]) {
+				c := *hrv
+				pa.Hrvs = append(pa.Hrvs, &c)
+			}
+		}
+		for _, bi := range a.BeatIntervals {
+			if inPart(bi.Timestamp) {
+				c := *bi
+				pa.BeatIntervals = append(pa.BeatIntervals, &c)
+			}
+		}
+
+		renumber(pa)
+		pa.Activity = activityMsg(a.Activity, pa.Sessions, end)
+		files[k] = out
+	}
+	return files, nil
+}
+
+// SplitLaps splits an activity file at the start of the laps with the given
+// indexes, which must be increasing. See Split for details.
+func SplitLaps(file *fit.File, laps ...int) ([]*fit.File, error) {
+	a, err := file.Activity()
+	if err != nil {
+		return nil, err
+	}
+	at := make([]time.Time, len(laps))
+	for i, l := range laps {
+		if l <= 0 || l >= len(a.Laps) {
+			return nil, fmt.Errorf("lap index %d out of range [1, %d)", l, len(a.Laps))
+		}
+		at[i] = a.Laps[l].StartTime
+	}
+	return Split(file, at...)
+}
@@ -1,6 +1,7 @@
 package fit
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
 )
@@ -164,6 +165,77 @@ func (f *File) Type() FileType {
 	return f.FileId.Type
 }
 
+// MarshalJSON implements the json.Marshaler interface. The messages specific
+// to the file type are encoded as an object named after the file type, e.g.
+// "Activity" for activity files. Unknown messages, unknown fields and
+// developer data are not included.
+func (f *File) MarshalJSON() ([]byte, error) {
+	e := newJSONEncodeState()
+	e.open()
+	if err := e.jsonField("Header", f.Header); err != nil {
+		return nil, err
+	}
+	e.uintField("CRC", uint64(f.CRC), "")
+	if err := e.jsonField("FileId", &f.FileId); err != nil {
+		return nil, err
+	}
+	if f.FileCreator != nil {
+		if err := e.jsonField("FileCreator", f.FileCreator); err != nil {
+			return nil, err
+		}
+	}
+	if f.TimestampCorrelation != nil {
+		if err := e.jsonField("TimestampCorrelation", f.TimestampCorrelation); err != nil {
+			return nil, err
+		}
+	}
+	if f.msgAdder != nil {
+		if err := e.jsonField(f.Type().String(), f.msgAdder); err != nil {
+			return nil, err
+		}
+	}
+	e.close()
+	return e.done(), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It accepts the
+// output of MarshalJSON, and the resulting file can be encoded using Encode.
+func (f *File) UnmarshalJSON(data []byte) error {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+	fileID, found := obj["FileId"]
+	if !found {
+		return FormatError("file id message missing")
+	}
+
+	*f = File{}
+	if err := json.Unmarshal(fileID, &f.FileId); err != nil {
+		return fmt.Errorf("error decoding file id message: %w", err)
+	}
+	if err := f.init(); err != nil {
+		return err
+	}
+
+	for name, v := range map[string]interface{}{
+		"Header":               &f.Header,
+		"CRC":                  &f.CRC,
+		"FileCreator":          &f.FileCreator,
+		"TimestampCorrelation": &f.TimestampCorrelation,
+		f.Type().String():      f.msgAdder,
+	} {
+		raw, found := obj[name]
+		if !found {
+			continue
+		}
+		if err := json.Unmarshal(raw, v); err != nil {
+			return fmt.Errorf("error decoding %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
 type wrongFileTypeError struct {
 	actual, requested FileType
 }
@@ -26,7 +26,9 @@ type ActivityFile struct {
 	Hrvs         []*HrvMsg
 
 	// Requested messages by users.
-	Sport *SportMsg
+	Sport         *SportMsg
+	TimeInZones   []*TimeInZoneMsg
+	BeatIntervals []*BeatIntervalsMsg
 }
 
 // DeviceFile represents the Device FIT file type.
@@ -206,6 +208,10 @@ func (a *ActivityFile) add(msg reflect.Value) {
 		a.Hrvs = append(a.Hrvs, &tmp)
 	case SportMsg:
 		a.Sport = &tmp
+	case TimeInZoneMsg:
+		a.TimeInZones = append(a.TimeInZones, &tmp)
+	case BeatIntervalsMsg:
+		a.BeatIntervals = append(a.BeatIntervals, &tmp)
 	default:
 	}
 }
@@ -0,0 +1,274 @@
+package fit
+
+import (
+	"math"
+	"sort"
+)
+
+// Earth models used by the geodesic functions.
+const (
+	// earthRadius is the mean radius of the earth in meters, used for
+	// spherical calculations.
+	earthRadius = 6371008.8
+
+	// WGS 84 ellipsoid semi-major axis in meters and flattening, used by
+	// DistanceVincenty.
+	wgs84A = 6378137.0
+	wgs84F = 1 / 298.257223563
+
+	vincentyMaxIterations = 200
+)
+
+// Position represents a geographical position.
+type Position struct {
+	Lat  Latitude
+	Long Longitude
+}
+
+// NewPositionDegrees returns a new position from a latitude and longitude in
+// degrees. If either is out of range, the returned position is invalid.
+func NewPositionDegrees(lat, long float64) Position {
+	return Position{Lat: NewLatitudeDegrees(lat), Long: NewLongitudeDegrees(long)}
+}
+
+// Invalid reports whether the latitude or longitude of p is invalid.
+func (p Position) Invalid() bool {
+	return p.Lat.Invalid() || p.Long.Invalid()
+}
+
+// String returns a string representation of p as latitude and longitude in
+// degrees with 5 decimal places.
+func (p Position) String() string {
+	return p.Lat.String() + ", " + p.Long.String()
+}
+
+func (p Position) radians() (lat, long float64) {
+	return p.Lat.Degrees() * math.Pi / 180, p.Long.Degrees() * math.Pi / 180
+}
+
+// Distance returns the great-circle distance in meters between p and q,
+// using the haversine formula on a spherical earth. The error compared to
+// DistanceVincenty is up to 0.6%. If p or q is invalid then NaN is
+// returned.
+func (p Position) Distance(q Position) float64 {
+	if p.Invalid() || q.Invalid() {
+		return math.NaN()
+	}
+	return earthRadius * p.angle(q)
+}
+
+// angle returns the central angle in radians between p and q.
+func (p Position) angle(q Position) float64 {
+	lat1, long1 := p.radians()
+	lat2, long2 := q.radians()
+	sinLat := math.Sin((lat2 - lat1) / 2)
+	sinLong := math.Sin((long2 - long1) / 2)
+	h := sinLat*sinLat + math.Cos(lat1)*math.Cos(lat2)*sinLong*sinLong
+	return 2 * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// DistanceVincenty returns the distance in meters between p and q on the
+// WGS 84 ellipsoid, using Vincenty's inverse formula. It is accurate to
+// within a millimeter, but slower than Distance. If p or q is invalid, or
+// the formula fails to converge, which may happen for nearly antipodal
+// points, then NaN is returned.
+func (p Position) DistanceVincenty(q Position) float64 {
+	if p.Invalid() || q.Invalid() {
+		return math.NaN()
+	}
+	const b = wgs84A * (1 - wgs84F)
+	lat1, long1 := p.radians()
+	lat2, long2 := q.radians()
+	l := long2 - long1
+	u1 := math.Atan((1 - wgs84F) * math.Tan(lat1))
+	u2 := math.Atan((1 - wgs84F) * math.Tan(lat2))
+	sinU1, cosU1 := math.Sincos(u1)
+	sinU2, cosU2 := math.Sincos(u2)
+
+	lambda := l
+	for i := 0; i < vincentyMaxIterations; i++ {
+		sinLambda, cosLambda := math.Sincos(lambda)
+		sinSigma := math.Hypot(cosU2*sinLambda, cosU1*sinU2-sinU1*cosU2*cosLambda)
+		if sinSigma == 0 {
+			return 0
+		}
+		cosSigma := sinU1*sinU2 + cosU1*cosU2*cosLambda
+		sigma := math.Atan2(sinSigma, cosSigma)
+		sinAlpha := cosU1 * cosU2 * sinLambda / sinSigma
+		cos2Alpha := 1 - sinAlpha*sinAlpha
+		cos2SigmaM := 0.0
+		if cos2Alpha != 0 {
+			cos2SigmaM = cosSigma - 2*sinU1*sinU2/cos2Alpha
+		}
+		c := wgs84F / 16 * cos2Alpha * (4 + wgs84F*(4-3*cos2Alpha))
+		prev := lambda
+		lambda = l + (1-c)*wgs84F*sinAlpha*
+			(sigma+c*sinSigma*(cos2SigmaM+c*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+		if math.Abs(lambda-prev) > 1e-12 {
+			continue
+		}
+
+		uSq := cos2Alpha * (wgs84A*wgs84A - b*b) / (b * b)
+		bigA := 1 + uSq/16384*(4096+uSq*(-768+uSq*(320-175*uSq)))
+		bigB := uSq / 1024 * (256 + uSq*(-128+uSq*(74-47*uSq)))
+		deltaSigma := bigB * sinSigma * (cos2SigmaM + bigB/4*
+			(cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)-
+				bigB/6*cos2SigmaM*(-3+4*sinSigma*sinSigma)*(-3+4*cos2SigmaM*cos2SigmaM)))
+		return b * bigA * (sigma - deltaSigma)
+	}
+	return math.NaN()
+}
+
+// Bearing returns the initial bearing in degrees, from 0 up to 360 and
+// clockwise from north, of the great circle path from p to q. If p or q is
+// invalid then NaN is returned.
+func (p Position) Bearing(q Position) float64 {
+	if p.Invalid() || q.Invalid() {
+		return math.NaN()
+	}
+	lat1, long1 := p.radians()
+	lat2, long2 := q.radians()
+	dLong := long2 - long1
+	y := math.Sin(dLong) * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(dLong)
+	return math.Mod(math.Atan2(y, x)*180/math.Pi+360, 360)
+}
+
+// Destination returns the position reached by travelling the given distance
+// in meters from p along the great circle with the given initial bearing in
+// degrees. The destination may be on the antimeridian, see
+// NewLongitudeDegrees. If p is invalid then an invalid position is returned.
+func (p Position) Destination(bearing, distance float64) Position {
+	if p.Invalid() {
+		return Position{Lat: NewLatitudeInvalid(), Long: NewLongitudeInvalid()}
+	}
+	lat1, long1 := p.radians()
+	theta := bearing * math.Pi / 180
+	delta := distance / earthRadius
+	sinLat := math.Sin(lat1)*math.Cos(delta) + math.Cos(lat1)*math.Sin(delta)*math.Cos(theta)
+	lat2 := math.Asin(sinLat)
+	long2 := long1 + math.Atan2(
+		math.Sin(theta)*math.Sin(delta)*math.Cos(lat1),
+		math.Cos(delta)-math.Sin(lat1)*sinLat,
+	)
+	// Normalise the longitude to (-180, 180].
+	long := math.Mod(long2*180/math.Pi+180, 360)
+	if long <= 0 {
+		long += 360
+	}
+	return NewPositionDegrees(lat2*180/math.Pi, long-180)
+}
+
+// DistanceToSegment returns the shortest distance in meters from p to the
+// great circle segment from a to b, on a spherical earth. If the closest
+// point on the great circle through a and b is outside the segment then the
+// distance to the closest of a and b is returned. If any position is
+// invalid then NaN is returned.
+func (p Position) DistanceToSegment(a, b Position) float64 {
+	if p.Invalid() || a.Invalid() || b.Invalid() {
+		return math.NaN()
+	}
+	d13 := a.angle(p)
+	d12 := a.angle(b)
+	if d12 == 0 {
+		return earthRadius * d13
+	}
+	theta := (a.Bearing(p) - a.Bearing(b)) * math.Pi / 180
+	if math.Cos(theta) < 0 {
+		// The closest point is before a.
+		return earthRadius * d13
+	}
+	xt := math.Asin(math.Sin(d13) * math.Sin(theta))
+	at := math.Acos(math.Max(-1, math.Min(1, math.Cos(d13)/math.Cos(xt))))
+	if at > d12 {
+		return earthRadius * b.angle(p)
+	}
+	return earthRadius * math.Abs(xt)
+}
+
+// BoundingBox represents the area between two latitudes and two longitudes.
+// If West is greater than East, the box crosses the antimeridian.
+type BoundingBox struct {
+	South Latitude
+	West  Longitude
+	North Latitude
+	East  Longitude
+}
+
+// NewBoundingBox returns the smallest bounding box containing the valid
+// positions. If the positions span the antimeridian more narrowly than the
+// prime meridian, the returned box crosses the antimeridian. It reports
+// false if there are no valid positions.
+func NewBoundingBox(positions []Position) (BoundingBox, bool) {
+	var (
+		south, north int32
+		longs        []int32
+	)
+	for _, p := range positions {
+		if p.Invalid() {
+			continue
+		}
+		lat := p.Lat.Semicircles()
+		if len(longs) == 0 || lat < south {
+			south = lat
+		}
+		if len(longs) == 0 || lat > north {
+			north = lat
+		}
+		longs = append(longs, p.Long.Semicircles())
+	}
+	if len(longs) == 0 {
+		return BoundingBox{}, false
+	}
+
+	// The box covers all longitudes except the largest gap between them,
+	// which may be the one across the antimeridian.
+	sort.Slice(longs, func(i, j int) bool { return longs[i] < longs[j] })
+	west, east := longs[0], longs[len(longs)-1]
+	largest := int64(1<<32) - (int64(east) - int64(west))
+	for i := 1; i < len(longs); i++ {
+		if gap := int64(longs[i]) - int64(longs[i-1]); gap > largest {
+			largest = gap
+			west, east = longs[i], longs[i-1]
+		}
+	}
+	return BoundingBox{
+		South: NewLatitude(south),
+		West:  NewLongitude(west),
+		North: NewLatitude(north),
+		East:  NewLongitude(east),
+	}, true
+}
+
+// Contains reports whether p is inside the bounding box, including its
+// edges.
+func (b BoundingBox) Contains(p Position) bool {
+	if p.Invalid() {
+		return false
+	}
+	lat, long := p.Lat.Semicircles(), p.Long.Semicircles()
+	if lat < b.South.Semicircles() || lat > b.North.Semicircles() {
+		return false
+	}
+	west, east := b.West.Semicircles(), b.East.Semicircles()
+	if west <= east {
+		return long >= west && long <= east
+	}
+	return long >= west || long <= east
+}
+
+// Center returns the center of the bounding box.
+func (b BoundingBox) Center() Position {
+	west, east := int64(b.West.Semicircles()), int64(b.East.Semicircles())
+	if west > east {
+		east += 1 << 32
+	}
+	long := (west + east) / 2
+	if long > math.MaxInt32 {
+		long -= 1 << 32
+	}
+	return Position{
+		Lat:  NewLatitude(int32((int64(b.South.Semicircles()) + int64(b.North.Semicircles())) / 2)),
+		Long: NewLongitude(int32(long)),
+	}
+}
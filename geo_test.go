@@ -0,0 +1,171 @@
+package fit_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tormoder/fit"
+)
+
+// dms returns degrees, minutes and seconds as degrees.
+func dms(d, m, s float64) float64 {
+	if d < 0 {
+		return d - m/60 - s/3600
+	}
+	return d + m/60 + s/3600
+}
+
+var (
+	flindersPeak = fit.NewPositionDegrees(dms(-37, 57, 3.72030), dms(144, 25, 29.52440))
+	buninyong    = fit.NewPositionDegrees(dms(-37, 39, 10.15610), dms(143, 55, 35.38390))
+	london       = fit.NewPositionDegrees(51.5007, -0.1246)
+	newYork      = fit.NewPositionDegrees(40.6892, -74.0445)
+	invalid      = fit.Position{Lat: fit.NewLatitudeInvalid(), Long: fit.NewLongitudeInvalid()}
+)
+
+func TestPositionDistance(t *testing.T) {
+	tests := []struct {
+		name     string
+		p, q     fit.Position
+		vincenty float64
+		tol      float64
+	}{
+		{"same", london, london, 0, 0.001},
+		{"flinders peak to buninyong", flindersPeak, buninyong, 54972.271, 0.01},
+		{"along meridian", fit.NewPositionDegrees(0, 0), fit.NewPositionDegrees(1, 0), 110574.389, 0.01},
+		{"along equator", fit.NewPositionDegrees(0, 0), fit.NewPositionDegrees(0, 1), 111319.491, 0.01},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.p.DistanceVincenty(test.q); math.Abs(got-test.vincenty) > test.tol {
+				t.Errorf("Vincenty: got %v, want %v", got, test.vincenty)
+			}
+			if got := test.p.Distance(test.q); math.Abs(got-test.vincenty) > 0.006*test.vincenty+test.tol {
+				t.Errorf("haversine: got %v, want %v within 0.6%%", got, test.vincenty)
+			}
+		})
+	}
+
+	if !math.IsNaN(london.Distance(invalid)) || !math.IsNaN(invalid.DistanceVincenty(london)) {
+		t.Errorf("got distance to invalid position, want NaN")
+	}
+}
+
+func TestPositionBearingAndDestination(t *testing.T) {
+	// Initial bearing from Vincenty's direct example, on a sphere.
+	if got, want := flindersPeak.Bearing(buninyong), dms(306, 52, 5.37); math.Abs(got-want) > 0.2 {
+		t.Errorf("bearing: got %v, want about %v", got, want)
+	}
+	tests := []struct {
+		name    string
+		p       fit.Position
+		bearing float64
+	}{
+		{"north", fit.NewPositionDegrees(0, 0), 0},
+		{"east", fit.NewPositionDegrees(0, 0), 90},
+		{"south west", london, 225},
+		{"across antimeridian", fit.NewPositionDegrees(10, 179.99), 90},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			q := test.p.Destination(test.bearing, 10000)
+			if q.Invalid() {
+				t.Fatalf("got invalid destination")
+			}
+			if d := test.p.Distance(q); math.Abs(d-10000) > 0.01 {
+				t.Errorf("distance to destination: got %v, want 10000", d)
+			}
+			if b := test.p.Bearing(q); math.Abs(math.Mod(b-test.bearing+540, 360)-180) > 1e-4 {
+				t.Errorf("bearing to destination: got %v, want %v", b, test.bearing)
+			}
+		})
+	}
+
+	for _, long := range []float64{-180, 180} {
+		q := fit.NewPositionDegrees(0, long).Destination(90, 0)
+		if q.Invalid() || q.Long.Degrees() != -180 {
+			t.Errorf("destination on antimeridian from %v: got %v, want longitude -180", long, q)
+		}
+	}
+}
+
+func TestPositionDistanceToSegment(t *testing.T) {
+	a := fit.NewPositionDegrees(0, 0)
+	b := fit.NewPositionDegrees(0, 1)
+	tests := []struct {
+		name string
+		p    fit.Position
+		want fit.Position // Closest point on the segment.
+	}{
+		{"above middle", fit.NewPositionDegrees(0.01, 0.5), fit.NewPositionDegrees(0, 0.5)},
+		{"below middle", fit.NewPositionDegrees(-0.01, 0.5), fit.NewPositionDegrees(0, 0.5)},
+		{"before start", fit.NewPositionDegrees(0.01, -0.5), a},
+		{"after end", fit.NewPositionDegrees(0.01, 1.5), b},
+		{"on segment", fit.NewPositionDegrees(0, 0.25), fit.NewPositionDegrees(0, 0.25)},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.p.DistanceToSegment(a, b)
+			if want := test.p.Distance(test.want); math.Abs(got-want) > 0.01 {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		})
+	}
+	if got, want := london.DistanceToSegment(newYork, newYork), london.Distance(newYork); got != want {
+		t.Errorf("degenerate segment: got %v, want %v", got, want)
+	}
+}
+
+func TestNewBoundingBox(t *testing.T) {
+	tests := []struct {
+		name      string
+		positions []fit.Position
+		want      [4]float64 // South, west, north, east.
+		inside    fit.Position
+		outside   fit.Position
+	}{
+		{
+			name:      "europe",
+			positions: []fit.Position{london, invalid, fit.NewPositionDegrees(59.9, 10.75), fit.NewPositionDegrees(48.85, 2.35)},
+			want:      [4]float64{48.85, -0.1246, 59.9, 10.75},
+			inside:    fit.NewPositionDegrees(55, 5),
+			outside:   newYork,
+		},
+		{
+			name:      "antimeridian",
+			positions: []fit.Position{fit.NewPositionDegrees(-17, 179), fit.NewPositionDegrees(-18, -179), fit.NewPositionDegrees(-16, 178)},
+			want:      [4]float64{-18, 178, -16, -179},
+			inside:    fit.NewPositionDegrees(-17, 179.9),
+			outside:   fit.NewPositionDegrees(-17, 0),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			box, ok := fit.NewBoundingBox(test.positions)
+			if !ok {
+				t.Fatalf("got no bounding box")
+			}
+			got := [4]float64{box.South.Degrees(), box.West.Degrees(), box.North.Degrees(), box.East.Degrees()}
+			for i := range got {
+				if math.Abs(got[i]-test.want[i]) > 1e-6 {
+					t.Errorf("got %v, want %v", got, test.want)
+					break
+				}
+			}
+			if !box.Contains(test.inside) || box.Contains(test.outside) || box.Contains(invalid) {
+				t.Errorf("Contains: unexpected result for %v", box)
+			}
+			for _, p := range test.positions {
+				if !p.Invalid() && !box.Contains(p) {
+					t.Errorf("Contains(%v): got false for position in box", p)
+				}
+			}
+			if c := box.Center(); !box.Contains(c) {
+				t.Errorf("center %v not in box", c)
+			}
+		})
+	}
+	if _, ok := fit.NewBoundingBox([]fit.Position{invalid}); ok {
+		t.Errorf("got bounding box for invalid positions")
+	}
+}
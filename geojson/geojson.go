@@ -0,0 +1,185 @@
+// Package geojson implements conversion of FIT activity and course files to
+// GeoJSON (RFC 7946) documents.
+package geojson
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/internal/track"
+)
+
+type featureCollection struct {
+	Type     string    `json:"type"`
+	Features []feature `json:"features"`
+}
+
+type feature struct {
+	Type       string                 `json:"type"`
+	Geometry   geometry               `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+type geometry struct {
+	Type        string      `json:"type"`
+	Coordinates interface{} `json:"coordinates"`
+}
+
+// value is a float64 that is encoded as null if it is NaN.
+type value float64
+
+func (v value) MarshalJSON() ([]byte, error) {
+	if math.IsNaN(float64(v)) {
+		return []byte("null"), nil
+	}
+	return json.Marshal(float64(v))
+}
+
+// WriteGeoJSON writes the activity or course file to w as a GeoJSON feature
+// collection.
+//
+// The first features are the track. Records with a valid position are
+// written as a LineString, or as a MultiLineString with one line per part if
+// the timer was paused. The times, heart rate, power and speed of every
+// position are written as properties in parallel arrays, nested per line for
+// a MultiLineString, with null for invalid values. Parts with a single
+// position can not be written as a line, and are written as Point features
+// with single values as properties following the line. The start and end
+// position of every lap, and the course points of course files, are written
+// as Point features.
+func WriteGeoJSON(w io.Writer, file *fit.File) error {
+	t, err := track.FromFile(file)
+	if err != nil {
+		return fmt.Errorf("geojson: %w", err)
+	}
+
+	fc := featureCollection{
+		Type:     "FeatureCollection",
+		Features: []feature{}, // Never null.
+	}
+	fc.Features = append(fc.Features, trackFeatures(t)...)
+	for _, m := range t.Markers {
+		fc.Features = append(fc.Features, markerFeature(m))
+	}
+
+	return json.NewEncoder(w).Encode(fc)
+}
+
+// trackFeatures returns the features of the track: a line feature for the
+// segments with at least two positions, as a line needs two positions (RFC
+// 7946 section 3.1.4), followed by a Point feature for every segment with a
+// single position.
+func trackFeatures(t *track.Track) []feature {
+	var (
+		features                   []feature
+		coords                     [][][]float64
+		times                      [][]string
+		hr, power, speed, altitude [][]value
+	)
+	for _, seg := range t.Segments {
+		if len(seg) == 1 {
+			pt := seg[0]
+			props := trackProperties(t)
+			props["times"] = formatTime(pt.Time)
+			props["heart_rate"] = value(pt.HeartRate)
+			props["power"] = value(pt.Power)
+			props["speed"] = value(pt.Speed)
+			props["altitude"] = value(pt.Altitude)
+			features = append(features, feature{
+				Type:       "Feature",
+				Geometry:   geometry{Type: "Point", Coordinates: []float64{pt.Lon, pt.Lat}},
+				Properties: props,
+			})
+			continue
+		}
+		var (
+			c          [][]float64
+			ts         []string
+			h, p, s, a []value
+		)
+		for _, pt := range seg {
+			c = append(c, []float64{pt.Lon, pt.Lat})
+			ts = append(ts, formatTime(pt.Time))
+			h = append(h, value(pt.HeartRate))
+			p = append(p, value(pt.Power))
+			s = append(s, value(pt.Speed))
+			a = append(a, value(pt.Altitude))
+		}
+		coords = append(coords, c)
+		times = append(times, ts)
+		hr = append(hr, h)
+		power = append(power, p)
+		speed = append(speed, s)
+		altitude = append(altitude, a)
+	}
+	if len(coords) == 0 {
+		return features
+	}
+
+	props := trackProperties(t)
+	line := feature{Type: "Feature", Properties: props}
+	if len(coords) == 1 {
+		props["times"] = times[0]
+		props["heart_rate"] = hr[0]
+		props["power"] = power[0]
+		props["speed"] = speed[0]
+		props["altitude"] = altitude[0]
+		line.Geometry = geometry{Type: "LineString", Coordinates: coords[0]}
+	} else {
+		props["times"] = times
+		props["heart_rate"] = hr
+		props["power"] = power
+		props["speed"] = speed
+		props["altitude"] = altitude
+		line.Geometry = geometry{Type: "MultiLineString", Coordinates: coords}
+	}
+	return append([]feature{line}, features...)
+}
+
+// trackProperties returns the properties common to all track features.
+func trackProperties(t *track.Track) map[string]interface{} {
+	props := map[string]interface{}{}
+	if t.Name != "" {
+		props["name"] = t.Name
+	}
+	if t.Sport != fit.SportInvalid {
+		props["sport"] = strings.ToLower(t.Sport.String())
+	}
+	return props
+}
+
+func markerFeature(m track.Marker) feature {
+	props := map[string]interface{}{
+		"kind": m.Kind,
+	}
+	switch m.Kind {
+	case track.KindCoursePoint:
+		props["index"] = m.Index
+		props["type"] = m.PointType
+		if m.Name != "" {
+			props["name"] = m.Name
+		}
+	default:
+		props["lap"] = m.Index
+	}
+	if s := formatTime(m.Time); s != "" {
+		props["time"] = s
+	}
+	return feature{
+		Type:       "Feature",
+		Geometry:   geometry{Type: "Point", Coordinates: []float64{m.Lon, m.Lat}},
+		Properties: props,
+	}
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() || fit.IsBaseTime(t) {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
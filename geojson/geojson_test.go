@@ -0,0 +1,268 @@
+package geojson_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/geojson"
+)
+
+type featureCollection struct {
+	Type     string `json:"type"`
+	Features []struct {
+		Geometry struct {
+			Type        string          `json:"type"`
+			Coordinates json.RawMessage `json:"coordinates"`
+		} `json:"geometry"`
+		Properties map[string]json.RawMessage `json:"properties"`
+	} `json:"features"`
+}
+
+func writeAndParse(t *testing.T, file *fit.File) featureCollection {
+	t.Helper()
+	buf := new(bytes.Buffer)
+	if err := geojson.WriteGeoJSON(buf, file); err != nil {
+		t.Fatalf("WriteGeoJSON: got error, want none; error is: %v", err)
+	}
+	var fc featureCollection
+	if err := json.Unmarshal(buf.Bytes(), &fc); err != nil {
+		t.Fatalf("unmarshal: got error, want none; error is: %v", err)
+	}
+	if fc.Type != "FeatureCollection" {
+		t.Fatalf("got type %q, want FeatureCollection", fc.Type)
+	}
+	return fc
+}
+
+func TestWriteGeoJSONActivity(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "testdata", "dcrainmaker", "Edge810-Vector-2013-08-16-15-35-10.fit"))
+	if err != nil {
+		t.Fatalf("reading file failed: %v", err)
+	}
+	file, err := fit.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("decode: got error, want none; error is: %v", err)
+	}
+	activity, err := file.Activity()
+	if err != nil {
+		t.Fatalf("activity: got error, want none; error is: %v", err)
+	}
+	var wantPts int
+	for _, r := range activity.Records {
+		if !r.PositionLat.Invalid() && !r.PositionLong.Invalid() {
+			wantPts++
+		}
+	}
+
+	fc := writeAndParse(t, file)
+	if len(fc.Features) != 1+2*len(activity.Laps) {
+		t.Fatalf("got %d features, want %d", len(fc.Features), 1+2*len(activity.Laps))
+	}
+	trk := fc.Features[0]
+	if trk.Geometry.Type != "LineString" {
+		t.Fatalf("track geometry: got %q, want LineString", trk.Geometry.Type)
+	}
+	var coords [][]float64
+	if err = json.Unmarshal(trk.Geometry.Coordinates, &coords); err != nil {
+		t.Fatalf("coordinates: got error, want none; error is: %v", err)
+	}
+	if len(coords) != wantPts {
+		t.Errorf("got %d coordinates, want %d", len(coords), wantPts)
+	}
+	for _, prop := range []string{"times", "heart_rate", "power", "speed"} {
+		var values []*float64
+		if prop == "times" {
+			var times []string
+			err = json.Unmarshal(trk.Properties[prop], &times)
+			values = make([]*float64, len(times))
+		} else {
+			err = json.Unmarshal(trk.Properties[prop], &values)
+		}
+		if err != nil {
+			t.Errorf("%s: got error, want none; error is: %v", prop, err)
+			continue
+		}
+		if len(values) != wantPts {
+			t.Errorf("%s: got %d values, want %d", prop, len(values), wantPts)
+		}
+	}
+	for _, f := range fc.Features[1:] {
+		if f.Geometry.Type != "Point" {
+			t.Errorf("lap feature: got geometry %q, want Point", f.Geometry.Type)
+		}
+	}
+}
+
+func TestWriteGeoJSONPauses(t *testing.T) {
+	fc := writeAndParse(t, pausedActivity(t))
+	if len(fc.Features) != 2 {
+		t.Fatalf("got %d features, want 2", len(fc.Features))
+	}
+	trk := fc.Features[0]
+	if trk.Geometry.Type != "MultiLineString" {
+		t.Fatalf("track geometry: got %q, want MultiLineString", trk.Geometry.Type)
+	}
+	var coords [][][]float64
+	if err := json.Unmarshal(trk.Geometry.Coordinates, &coords); err != nil {
+		t.Fatalf("coordinates: got error, want none; error is: %v", err)
+	}
+	if len(coords) != 2 || len(coords[0]) != 10 || len(coords[1]) != 5 {
+		t.Fatalf("got lines %v, want 2 lines of 10 and 5 positions", coords)
+	}
+	var hr [][]*float64
+	if err := json.Unmarshal(trk.Properties["heart_rate"], &hr); err != nil {
+		t.Fatalf("heart_rate: got error, want none; error is: %v", err)
+	}
+	if len(hr) != 2 || hr[0][0] != nil || hr[0][1] == nil || *hr[0][1] != 121 {
+		t.Errorf("heart_rate: got %s, want null for the first position and 121 for the second", trk.Properties["heart_rate"])
+	}
+
+	lap := fc.Features[1]
+	var kind string
+	if err := json.Unmarshal(lap.Properties["kind"], &kind); err != nil || kind != "lap_start" {
+		t.Errorf("lap feature: got kind %q, want lap_start", kind)
+	}
+}
+
+func TestWriteGeoJSONSinglePositions(t *testing.T) {
+	start := time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		offsets []int // Record offsets; the timer is stopped after 9 seconds.
+		want    []string
+	}{
+		{"one position", []int{0}, []string{"Point"}},
+		{"one position per part", []int{0, 15}, []string{"Point", "Point"}},
+		{"one position in part", []int{0, 1, 15}, []string{"LineString", "Point"}},
+		{"one position in first part", []int{0, 15, 16}, []string{"LineString", "Point"}},
+		{"lines", []int{0, 1, 15, 16}, []string{"MultiLineString"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			file, err := fit.NewFile(fit.FileTypeActivity, fit.NewHeader(fit.V20, true))
+			if err != nil {
+				t.Fatalf("NewFile: got error, want none; error is: %v", err)
+			}
+			for _, ev := range []struct {
+				offset int
+				typ    fit.EventType
+			}{{0, fit.EventTypeStart}, {9, fit.EventTypeStop}, {15, fit.EventTypeStart}, {19, fit.EventTypeStopAll}} {
+				e := fit.NewEventMsg()
+				e.Timestamp = start.Add(time.Duration(ev.offset) * time.Second)
+				e.Event = fit.EventTimer
+				e.EventType = ev.typ
+				mustAdd(t, file, e)
+			}
+			for _, off := range test.offsets {
+				r := fit.NewRecordMsg()
+				r.Timestamp = start.Add(time.Duration(off) * time.Second)
+				r.PositionLat = fit.NewLatitudeDegrees(59.9 + float64(off)*0.0001)
+				r.PositionLong = fit.NewLongitudeDegrees(10.7)
+				r.HeartRate = 120
+				mustAdd(t, file, r)
+			}
+
+			fc := writeAndParse(t, file)
+			if len(fc.Features) != len(test.want) {
+				t.Fatalf("got %d features, want %d", len(fc.Features), len(test.want))
+			}
+			for i, f := range fc.Features {
+				if f.Geometry.Type != test.want[i] {
+					t.Fatalf("feature %d: got geometry %q, want %s", i, f.Geometry.Type, test.want[i])
+				}
+				if f.Geometry.Type != "Point" {
+					continue
+				}
+				var coords []float64
+				if err := json.Unmarshal(f.Geometry.Coordinates, &coords); err != nil || len(coords) != 2 {
+					t.Errorf("feature %d: coordinates: got %s, want one position", i, f.Geometry.Coordinates)
+				}
+				var hr float64
+				if err := json.Unmarshal(f.Properties["heart_rate"], &hr); err != nil || hr != 120 {
+					t.Errorf("feature %d: heart_rate: got %s, want 120", i, f.Properties["heart_rate"])
+				}
+			}
+		})
+	}
+}
+
+func TestWriteGeoJSONCoursePoints(t *testing.T) {
+	file, err := fit.NewFile(fit.FileTypeCourse, fit.NewHeader(fit.V20, true))
+	if err != nil {
+		t.Fatalf("NewFile: got error, want none; error is: %v", err)
+	}
+	cp := fit.NewCoursePointMsg()
+	cp.PositionLat = fit.NewLatitudeDegrees(59.9)
+	cp.PositionLong = fit.NewLongitudeDegrees(10.7)
+	cp.Type = fit.CoursePointSummit
+	cp.Name = "Top"
+	mustAdd(t, file, cp)
+
+	fc := writeAndParse(t, file)
+	if len(fc.Features) != 1 {
+		t.Fatalf("got %d features, want 1", len(fc.Features))
+	}
+	var name, typ string
+	_ = json.Unmarshal(fc.Features[0].Properties["name"], &name)
+	_ = json.Unmarshal(fc.Features[0].Properties["type"], &typ)
+	if name != "Top" || typ != "Summit" {
+		t.Errorf("course point: got name %q and type %q, want Top and Summit", name, typ)
+	}
+
+	settings, err := fit.NewFile(fit.FileTypeSettings, fit.NewHeader(fit.V20, true))
+	if err != nil {
+		t.Fatalf("NewFile: got error, want none; error is: %v", err)
+	}
+	if err = geojson.WriteGeoJSON(new(bytes.Buffer), settings); err == nil {
+		t.Errorf("settings file: got no error, want one")
+	}
+}
+
+// pausedActivity returns an activity with 20 records, where the timer is
+// stopped after 9 seconds and started again after 15 seconds.
+func pausedActivity(t *testing.T) *fit.File {
+	t.Helper()
+	file, err := fit.NewFile(fit.FileTypeActivity, fit.NewHeader(fit.V20, true))
+	if err != nil {
+		t.Fatalf("NewFile: got error, want none; error is: %v", err)
+	}
+	start := time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)
+	for _, ev := range []struct {
+		offset int
+		typ    fit.EventType
+	}{{0, fit.EventTypeStart}, {9, fit.EventTypeStop}, {15, fit.EventTypeStart}, {19, fit.EventTypeStopAll}} {
+		e := fit.NewEventMsg()
+		e.Timestamp = start.Add(time.Duration(ev.offset) * time.Second)
+		e.Event = fit.EventTimer
+		e.EventType = ev.typ
+		mustAdd(t, file, e)
+	}
+	for i := 0; i < 20; i++ {
+		r := fit.NewRecordMsg()
+		r.Timestamp = start.Add(time.Duration(i) * time.Second)
+		r.PositionLat = fit.NewLatitudeDegrees(59.9 + float64(i)*0.0001)
+		r.PositionLong = fit.NewLongitudeDegrees(10.7)
+		if i > 0 {
+			r.HeartRate = uint8(120 + i)
+		}
+		mustAdd(t, file, r)
+	}
+	lap := fit.NewLapMsg()
+	lap.StartTime = start
+	lap.StartPositionLat = fit.NewLatitudeDegrees(59.9)
+	lap.StartPositionLong = fit.NewLongitudeDegrees(10.7)
+	mustAdd(t, file, lap)
+	return file
+}
+
+func mustAdd(t *testing.T, file *fit.File, msg interface{}) {
+	t.Helper()
+	if err := file.Add(msg); err != nil {
+		t.Fatalf("Add: got error, want none; error is: %v", err)
+	}
+}
@@ -0,0 +1,274 @@
+// Package gpx implements conversion of FIT activity and course files to GPX
+// 1.1 documents, and reading of routes and tracks from GPX documents.
+package gpx
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+const (
+	nsGPX             = "http://www.topografix.com/GPX/1/1"
+	nsTrackPointExt   = "http://www.garmin.com/xmlschemas/TrackPointExtension/v1"
+	nsXSI             = "http://www.w3.org/2001/XMLSchema-instance"
+	gpxSchemaLocation = nsGPX + " http://www.topografix.com/GPX/1/1/gpx.xsd " +
+		nsTrackPointExt + " http://www8.garmin.com/xmlschemas/TrackPointExtensionv1.xsd"
+)
+
+type gpxDoc struct {
+	XMLName        xml.Name  `xml:"gpx"`
+	Xmlns          string    `xml:"xmlns,attr"`
+	XmlnsTPX       string    `xml:"xmlns:gpxtpx,attr,omitempty"`
+	XmlnsXSI       string    `xml:"xmlns:xsi,attr"`
+	SchemaLocation string    `xml:"xsi:schemaLocation,attr"`
+	Version        string    `xml:"version,attr"`
+	Creator        string    `xml:"creator,attr"`
+	Metadata       *metadata `xml:"metadata,omitempty"`
+	Wpts           []wpt     `xml:"wpt"`
+	Trks           []trk     `xml:"trk"`
+}
+
+type metadata struct {
+	Name string `xml:"name,omitempty"`
+	Time string `xml:"time,omitempty"`
+}
+
+type wpt struct {
+	Lat  string `xml:"lat,attr"`
+	Lon  string `xml:"lon,attr"`
+	Time string `xml:"time,omitempty"`
+	Name string `xml:"name,omitempty"`
+	Type string `xml:"type,omitempty"`
+}
+
+type trk struct {
+	Name string   `xml:"name,omitempty"`
+	Type string   `xml:"type,omitempty"`
+	Segs []trkseg `xml:"trkseg"`
+}
+
+type trkseg struct {
+	Pts []trkpt `xml:"trkpt"`
+}
+
+type trkpt struct {
+	Lat        string      `xml:"lat,attr"`
+	Lon        string      `xml:"lon,attr"`
+	Ele        string      `xml:"ele,omitempty"`
+	Time       string      `xml:"time,omitempty"`
+	Extensions *extensions `xml:"extensions,omitempty"`
+}
+
+type extensions struct {
+	TrackPointExtension trackPointExtension `xml:"gpxtpx:TrackPointExtension"`
+}
+
+// trackPointExtension is the Garmin TrackPointExtension v1. The order of the
+// fields is given by the schema.
+type trackPointExtension struct {
+	ATemp string `xml:"gpxtpx:atemp,omitempty"`
+	HR    string `xml:"gpxtpx:hr,omitempty"`
+	Cad   string `xml:"gpxtpx:cad,omitempty"`
+}
+
+// WriteGPX writes the activity or course file to w as a GPX 1.1 document.
+//
+// Records with a valid position are written as track points of a single
+// track, split into track segments by lap or session as configured. Altitude
+// and time is written for every track point, and heart rate, cadence and
+// temperature using the Garmin TrackPointExtension. Course points of course
+// files are written as waypoints.
+func WriteGPX(w io.Writer, file *fit.File, opts ...Option) error {
+	o := defaultOptions()
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	doc := gpxDoc{
+		Xmlns:          nsGPX,
+		XmlnsXSI:       nsXSI,
+		SchemaLocation: gpxSchemaLocation,
+		Version:        "1.1",
+		Creator:        o.creator,
+	}
+	if o.extensions {
+		doc.XmlnsTPX = nsTrackPointExt
+	}
+
+	var (
+		t      trk
+		starts []time.Time
+		recs   []*fit.RecordMsg
+	)
+	switch file.Type() {
+	case fit.FileTypeActivity:
+		activity, err := file.Activity()
+		if err != nil {
+			return err
+		}
+		recs = activity.Records
+		if len(activity.Sessions) > 0 {
+			t.Type = sportType(activity.Sessions[0].Sport)
+		}
+		switch o.segmentation {
+		case SegmentByLap:
+			for _, lap := range activity.Laps {
+				starts = append(starts, lap.StartTime)
+			}
+		case SegmentBySession:
+			for _, session := range activity.Sessions {
+				starts = append(starts, session.StartTime)
+			}
+		}
+	case fit.FileTypeCourse:
+		course, err := file.Course()
+		if err != nil {
+			return err
+		}
+		recs = course.Records
+		if course.Course != nil {
+			t.Type = sportType(course.Course.Sport)
+			if o.name == "" {
+				o.name = course.Course.Name
+			}
+		}
+		if course.Lap != nil {
+			starts = append(starts, course.Lap.StartTime)
+		}
+		for _, cp := range course.CoursePoints {
+			if cp.PositionLat.Invalid() || cp.PositionLong.Invalid() {
+				continue
+			}
+			name := cp.Name
+			if name == "" {
+				name = cp.Type.String()
+			}
+			doc.Wpts = append(doc.Wpts, wpt{
+				Lat:  formatDegrees(cp.PositionLat.Degrees()),
+				Lon:  formatDegrees(cp.PositionLong.Degrees()),
+				Time: formatTime(cp.Timestamp),
+				Name: name,
+				Type: cp.Type.String(),
+			})
+		}
+	default:
+		return fmt.Errorf("gpx: unsupported file type: %v", file.Type())
+	}
+
+	t.Name = o.name
+	doc.Metadata = &metadata{
+		Name: o.name,
+		Time: formatTime(file.FileId.TimeCreated),
+	}
+
+	for _, seg := range splitRecords(recs, starts) {
+		var ts trkseg
+		for _, r := range seg {
+			if pt, ok := trackPoint(r, o.extensions); ok {
+				ts.Pts = append(ts.Pts, pt)
+			}
+		}
+		if len(ts.Pts) > 0 {
+			t.Segs = append(t.Segs, ts)
+		}
+	}
+	doc.Trks = []trk{t}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func trackPoint(r *fit.RecordMsg, ext bool) (trkpt, bool) {
+	if r.PositionLat.Invalid() || r.PositionLong.Invalid() {
+		return trkpt{}, false
+	}
+	pt := trkpt{
+		Lat:  formatDegrees(r.PositionLat.Degrees()),
+		Lon:  formatDegrees(r.PositionLong.Degrees()),
+		Time: formatTime(r.Timestamp),
+	}
+	ele := r.GetEnhancedAltitudeScaled()
+	if math.IsNaN(ele) {
+		ele = r.GetAltitudeScaled()
+	}
+	if !math.IsNaN(ele) {
+		pt.Ele = strconv.FormatFloat(ele, 'f', 1, 64)
+	}
+	if !ext {
+		return pt, true
+	}
+
+	var tpx trackPointExtension
+	if r.Temperature != 0x7F {
+		tpx.ATemp = strconv.Itoa(int(r.Temperature))
+	}
+	if r.HeartRate != 0xFF {
+		tpx.HR = strconv.Itoa(int(r.HeartRate))
+	}
+	if r.Cadence != 0xFF {
+		tpx.Cad = strconv.Itoa(int(r.Cadence))
+	}
+	if tpx != (trackPointExtension{}) {
+		pt.Extensions = &extensions{TrackPointExtension: tpx}
+	}
+	return pt, true
+}
+
+// splitRecords splits recs into segments starting at the given start times.
+// Records before the first start time are added to the first segment.
+func splitRecords(recs []*fit.RecordMsg, starts []time.Time) [][]*fit.RecordMsg {
+	var valid []time.Time
+	for _, s := range starts {
+		if !s.IsZero() && !fit.IsBaseTime(s) {
+			valid = append(valid, s)
+		}
+	}
+	sort.Slice(valid, func(i, j int) bool { return valid[i].Before(valid[j]) })
+	if len(valid) == 0 {
+		return [][]*fit.RecordMsg{recs}
+	}
+
+	segs := make([][]*fit.RecordMsg, len(valid))
+	for _, r := range recs {
+		i := sort.Search(len(valid), func(i int) bool { return valid[i].After(r.Timestamp) }) - 1
+		if i < 0 {
+			i = 0
+		}
+		segs[i] = append(segs[i], r)
+	}
+	return segs
+}
+
+func sportType(s fit.Sport) string {
+	if s == fit.SportInvalid {
+		return ""
+	}
+	return strings.ToLower(s.String())
+}
+
+func formatDegrees(deg float64) string {
+	return strconv.FormatFloat(deg, 'f', 8, 64)
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() || fit.IsBaseTime(t) {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
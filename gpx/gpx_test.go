@@ -0,0 +1,189 @@
+package gpx_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/gpx"
+)
+
+type gpxDoc struct {
+	Metadata struct {
+		Time string `xml:"time"`
+	} `xml:"metadata"`
+	Wpts []struct {
+		Lat  float64 `xml:"lat,attr"`
+		Lon  float64 `xml:"lon,attr"`
+		Name string  `xml:"name"`
+		Type string  `xml:"type"`
+	} `xml:"wpt"`
+	Trks []struct {
+		Name string `xml:"name"`
+		Type string `xml:"type"`
+		Segs []struct {
+			Pts []struct {
+				Lat  float64 `xml:"lat,attr"`
+				Lon  float64 `xml:"lon,attr"`
+				Ele  float64 `xml:"ele"`
+				Time string  `xml:"time"`
+				HR   int     `xml:"extensions>TrackPointExtension>hr"`
+				Cad  int     `xml:"extensions>TrackPointExtension>cad"`
+			} `xml:"trkpt"`
+		} `xml:"trkseg"`
+	} `xml:"trk"`
+}
+
+func writeAndParse(t *testing.T, file *fit.File, opts ...gpx.Option) (string, gpxDoc) {
+	t.Helper()
+	buf := new(bytes.Buffer)
+	if err := gpx.WriteGPX(buf, file, opts...); err != nil {
+		t.Fatalf("WriteGPX: got error, want none; error is: %v", err)
+	}
+	var doc gpxDoc
+	if err := xml.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("unmarshal: got error, want none; error is: %v", err)
+	}
+	if len(doc.Trks) != 1 {
+		t.Fatalf("got %d tracks, want 1", len(doc.Trks))
+	}
+	return buf.String(), doc
+}
+
+func TestWriteGPXActivity(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "testdata", "dcrainmaker", "Edge810-Vector-2013-08-16-15-35-10.fit"))
+	if err != nil {
+		t.Fatalf("reading file failed: %v", err)
+	}
+	file, err := fit.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("decode: got error, want none; error is: %v", err)
+	}
+	activity, err := file.Activity()
+	if err != nil {
+		t.Fatalf("activity: got error, want none; error is: %v", err)
+	}
+
+	var wantPts int
+	for _, r := range activity.Records {
+		if !r.PositionLat.Invalid() && !r.PositionLong.Invalid() {
+			wantPts++
+		}
+	}
+
+	tests := []struct {
+		name     string
+		opts     []gpx.Option
+		wantSegs int
+	}{
+		{"ByLap", nil, len(activity.Laps)},
+		{"BySession", []gpx.Option{gpx.WithSegmentation(gpx.SegmentBySession)}, len(activity.Sessions)},
+		{"None", []gpx.Option{gpx.WithSegmentation(gpx.SegmentNone)}, 1},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			out, doc := writeAndParse(t, file, test.opts...)
+			trk := doc.Trks[0]
+			if len(trk.Segs) != test.wantSegs {
+				t.Errorf("got %d track segments, want %d", len(trk.Segs), test.wantSegs)
+			}
+			var pts, hrs int
+			for _, seg := range trk.Segs {
+				pts += len(seg.Pts)
+				for _, pt := range seg.Pts {
+					if pt.HR > 0 {
+						hrs++
+					}
+				}
+			}
+			if pts != wantPts {
+				t.Errorf("got %d track points, want %d", pts, wantPts)
+			}
+			if hrs == 0 {
+				t.Errorf("got no track points with heart rate")
+			}
+			if trk.Type != "cycling" {
+				t.Errorf("track type: got %q, want %q", trk.Type, "cycling")
+			}
+			if !strings.Contains(out, `xmlns:gpxtpx="http://www.garmin.com/xmlschemas/TrackPointExtension/v1"`) {
+				t.Errorf("output has no TrackPointExtension namespace declaration")
+			}
+		})
+	}
+
+	out, _ := writeAndParse(t, file, gpx.WithoutExtensions())
+	if strings.Contains(out, "gpxtpx") {
+		t.Errorf("WithoutExtensions: output contains TrackPointExtension")
+	}
+}
+
+func TestWriteGPXCourse(t *testing.T) {
+	file, err := fit.NewFile(fit.FileTypeCourse, fit.NewHeader(fit.V20, true))
+	if err != nil {
+		t.Fatalf("NewFile: got error, want none; error is: %v", err)
+	}
+	start := time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)
+
+	course := fit.NewCourseMsg()
+	course.Name = "Loop"
+	course.Sport = fit.SportRunning
+	mustAdd(t, file, course)
+	lap := fit.NewLapMsg()
+	lap.StartTime = start
+	mustAdd(t, file, lap)
+	for i := 0; i < 10; i++ {
+		r := fit.NewRecordMsg()
+		r.Timestamp = start.Add(time.Duration(i) * time.Second)
+		r.PositionLat = fit.NewLatitudeDegrees(59.9 + float64(i)*0.0001)
+		r.PositionLong = fit.NewLongitudeDegrees(10.7)
+		r.Altitude = 2600
+		mustAdd(t, file, r)
+	}
+	cp := fit.NewCoursePointMsg()
+	cp.Timestamp = start.Add(5 * time.Second)
+	cp.PositionLat = fit.NewLatitudeDegrees(59.9005)
+	cp.PositionLong = fit.NewLongitudeDegrees(10.7)
+	cp.Type = fit.CoursePointSummit
+	mustAdd(t, file, cp)
+
+	_, doc := writeAndParse(t, file)
+	trk := doc.Trks[0]
+	if trk.Name != "Loop" || trk.Type != "running" {
+		t.Errorf("track: got name %q and type %q, want %q and %q", trk.Name, trk.Type, "Loop", "running")
+	}
+	if len(trk.Segs) != 1 || len(trk.Segs[0].Pts) != 10 {
+		t.Fatalf("got %d segments, want 1 with 10 points", len(trk.Segs))
+	}
+	pt := trk.Segs[0].Pts[0]
+	if pt.Ele != 20 || pt.Time != "2020-06-01T10:00:00Z" {
+		t.Errorf("first track point: got ele %v and time %q, want 20 and %q", pt.Ele, pt.Time, "2020-06-01T10:00:00Z")
+	}
+	if len(doc.Wpts) != 1 {
+		t.Fatalf("got %d waypoints, want 1", len(doc.Wpts))
+	}
+	if w := doc.Wpts[0]; w.Name != "Summit" || w.Type != "Summit" {
+		t.Errorf("waypoint: got name %q and type %q, want Summit", w.Name, w.Type)
+	}
+
+	settings, err := fit.NewFile(fit.FileTypeSettings, fit.NewHeader(fit.V20, true))
+	if err != nil {
+		t.Fatalf("NewFile: got error, want none; error is: %v", err)
+	}
+	if err = gpx.WriteGPX(new(bytes.Buffer), settings); err == nil {
+		t.Errorf("settings file: got no error, want one")
+	}
+}
+
+func mustAdd(t *testing.T, file *fit.File, msg interface{}) {
+	t.Helper()
+	if err := file.Add(msg); err != nil {
+		t.Fatalf("Add: got error, want none; error is: %v", err)
+	}
+}
@@ -0,0 +1,66 @@
+package gpx
+
+// Segmentation specifies how the records of an activity are split into track
+// segments.
+type Segmentation int
+
+const (
+	// SegmentByLap starts a new track segment for every lap.
+	SegmentByLap Segmentation = iota
+
+	// SegmentBySession starts a new track segment for every session.
+	SegmentBySession
+
+	// SegmentNone writes all records as a single track segment.
+	SegmentNone
+)
+
+type options struct {
+	creator      string
+	name         string
+	segmentation Segmentation
+	extensions   bool
+}
+
+func defaultOptions() options {
+	return options{
+		creator:      "github.com/tormoder/fit",
+		segmentation: SegmentByLap,
+		extensions:   true,
+	}
+}
+
+// Option configures WriteGPX.
+type Option func(*options)
+
+// WithCreator sets the creator attribute of the GPX document.
+func WithCreator(creator string) Option {
+	return func(o *options) {
+		o.creator = creator
+	}
+}
+
+// WithName sets the name of the GPX document and track. For course files the
+// course name is used by default.
+func WithName(name string) Option {
+	return func(o *options) {
+		o.name = name
+	}
+}
+
+// WithSegmentation configures how activity records are split into track
+// segments. The default is SegmentByLap. Course files are always split by
+// lap.
+func WithSegmentation(s Segmentation) Option {
+	return func(o *options) {
+		o.segmentation = s
+	}
+}
+
+// WithoutExtensions disables writing heart rate, cadence and temperature
+// using the Garmin TrackPointExtension.
+func WithoutExtensions() Option {
+	return func(o *options) {
+		o.extensions = false
+	}
+}
@@ -0,0 +1,127 @@
+package gpx
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+// Route is a route or track read from a GPX document.
+type Route struct {
+	Name   string
+	Points []Point
+}
+
+// Point is a route or track point. Elevation is in meters, or NaN if not
+// given, and Time is zero if not given.
+type Point struct {
+	Position  fit.Position
+	Elevation float64
+	Time      time.Time
+}
+
+// gpxIn is a GPX 1.0 or 1.1 document, of which only the elements read by
+// ReadRoutes are decoded. Elements are matched in any namespace.
+type gpxIn struct {
+	Name     string `xml:"name"`
+	Metadata struct {
+		Name string `xml:"name"`
+	} `xml:"metadata"`
+	Rtes []struct {
+		Name string `xml:"name"`
+		Pts  []ptIn `xml:"rtept"`
+	} `xml:"rte"`
+	Trks []struct {
+		Name string `xml:"name"`
+		Segs []struct {
+			Pts []ptIn `xml:"trkpt"`
+		} `xml:"trkseg"`
+	} `xml:"trk"`
+}
+
+type ptIn struct {
+	Lat  float64  `xml:"lat,attr"`
+	Lon  float64  `xml:"lon,attr"`
+	Ele  *float64 `xml:"ele"`
+	Time string   `xml:"time"`
+}
+
+// ReadRoutes reads the routes and tracks of a GPX 1.0 or 1.1 document, with
+// the routes first. The segments of a track are joined. Routes and tracks
+// without a name get the name of the document, if any.
+func ReadRoutes(r io.Reader) ([]Route, error) {
+	var doc gpxIn
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("gpx: decoding document: %w", err)
+	}
+	docName := doc.Metadata.Name
+	if docName == "" {
+		docName = doc.Name
+	}
+
+	var routes []Route
+	add := func(kind, name string, pts []ptIn) error {
+		route := Route{Name: strings.TrimSpace(name)}
+		if route.Name == "" {
+			route.Name = strings.TrimSpace(docName)
+		}
+		for _, pt := range pts {
+			p, err := readPoint(pt)
+			if err != nil {
+				return fmt.Errorf("gpx: %s %q: %w", kind, route.Name, err)
+			}
+			route.Points = append(route.Points, p)
+		}
+		routes = append(routes, route)
+		return nil
+	}
+	for _, rte := range doc.Rtes {
+		if err := add("route", rte.Name, rte.Pts); err != nil {
+			return nil, err
+		}
+	}
+	for _, trk := range doc.Trks {
+		var pts []ptIn
+		for _, seg := range trk.Segs {
+			pts = append(pts, seg.Pts...)
+		}
+		if err := add("track", trk.Name, pts); err != nil {
+			return nil, err
+		}
+	}
+	return routes, nil
+}
+
+// timeLayouts are the layouts of xsd:dateTime values, with and without
+// time zone. Times without a time zone are read as UTC.
+var timeLayouts = []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999"}
+
+func readPoint(pt ptIn) (Point, error) {
+	p := Point{
+		Position:  fit.NewPositionDegrees(pt.Lat, pt.Lon),
+		Elevation: math.NaN(),
+	}
+	if p.Position.Invalid() {
+		return p, fmt.Errorf("invalid position %v, %v", pt.Lat, pt.Lon)
+	}
+	if pt.Ele != nil {
+		p.Elevation = *pt.Ele
+	}
+	if s := strings.TrimSpace(pt.Time); s != "" {
+		var err error
+		for _, layout := range timeLayouts {
+			if p.Time, err = time.Parse(layout, s); err == nil {
+				break
+			}
+		}
+		if err != nil {
+			return p, fmt.Errorf("invalid time %q", s)
+		}
+	}
+	return p, nil
+}
@@ -0,0 +1,141 @@
+package gpx_test
+
+import (
+	"bytes"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/gpx"
+)
+
+func TestReadRoutes(t *testing.T) {
+	const doc = `<?xml version="1.0" encoding="UTF-8"?>
+<gpx version="1.0" creator="test" xmlns="http://www.topografix.com/GPX/1/0">
+  <name>Weekend</name>
+  <rte>
+    <rtept lat="59.9" lon="10.7"><ele>12.5</ele></rtept>
+    <rtept lat="59.91" lon="10.71"/>
+  </rte>
+  <trk>
+    <name> Ride </name>
+    <trkseg>
+      <trkpt lat="-33.9" lon="151.2"><time>2020-06-01T10:00:00Z</time></trkpt>
+    </trkseg>
+    <trkseg>
+      <trkpt lat="-33.91" lon="151.21"><ele>-2</ele><time>2020-06-01T10:00:05.5</time></trkpt>
+    </trkseg>
+  </trk>
+</gpx>`
+	routes, err := gpx.ReadRoutes(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	if len(routes) != 2 {
+		t.Fatalf("got %d routes, want 2", len(routes))
+	}
+	if routes[0].Name != "Weekend" || routes[1].Name != "Ride" {
+		t.Errorf("names: got %q and %q, want %q and %q", routes[0].Name, routes[1].Name, "Weekend", "Ride")
+	}
+
+	type point struct {
+		pos  fit.Position
+		ele  float64
+		time time.Time
+	}
+	want := [][]point{
+		{
+			{fit.NewPositionDegrees(59.9, 10.7), 12.5, time.Time{}},
+			{fit.NewPositionDegrees(59.91, 10.71), math.NaN(), time.Time{}},
+		},
+		{
+			{fit.NewPositionDegrees(-33.9, 151.2), math.NaN(), time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)},
+			{fit.NewPositionDegrees(-33.91, 151.21), -2, time.Date(2020, 6, 1, 10, 0, 5, 5e8, time.UTC)},
+		},
+	}
+	for i, route := range routes {
+		if len(route.Points) != len(want[i]) {
+			t.Errorf("route %d: got %d points, want %d", i, len(route.Points), len(want[i]))
+			continue
+		}
+		for j, p := range route.Points {
+			w := want[i][j]
+			sameEle := p.Elevation == w.ele || math.IsNaN(p.Elevation) && math.IsNaN(w.ele)
+			if p.Position != w.pos || !sameEle || !p.Time.Equal(w.time) {
+				t.Errorf("route %d point %d: got %v, %v, %v, want %v, %v, %v", i, j, p.Position, p.Elevation, p.Time, w.pos, w.ele, w.time)
+			}
+		}
+	}
+}
+
+func TestReadRoutesWritten(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "testdata", "dcrainmaker", "Edge810-Vector-2013-08-16-15-35-10.fit"))
+	if err != nil {
+		t.Fatalf("reading file failed: %v", err)
+	}
+	file, err := fit.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	activity, err := file.Activity()
+	if err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	var recs []*fit.RecordMsg
+	for _, r := range activity.Records {
+		if !r.PositionLat.Invalid() && !r.PositionLong.Invalid() {
+			recs = append(recs, r)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := gpx.WriteGPX(&buf, file, gpx.WithName("Edge")); err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	routes, err := gpx.ReadRoutes(&buf)
+	if err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	if len(routes) != 1 || routes[0].Name != "Edge" {
+		t.Fatalf("got %d routes, want 1 named Edge", len(routes))
+	}
+	pts := routes[0].Points
+	if len(pts) != len(recs) {
+		t.Fatalf("got %d points, want %d", len(pts), len(recs))
+	}
+	for i, p := range pts {
+		r := recs[i]
+		if d := p.Position.Distance(fit.Position{Lat: r.PositionLat, Long: r.PositionLong}); d > 0.05 {
+			t.Fatalf("point %d: got position %v, %.3f m from record", i, p.Position, d)
+		}
+		if !p.Time.Equal(r.Timestamp) {
+			t.Fatalf("point %d: got time %v, want %v", i, p.Time, r.Timestamp)
+		}
+		if alt := r.GetEnhancedAltitudeScaled(); math.Abs(p.Elevation-alt) > 0.05 {
+			t.Fatalf("point %d: got elevation %v, want %v", i, p.Elevation, alt)
+		}
+	}
+}
+
+func TestReadRoutesErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+	}{
+		{"not xml", `gpx`},
+		{"invalid position", `<gpx><rte><rtept lat="91" lon="10"/></rte></gpx>`},
+		{"invalid time", `<gpx><trk><trkseg><trkpt lat="1" lon="2"><time>yesterday</time></trkpt></trkseg></trk></gpx>`},
+		{"invalid elevation", `<gpx><trk><trkseg><trkpt lat="1" lon="2"><ele>high</ele></trkpt></trkseg></trk></gpx>`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := gpx.ReadRoutes(strings.NewReader(test.doc)); err == nil {
+				t.Error("got no error, want error")
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package fit
 import (
 	"bytes"
 	"encoding/binary"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -159,7 +160,33 @@ func (h Header) MarshalJSON() ([]byte, error) {
 	e.writeFieldName("CRC")
 	e.writeUint(uint64(h.CRC))
 	e.close()
-	return e.Bytes(), nil
+	return e.done(), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It accepts the
+// output of MarshalJSON.
+func (h *Header) UnmarshalJSON(data []byte) error {
+	var tmp struct {
+		Size            byte
+		ProtocolVersion byte
+		ProfileVersion  uint16
+		DataSize        uint32
+		DataType        string
+		CRC             uint16
+	}
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+	if len(tmp.DataType) != len(h.DataType) {
+		return fmt.Errorf("header data type must be %d bytes, got %q", len(h.DataType), tmp.DataType)
+	}
+	h.Size = tmp.Size
+	h.ProtocolVersion = tmp.ProtocolVersion
+	h.ProfileVersion = tmp.ProfileVersion
+	h.DataSize = tmp.DataSize
+	copy(h.DataType[:], tmp.DataType)
+	h.CRC = tmp.CRC
+	return nil
 }
 
 func (h Header) String() string {
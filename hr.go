@@ -0,0 +1,126 @@
+package fit
+
+import (
+	"errors"
+	"time"
+)
+
+// eventTimestamp12Bits is the size of each packed event timestamp in the
+// event_timestamp_12 field of hr messages.
+const eventTimestamp12Bits = 12
+
+// HeartRateSample is a heart rate measurement from hr messages.
+type HeartRateSample struct {
+	Time time.Time
+	Bpm  uint8
+}
+
+// ExpandHeartRates returns the heart rate samples of hr messages, in the
+// order they appear in, as recorded by e.g. chest straps during swims.
+//
+// The messages are expanded like the FIT SDK does it: the 12-bit event
+// timestamps packed in the event_timestamp_12 field are accumulated into
+// event timestamps, continuing from the previous message, and every event
+// timestamp is converted to a time relative to the last anchor message. An
+// anchor message has a timestamp and a single event timestamp. The packed
+// event timestamps are not expanded when decoding, and msgs is not modified.
+// Event timestamps are 32-bit counters that may wrap around, also between an
+// anchor and the following messages, so the time of a sample is given by the
+// difference from the anchor modulo 2^32. Samples with the same event
+// timestamp as the previous sample are skipped, since devices pad the last
+// message by repeating its last sample.
+//
+// An error is returned if a message with a timestamp does not have exactly
+// one event timestamp, or if event timestamps appear before the first anchor
+// message.
+func ExpandHeartRates(msgs []*HrMsg) ([]HeartRateSample, error) {
+	var (
+		samples     []HeartRateSample
+		anchored    bool
+		anchorTime  time.Time
+		anchorEvent uint32
+		last        uint32
+		acc         = uint32NewAccumulator(eventTimestamp12Bits)
+	)
+	for _, msg := range msgs {
+		if !IsBaseTime(msg.Timestamp) {
+			if len(msg.EventTimestamp) != 1 {
+				return nil, errors.New("hr anchor message must have one event timestamp")
+			}
+			anchorTime = msg.Timestamp
+			if msg.FractionalTimestamp != 0xFFFF {
+				anchorTime = anchorTime.Add(time.Duration(msg.FractionalTimestamp) * time.Second / 32768)
+			}
+			anchorEvent = msg.EventTimestamp[0]
+			anchored = true
+		}
+		events := msg.EventTimestamp
+		if len(events) == 0 && len(msg.EventTimestamp12) > 0 {
+			events = unpackEventTimestamps(msg.EventTimestamp12, acc)
+		}
+		if len(events) == 0 {
+			continue
+		}
+		acc.accumuValue, acc.lastValue = events[len(events)-1], events[len(events)-1]
+		if !anchored {
+			return nil, errors.New("hr event timestamps before first anchor message")
+		}
+		for i, ts := range events {
+			if i >= len(msg.FilteredBpm) {
+				break
+			}
+			if msg.FilteredBpm[i] == 0xFF || (len(samples) > 0 && ts == last) {
+				continue
+			}
+			last = ts
+			offset := time.Duration(int32(ts-anchorEvent)) * time.Second / 1024
+			samples = append(samples, HeartRateSample{
+				Time: anchorTime.Add(offset),
+				Bpm:  msg.FilteredBpm[i],
+			})
+		}
+	}
+	return samples, nil
+}
+
+// unpackEventTimestamps returns the accumulated values of the 12-bit
+// little-endian event timestamps packed in b.
+func unpackEventTimestamps(b []byte, acc *uint32Accumulator) []uint32 {
+	n := len(b) * 8 / eventTimestamp12Bits
+	ts := make([]uint32, 0, n)
+	for i := 0; i < n; i++ {
+		bit := i * eventTimestamp12Bits
+		v := uint32(b[bit/8]) | uint32(b[bit/8+1])<<8
+		v = (v >> (bit % 8)) & (1<<eventTimestamp12Bits - 1)
+		ts = append(ts, acc.accumulate(v))
+	}
+	return ts
+}
+
+// FillRecordHeartRates sets the heart rate of records without one to the
+// average of the heart rate samples since the previous record, or in the
+// second before the first record. It returns the number of records that
+// were changed. Records and samples must be sorted by time.
+func FillRecordHeartRates(recs []*RecordMsg, samples []HeartRateSample) int {
+	n, j := 0, 0
+	for i, r := range recs {
+		from := r.Timestamp.Add(-time.Second)
+		if i > 0 {
+			from = recs[i-1].Timestamp
+		}
+		for j < len(samples) && !samples[j].Time.After(from) {
+			j++
+		}
+		sum, count := 0, 0
+		for k := j; k < len(samples) && !samples[k].Time.After(r.Timestamp); k++ {
+			sum += int(samples[k].Bpm)
+			count++
+		}
+		if r.HeartRate != 0xFF || count == 0 {
+			continue
+		}
+		r.HeartRate = uint8((sum + count/2) / count)
+		n++
+	}
+	return n
+}
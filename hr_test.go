@@ -0,0 +1,153 @@
+package fit_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+func TestExpandHeartRates(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "fitsdk", "activity_poolswim_with_hr.fit"))
+	if err != nil {
+		t.Fatalf("reading file failed: %v", err)
+	}
+	file, err := fit.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("decode: got error, want none; error is: %v", err)
+	}
+	activity, err := file.Activity()
+	if err != nil {
+		t.Fatalf("activity: got error, want none; error is: %v", err)
+	}
+
+	samples, err := fit.ExpandHeartRates(activity.Hrs)
+	if err != nil {
+		t.Fatalf("ExpandHeartRates: got error, want none; error is: %v", err)
+	}
+	if len(samples) < len(activity.Hrs) {
+		t.Fatalf("got %d samples from %d hr messages", len(samples), len(activity.Hrs))
+	}
+	for _, msg := range activity.Hrs {
+		if len(msg.EventTimestamp12) > 0 && len(msg.EventTimestamp) != 0 {
+			t.Fatalf("got %d event timestamps in packed message, want messages not modified", len(msg.EventTimestamp))
+		}
+	}
+
+	// Samples are beats, so should be increasing and at most a few seconds
+	// apart, except between the anchor messages.
+	first, last := samples[0].Time, samples[len(samples)-1].Time
+	if want := activity.Hrs[0].Timestamp; first.Sub(want) < 0 || first.Sub(want) > time.Second {
+		t.Errorf("first sample at %v, want at first anchor %v", first, want)
+	}
+	for i := 1; i < len(samples); i++ {
+		if !samples[i].Time.After(samples[i-1].Time) {
+			t.Fatalf("sample %d at %v, not after %v", i, samples[i].Time, samples[i-1].Time)
+		}
+	}
+	records := activity.Records
+	if end := records[len(records)-1].Timestamp; last.Sub(end) < -time.Minute || last.Sub(end) > time.Minute {
+		t.Errorf("last sample at %v, want close to last record at %v", last, end)
+	}
+
+	n := fit.FillRecordHeartRates(records, samples)
+	if n < len(records)*9/10 {
+		t.Errorf("filled %d of %d records", n, len(records))
+	}
+	for _, r := range records {
+		if r.HeartRate != 0xFF && (r.HeartRate < 40 || r.HeartRate > 220) {
+			t.Errorf("record at %v: got heart rate %d", r.Timestamp, r.HeartRate)
+		}
+	}
+}
+
+func TestExpandHeartRatesPacked(t *testing.T) {
+	anchor := time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)
+	first := fit.NewHrMsg()
+	first.Timestamp = anchor
+	first.FractionalTimestamp = 16384 // 0.5 s
+	first.FilteredBpm = []uint8{60}
+	first.EventTimestamp = []uint32{4000}
+
+	// Event timestamps 4096+0, 4096+1024 and 4096+2048 packed as 12-bit
+	// values 0x000, 0x400 and 0x800, rolling over from 4000.
+	packed := fit.NewHrMsg()
+	packed.FilteredBpm = []uint8{61, 62, 63, 0xFF}
+	packed.EventTimestamp12 = []byte{0x00, 0x00, 0x40, 0x00, 0x08, 0x00}
+
+	samples, err := fit.ExpandHeartRates([]*fit.HrMsg{first, packed})
+	if err != nil {
+		t.Fatalf("ExpandHeartRates: got error, want none; error is: %v", err)
+	}
+	want := []fit.HeartRateSample{
+		{Time: anchor.Add(500 * time.Millisecond), Bpm: 60},
+		{Time: anchor.Add(500*time.Millisecond + 96*time.Second/1024), Bpm: 61},
+		{Time: anchor.Add(500*time.Millisecond + 1120*time.Second/1024), Bpm: 62},
+		{Time: anchor.Add(500*time.Millisecond + 2144*time.Second/1024), Bpm: 63},
+	}
+	if len(samples) != len(want) {
+		t.Fatalf("got %d samples, want %d", len(samples), len(want))
+	}
+	for i := range want {
+		if !samples[i].Time.Equal(want[i].Time) || samples[i].Bpm != want[i].Bpm {
+			t.Errorf("sample %d: got %v, want %v", i, samples[i], want[i])
+		}
+	}
+
+	if _, err := fit.ExpandHeartRates([]*fit.HrMsg{packed}); err == nil {
+		t.Errorf("ExpandHeartRates without anchor: got no error, want one")
+	}
+}
+
+func TestExpandHeartRatesWrap(t *testing.T) {
+	anchor := time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)
+	first := fit.NewHrMsg()
+	first.Timestamp = anchor
+	first.FilteredBpm = []uint8{60}
+	first.EventTimestamp = []uint32{0xFFFFFF00}
+
+	// Event timestamps 0x100 and 0x500 after the anchor, wrapping around
+	// the 32-bit event timestamp, packed as 12-bit values 0x000 and 0x400.
+	packed := fit.NewHrMsg()
+	packed.FilteredBpm = []uint8{61, 62}
+	packed.EventTimestamp12 = []byte{0x00, 0x00, 0x40}
+
+	// A new anchor two seconds after the first, and a packed event
+	// timestamp 1024 after it.
+	second := fit.NewHrMsg()
+	second.Timestamp = anchor.Add(2 * time.Second)
+	second.FilteredBpm = []uint8{63}
+	second.EventTimestamp = []uint32{0x700}
+	packed2 := fit.NewHrMsg()
+	packed2.FilteredBpm = []uint8{64}
+	packed2.EventTimestamp12 = []byte{0x00, 0x0B}
+
+	msgs := []*fit.HrMsg{first, packed, second, packed2}
+	samples, err := fit.ExpandHeartRates(msgs)
+	if err != nil {
+		t.Fatalf("ExpandHeartRates: got error, want none; error is: %v", err)
+	}
+	want := []fit.HeartRateSample{
+		{Time: anchor, Bpm: 60},
+		{Time: anchor.Add(256 * time.Second / 1024), Bpm: 61},
+		{Time: anchor.Add(1280 * time.Second / 1024), Bpm: 62},
+		{Time: anchor.Add(2 * time.Second), Bpm: 63},
+		{Time: anchor.Add(3 * time.Second), Bpm: 64},
+	}
+	if len(samples) != len(want) {
+		t.Fatalf("got %d samples, want %d: %v", len(samples), len(want), samples)
+	}
+	for i := range want {
+		if !samples[i].Time.Equal(want[i].Time) || samples[i].Bpm != want[i].Bpm {
+			t.Errorf("sample %d: got %v, want %v", i, samples[i], want[i])
+		}
+	}
+	for i, msg := range msgs {
+		if msg.EventTimestamp == nil != (i%2 == 1) {
+			t.Errorf("message %d: got event timestamps %v, want messages not modified", i, msg.EventTimestamp)
+		}
+	}
+}
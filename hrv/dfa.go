@@ -0,0 +1,131 @@
+package hrv
+
+import (
+	"math"
+	"time"
+)
+
+// Box sizes, in beats, for DFA alpha1.
+const (
+	minBox = 4
+	maxBox = 16
+)
+
+// DFAAlpha1 returns the short-term scaling exponent of detrended fluctuation
+// analysis of the intervals, using box sizes of 4 to 16 beats. Values around
+// 1 are typical at rest and low intensity, and values around 0.75 and 0.5
+// are often used to estimate the aerobic and anaerobic thresholds. NaN is
+// returned if there are fewer than 32 intervals.
+func (s *Series) DFAAlpha1() float64 {
+	if len(s.RR) < 2*maxBox {
+		return math.NaN()
+	}
+	mean := 0.0
+	for _, rr := range s.RR {
+		mean += rr
+	}
+	mean /= float64(len(s.RR))
+
+	// The integrated series.
+	y := make([]float64, len(s.RR))
+	sum := 0.0
+	for i, rr := range s.RR {
+		sum += rr - mean
+		y[i] = sum
+	}
+
+	var logN, logF []float64
+	for n := minBox; n <= maxBox; n++ {
+		f := fluctuation(y, n)
+		if f <= 0 {
+			continue
+		}
+		logN = append(logN, math.Log(float64(n)))
+		logF = append(logF, math.Log(f))
+	}
+	if len(logN) < 2 {
+		return math.NaN()
+	}
+	return slope(logN, logF)
+}
+
+// fluctuation returns the root mean square deviation of y from the linear
+// trend of each of its non-overlapping boxes of n samples.
+func fluctuation(y []float64, n int) float64 {
+	boxes := len(y) / n
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = float64(i)
+	}
+	sum := 0.0
+	for b := 0; b < boxes; b++ {
+		box := y[b*n : (b+1)*n]
+		k := slope(x, box)
+		m := mean(box) - k*mean(x)
+		for i, v := range box {
+			d := v - (k*x[i] + m)
+			sum += d * d
+		}
+	}
+	return math.Sqrt(sum / float64(boxes*n))
+}
+
+func mean(v []float64) float64 {
+	sum := 0.0
+	for _, x := range v {
+		sum += x
+	}
+	return sum / float64(len(v))
+}
+
+// slope returns the slope of the least squares line through the points.
+func slope(x, y []float64) float64 {
+	mx, my := mean(x), mean(y)
+	var sxy, sxx float64
+	for i := range x {
+		sxy += (x[i] - mx) * (y[i] - my)
+		sxx += (x[i] - mx) * (x[i] - mx)
+	}
+	return sxy / sxx
+}
+
+// Window holds the metrics of the intervals in a time window. Metrics that
+// can not be computed are NaN.
+type Window struct {
+	Start     time.Time
+	End       time.Time
+	Beats     int
+	RMSSD     float64
+	SDNN      float64
+	PNN50     float64
+	DFAAlpha1 float64
+}
+
+// Windows returns the metrics of windows of the given size, starting at the
+// first beat and every step after that. Windows without intervals are
+// omitted. A typical choice for DFA alpha1 is 2 minute windows every 5
+// seconds.
+func (s *Series) Windows(size, step time.Duration) []Window {
+	if len(s.Times) == 0 || size <= 0 || step <= 0 {
+		return nil
+	}
+	var windows []Window
+	last := s.Times[len(s.Times)-1]
+	for start := s.Times[0]; !start.After(last); start = start.Add(step) {
+		end := start.Add(size)
+		w := s.Slice(start, end)
+		if w.Len() == 0 {
+			continue
+		}
+		windows = append(windows, Window{
+			Start:     start,
+			End:       end,
+			Beats:     w.Len(),
+			RMSSD:     w.RMSSD(),
+			SDNN:      w.SDNN(),
+			PNN50:     w.PNN50(),
+			DFAAlpha1: w.DFAAlpha1(),
+		})
+	}
+	return windows
+}
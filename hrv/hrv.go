@@ -0,0 +1,241 @@
+// Package hrv extracts beat-to-beat (RR) intervals from hrv and
+// beat_intervals messages and computes heart rate variability metrics:
+// RMSSD, SDNN, pNN50 and the short-term scaling exponent of detrended
+// fluctuation analysis (DFA alpha1).
+package hrv
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+// ErrNoIntervals is returned when an activity has no RR intervals.
+var ErrNoIntervals = errors.New("no rr intervals")
+
+// Limits for physiologically plausible RR intervals, in milliseconds.
+const (
+	minRR = 300
+	maxRR = 2000
+)
+
+// adjacentTolerance is the largest difference in milliseconds between the
+// time between two beats and the RR interval of the second for the beats to
+// be regarded as successive.
+const adjacentTolerance = 50
+
+// Series is a series of RR intervals.
+type Series struct {
+	// Times holds the time of the beat ending each interval, and RR the
+	// interval in milliseconds.
+	Times []time.Time
+	RR    []float64
+}
+
+// Len returns the number of intervals in the series.
+func (s *Series) Len() int {
+	return len(s.RR)
+}
+
+func (s *Series) add(t time.Time, rr float64) {
+	s.Times = append(s.Times, t)
+	s.RR = append(s.RR, rr)
+}
+
+// FromHrv returns the RR intervals of hrv messages. Hrv messages have no
+// timestamps, so the first interval is taken to start at start, usually
+// the start time of the activity, and every interval to start where the
+// previous ended. Invalid intervals are skipped.
+func FromHrv(msgs []*fit.HrvMsg, start time.Time) *Series {
+	s := new(Series)
+	t := start
+	for _, msg := range msgs {
+		for _, v := range msg.Time {
+			if v == 0xFFFF {
+				continue
+			}
+			rr := float64(v)
+			t = t.Add(time.Duration(v) * time.Millisecond)
+			s.add(t, rr)
+		}
+	}
+	return s
+}
+
+// FromBeatIntervals returns the RR intervals of beat_intervals messages. The
+// timestamp of each message is taken to be the time of the beat ending its
+// first interval. Invalid intervals are skipped.
+func FromBeatIntervals(msgs []*fit.BeatIntervalsMsg) *Series {
+	s := new(Series)
+	for _, msg := range msgs {
+		t := msg.Timestamp
+		if msg.TimestampMs != 0xFFFF {
+			t = t.Add(time.Duration(msg.TimestampMs) * time.Millisecond)
+		}
+		first := true
+		for _, v := range msg.Time {
+			if v == 0xFFFF {
+				continue
+			}
+			if !first {
+				t = t.Add(time.Duration(v) * time.Millisecond)
+			}
+			first = false
+			s.add(t, float64(v))
+		}
+	}
+	return s
+}
+
+// FromActivity returns the RR intervals of an activity, from the
+// beat_intervals messages if there are any, and otherwise from the hrv
+// messages aligned to the start of the activity: the first timer start
+// event, or the start of the first session or the first record if there
+// are no timer events.
+func FromActivity(a *fit.ActivityFile) (*Series, error) {
+	var s *Series
+	if len(a.BeatIntervals) > 0 {
+		s = FromBeatIntervals(a.BeatIntervals)
+	} else {
+		start, ok := startTime(a)
+		if !ok {
+			return nil, errors.New("no start time for hrv messages")
+		}
+		s = FromHrv(a.Hrvs, start)
+	}
+	if s.Len() == 0 {
+		return nil, ErrNoIntervals
+	}
+	return s, nil
+}
+
+func startTime(a *fit.ActivityFile) (time.Time, bool) {
+	for _, e := range a.Events {
+		if e.Event == fit.EventTimer && e.EventType == fit.EventTypeStart {
+			return e.Timestamp, true
+		}
+	}
+	if len(a.Sessions) > 0 && !fit.IsBaseTime(a.Sessions[0].StartTime) {
+		return a.Sessions[0].StartTime, true
+	}
+	if len(a.Records) > 0 {
+		return a.Records[0].Timestamp, true
+	}
+	return time.Time{}, false
+}
+
+// Filter returns the series with artifacts removed, and the number of
+// intervals removed. An interval is an artifact if it is outside 300 to
+// 2000 ms, or if it differs by more than maxDeviation, e.g. 0.2 for 20%,
+// from the median of the 10 surrounding intervals. Missed and extra beats
+// are typical artifacts.
+func (s *Series) Filter(maxDeviation float64) (*Series, int) {
+	const radius = 5
+	out := new(Series)
+	window := make([]float64, 0, 2*radius)
+	for i, rr := range s.RR {
+		if rr < minRR || rr > maxRR {
+			continue
+		}
+		window = window[:0]
+		for j := i - radius; j <= i+radius; j++ {
+			if j < 0 || j == i || j >= len(s.RR) || s.RR[j] < minRR || s.RR[j] > maxRR {
+				continue
+			}
+			window = append(window, s.RR[j])
+		}
+		if len(window) > 0 {
+			if m := median(window); math.Abs(rr-m) > maxDeviation*m {
+				continue
+			}
+		}
+		out.add(s.Times[i], rr)
+	}
+	return out, s.Len() - out.Len()
+}
+
+func median(v []float64) float64 {
+	sorted := append([]float64(nil), v...)
+	sort.Float64s(sorted)
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
+
+// Slice returns the intervals ending from start up to, but not including,
+// end.
+func (s *Series) Slice(start, end time.Time) *Series {
+	i := sort.Search(len(s.Times), func(i int) bool { return !s.Times[i].Before(start) })
+	j := sort.Search(len(s.Times), func(j int) bool { return !s.Times[j].Before(end) })
+	if j < i {
+		j = i
+	}
+	return &Series{Times: s.Times[i:j], RR: s.RR[i:j]}
+}
+
+// successiveDiffs returns the differences between successive intervals.
+// Intervals separated by removed or missing beats are not successive.
+func (s *Series) successiveDiffs() []float64 {
+	var diffs []float64
+	for i := 1; i < len(s.RR); i++ {
+		gap := float64(s.Times[i].Sub(s.Times[i-1])) / float64(time.Millisecond)
+		if math.Abs(gap-s.RR[i]) > adjacentTolerance {
+			continue
+		}
+		diffs = append(diffs, s.RR[i]-s.RR[i-1])
+	}
+	return diffs
+}
+
+// RMSSD returns the root mean square of successive differences of the
+// intervals in milliseconds, or NaN if there are no successive intervals.
+func (s *Series) RMSSD() float64 {
+	diffs := s.successiveDiffs()
+	if len(diffs) == 0 {
+		return math.NaN()
+	}
+	sum := 0.0
+	for _, d := range diffs {
+		sum += d * d
+	}
+	return math.Sqrt(sum / float64(len(diffs)))
+}
+
+// SDNN returns the standard deviation of the intervals in milliseconds, or
+// NaN if there are fewer than two intervals.
+func (s *Series) SDNN() float64 {
+	if len(s.RR) < 2 {
+		return math.NaN()
+	}
+	mean := 0.0
+	for _, rr := range s.RR {
+		mean += rr
+	}
+	mean /= float64(len(s.RR))
+	sum := 0.0
+	for _, rr := range s.RR {
+		sum += (rr - mean) * (rr - mean)
+	}
+	return math.Sqrt(sum / float64(len(s.RR)-1))
+}
+
+// PNN50 returns the fraction of successive differences larger than 50 ms,
+// or NaN if there are no successive intervals.
+func (s *Series) PNN50() float64 {
+	diffs := s.successiveDiffs()
+	if len(diffs) == 0 {
+		return math.NaN()
+	}
+	n := 0
+	for _, d := range diffs {
+		if math.Abs(d) > 50 {
+			n++
+		}
+	}
+	return float64(n) / float64(len(diffs))
+}
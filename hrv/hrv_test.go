@@ -0,0 +1,201 @@
+package hrv_test
+
+import (
+	"bytes"
+	"math"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/hrv"
+)
+
+var start = time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)
+
+// newSeries returns a series of successive intervals starting at start.
+func newSeries(rr []float64) *hrv.Series {
+	s := new(hrv.Series)
+	t := start
+	for _, v := range rr {
+		t = t.Add(time.Duration(v * float64(time.Millisecond)))
+		s.Times = append(s.Times, t)
+		s.RR = append(s.RR, v)
+	}
+	return s
+}
+
+func TestFromActivity(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "testdata", "bpg", "garmin.fit"))
+	if err != nil {
+		t.Fatalf("reading file failed: %v", err)
+	}
+	file, err := fit.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("decode: got error, want none; error is: %v", err)
+	}
+	activity, err := file.Activity()
+	if err != nil {
+		t.Fatalf("activity: got error, want none; error is: %v", err)
+	}
+
+	s, err := hrv.FromActivity(activity)
+	if err != nil {
+		t.Fatalf("FromActivity: got error, want none; error is: %v", err)
+	}
+	for _, rr := range s.RR {
+		if rr == 0xFFFF {
+			t.Fatalf("got invalid interval in series")
+		}
+	}
+
+	// The intervals are aligned to the start of the activity, and should
+	// end close to the last record.
+	if !s.Times[0].After(activity.Sessions[0].StartTime) {
+		t.Errorf("first beat at %v, want after %v", s.Times[0], activity.Sessions[0].StartTime)
+	}
+	last := activity.Records[len(activity.Records)-1].Timestamp
+	if d := s.Times[s.Len()-1].Sub(last); d < -time.Minute || d > time.Minute {
+		t.Errorf("last beat at %v, want close to last record at %v", s.Times[s.Len()-1], last)
+	}
+
+	filtered, removed := s.Filter(0.2)
+	if removed == 0 || removed > s.Len()/10 {
+		t.Errorf("filter: removed %d of %d intervals", removed, s.Len())
+	}
+	if filtered.Len() != s.Len()-removed {
+		t.Errorf("filter: got %d intervals, want %d", filtered.Len(), s.Len()-removed)
+	}
+	for _, w := range filtered.Windows(2*time.Minute, time.Minute) {
+		if w.Beats < 2*16 {
+			continue
+		}
+		if math.IsNaN(w.RMSSD) || math.IsNaN(w.SDNN) || math.IsNaN(w.DFAAlpha1) {
+			t.Errorf("window %v: got %+v, want all metrics", w.Start, w)
+		}
+	}
+
+	if _, err := hrv.FromActivity(&fit.ActivityFile{}); err == nil {
+		t.Errorf("FromActivity without intervals: got no error, want one")
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	s := newSeries([]float64{800, 810, 790, 850, 860, 840})
+	diffs := []float64{10, -20, 60, 10, -20}
+	sum := 0.0
+	for _, d := range diffs {
+		sum += d * d
+	}
+	if got, want := s.RMSSD(), math.Sqrt(sum/5); math.Abs(got-want) > 1e-9 {
+		t.Errorf("RMSSD: got %v, want %v", got, want)
+	}
+	if got, want := s.PNN50(), 0.2; got != want {
+		t.Errorf("pNN50: got %v, want %v", got, want)
+	}
+	// Mean 825; squared deviations 625, 225, 1225, 625, 1225, 225.
+	if got, want := s.SDNN(), math.Sqrt(4150.0/5); math.Abs(got-want) > 1e-9 {
+		t.Errorf("SDNN: got %v, want %v", got, want)
+	}
+
+	// Removing the fourth interval leaves the third and fifth not
+	// successive.
+	gap := &hrv.Series{
+		Times: append(append([]time.Time(nil), s.Times[:3]...), s.Times[4:]...),
+		RR:    append(append([]float64(nil), s.RR[:3]...), s.RR[4:]...),
+	}
+	diffs = []float64{10, -20, -20}
+	sum = 0
+	for _, d := range diffs {
+		sum += d * d
+	}
+	if got, want := gap.RMSSD(), math.Sqrt(sum/3); math.Abs(got-want) > 1e-9 {
+		t.Errorf("RMSSD with gap: got %v, want %v", got, want)
+	}
+
+	empty := new(hrv.Series)
+	if !math.IsNaN(empty.RMSSD()) || !math.IsNaN(empty.SDNN()) || !math.IsNaN(empty.PNN50()) || !math.IsNaN(empty.DFAAlpha1()) {
+		t.Errorf("got metrics for empty series, want NaN")
+	}
+}
+
+func TestFilter(t *testing.T) {
+	rr := make([]float64, 30)
+	for i := range rr {
+		rr[i] = 800 + float64(i%3)*10
+	}
+	rr[10] = 1600 // Missed beat.
+	rr[20] = 250  // Too short.
+	s := newSeries(rr)
+	filtered, removed := s.Filter(0.2)
+	if removed != 2 {
+		t.Fatalf("got %d intervals removed, want 2", removed)
+	}
+	for _, v := range filtered.RR {
+		if v < 800 || v > 820 {
+			t.Errorf("got interval %v after filtering", v)
+		}
+	}
+}
+
+func TestDFAAlpha1(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	white := make([]float64, 2000)
+	brown := make([]float64, 2000)
+	x := 800.0
+	for i := range white {
+		white[i] = 800 + 30*r.NormFloat64()
+		x += 5 * r.NormFloat64()
+		brown[i] = x
+	}
+	tests := []struct {
+		name string
+		rr   []float64
+		want float64
+	}{
+		{"uncorrelated", white, 0.5},
+		{"random walk", brown, 1.5},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := newSeries(test.rr).DFAAlpha1(); math.Abs(got-test.want) > 0.15 {
+				t.Errorf("got %v, want about %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestFromBeatIntervals(t *testing.T) {
+	msgs := []*fit.BeatIntervalsMsg{
+		{Timestamp: start, TimestampMs: 500, Time: []uint16{800, 810, 0xFFFF}},
+		{Timestamp: start.Add(2 * time.Second), TimestampMs: 0xFFFF, Time: []uint16{820}},
+	}
+	s := hrv.FromBeatIntervals(msgs)
+	want := []time.Time{
+		start.Add(500 * time.Millisecond),
+		start.Add(1310 * time.Millisecond),
+		start.Add(2 * time.Second),
+	}
+	if s.Len() != len(want) {
+		t.Fatalf("got %d intervals, want %d", s.Len(), len(want))
+	}
+	for i := range want {
+		if !s.Times[i].Equal(want[i]) {
+			t.Errorf("interval %d: got time %v, want %v", i, s.Times[i], want[i])
+		}
+	}
+
+	a := &fit.ActivityFile{
+		BeatIntervals: msgs,
+		Hrvs:          []*fit.HrvMsg{{Time: []uint16{1000}}},
+	}
+	s, err := hrv.FromActivity(a)
+	if err != nil {
+		t.Fatalf("FromActivity: got error, want none; error is: %v", err)
+	}
+	if s.Len() != 3 {
+		t.Errorf("FromActivity: got %d intervals, want 3 from beat intervals", s.Len())
+	}
+}
@@ -0,0 +1,158 @@
+// Package activity holds helpers shared by the packages working on activity
+// files: lap and session end times, raw field values and timer periods.
+package activity
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+// LapEnd, SegmentLapEnd and SessionEnd return the end time of a lap,
+// segment lap or session. The timestamp is only used if the elapsed time is
+// invalid, since devices may write the message some time after the lap or
+// session ended.
+func LapEnd(l *fit.LapMsg) time.Time {
+	return endTime(l.StartTime, l.Timestamp, l.TotalElapsedTime)
+}
+
+func SegmentLapEnd(l *fit.SegmentLapMsg) time.Time {
+	return endTime(l.StartTime, l.Timestamp, l.TotalElapsedTime)
+}
+
+func SessionEnd(s *fit.SessionMsg) time.Time {
+	return endTime(s.StartTime, s.Timestamp, s.TotalElapsedTime)
+}
+
+func endTime(start, timestamp time.Time, elapsed uint32) time.Time {
+	if elapsed == 0xFFFFFFFF {
+		return timestamp
+	}
+	return start.Add(time.Duration(elapsed) * time.Millisecond)
+}
+
+// Raw returns the raw value of the field value v for the given profile
+// scale and offset, or invalid if v is NaN or does not fit below invalid.
+func Raw(v, scale, offset, invalid float64) float64 {
+	raw := math.Round((v + offset) * scale)
+	if math.IsNaN(raw) || raw < 0 || raw >= invalid {
+		return invalid
+	}
+	return raw
+}
+
+// TimerEvent returns a manually triggered timer event of the given type at
+// t, as added when the timer is started or stopped.
+func TimerEvent(t time.Time, typ fit.EventType) *fit.EventMsg {
+	e := fit.NewEventMsg()
+	e.Timestamp = t
+	e.Event = fit.EventTimer
+	e.EventType = typ
+	e.Data = uint32(fit.TimerTriggerManual)
+	e.EventGroup = 0
+	return e
+}
+
+// Period is a time period during which the timer was running. A zero End
+// means that the timer was never stopped.
+type Period struct {
+	Start, End time.Time
+}
+
+// Contains reports whether t is within the period, inclusive.
+func (p Period) Contains(t time.Time) bool {
+	return !t.Before(p.Start) && (p.End.IsZero() || !t.After(p.End))
+}
+
+// Timer holds the periods during which the timer was running, sorted by
+// start time.
+type Timer []Period
+
+// TimerPeriods returns the periods during which the timer was running, as
+// given by the timer start and stop events. Events of other types are
+// ignored.
+func TimerPeriods(events []*fit.EventMsg) Timer {
+	var timer []*fit.EventMsg
+	for _, e := range events {
+		if e.Event == fit.EventTimer && !fit.IsBaseTime(e.Timestamp) {
+			timer = append(timer, e)
+		}
+	}
+	sort.SliceStable(timer, func(i, j int) bool { return timer[i].Timestamp.Before(timer[j].Timestamp) })
+
+	var (
+		periods Timer
+		running bool
+	)
+	for _, e := range timer {
+		switch e.EventType {
+		case fit.EventTypeStart:
+			if !running {
+				running = true
+				periods = append(periods, Period{Start: e.Timestamp})
+			}
+		case fit.EventTypeStop, fit.EventTypeStopAll,
+			fit.EventTypeStopDisable, fit.EventTypeStopDisableAll:
+			if running {
+				running = false
+				periods[len(periods)-1].End = e.Timestamp
+			}
+		}
+	}
+	return periods
+}
+
+// Within returns the periods overlapping the period from start to end.
+func (tm Timer) Within(start, end time.Time) Timer {
+	var out Timer
+	for _, p := range tm {
+		if !p.Start.After(end) && (p.End.IsZero() || p.End.After(start)) {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// Containing returns the period containing t, if any.
+func (tm Timer) Containing(t time.Time) (Period, bool) {
+	for _, p := range tm {
+		if p.Contains(t) {
+			return p, true
+		}
+	}
+	return Period{}, false
+}
+
+// Running reports whether the timer was running at t. If there are no
+// periods, the timer is always running.
+func (tm Timer) Running(t time.Time) bool {
+	if len(tm) == 0 {
+		return true
+	}
+	_, found := tm.Containing(t)
+	return found
+}
+
+// Duration returns the time the timer was running between start and end.
+// If there are no periods, the timer is always running.
+func (tm Timer) Duration(start, end time.Time) time.Duration {
+	if len(tm) == 0 {
+		return end.Sub(start)
+	}
+	var d time.Duration
+	for _, p := range tm {
+		s, e := p.Start, p.End
+		if s.Before(start) {
+			s = start
+		}
+		if e.IsZero() || e.After(end) {
+			e = end
+		}
+		if e.After(s) {
+			d += e.Sub(s)
+		}
+	}
+	return d
+}
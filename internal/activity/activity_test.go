@@ -0,0 +1,73 @@
+package activity_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/internal/activity"
+)
+
+func TestTimer(t *testing.T) {
+	start := time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)
+	at := func(secs int) time.Time { return start.Add(time.Duration(secs) * time.Second) }
+	events := []*fit.EventMsg{
+		activity.TimerEvent(at(30), fit.EventTypeStart),
+		activity.TimerEvent(at(0), fit.EventTypeStart),
+		activity.TimerEvent(at(10), fit.EventTypeStop),
+		activity.TimerEvent(at(12), fit.EventTypeStopAll),
+	}
+	timer := activity.TimerPeriods(events)
+	if len(timer) != 2 {
+		t.Fatalf("got %d periods, want 2", len(timer))
+	}
+	if p, found := timer.Containing(at(5)); !found || p != timer[0] {
+		t.Errorf("Containing: got %v, %t, want %v, true", p, found, timer[0])
+	}
+	if timer.Running(at(20)) || !timer.Running(at(40)) {
+		t.Errorf("Running: got unexpected result for %v", timer)
+	}
+	if !activity.TimerPeriods(nil).Running(start) {
+		t.Errorf("Running: got false without timer events, want true")
+	}
+	if got := timer.Within(at(11), at(20)); len(got) != 0 {
+		t.Errorf("Within: got %v, want no periods", got)
+	}
+	if got := timer.Duration(at(5), at(40)); got != 15*time.Second {
+		t.Errorf("Duration: got %v, want 15s", got)
+	}
+}
+
+func TestEnd(t *testing.T) {
+	start := time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)
+	s := fit.NewSessionMsg()
+	s.StartTime = start
+	s.Timestamp = start.Add(time.Minute)
+	if got := activity.SessionEnd(s); !got.Equal(s.Timestamp) {
+		t.Errorf("invalid elapsed time: got %v, want timestamp %v", got, s.Timestamp)
+	}
+	s.TotalElapsedTime = 30000
+	if got, want := activity.SessionEnd(s), start.Add(30*time.Second); !got.Equal(want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestRaw(t *testing.T) {
+	tests := []struct {
+		v, scale, offset, invalid float64
+		want                      float64
+	}{
+		{12.3456, 1000, 0, 0xFFFF, 12346},
+		{132.2, 5, 500, 0xFFFF, 3161},
+		{-1, 1, 0, 0xFF, 0xFF},
+		{300, 1, 0, 0xFF, 0xFF},
+		{math.NaN(), 1, 0, 0xFF, 0xFF},
+	}
+	for _, test := range tests {
+		if got := activity.Raw(test.v, test.scale, test.offset, test.invalid); got != test.want {
+			t.Errorf("Raw(%v, %v, %v, %v): got %v, want %v",
+				test.v, test.scale, test.offset, test.invalid, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Package field formats the values of profile fields for the packages and
+// commands presenting messages as text.
+package field
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+// Scalar returns the scaled value of the scalar field v described by pf,
+// formatted and as a number, and whether it is valid. Values of types with
+// names are formatted as names and have no number.
+func Scalar(pf fit.ProfileField, v reflect.Value) (string, float64, bool) {
+	var f float64
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		f = v.Float()
+		if math.IsNaN(f) {
+			return "", 0, false
+		}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if pf.IsInvalid(v) {
+			return "", 0, false
+		}
+		f = float64(v.Int())
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if pf.IsInvalid(v) {
+			return "", 0, false
+		}
+		f = float64(v.Uint())
+	default:
+		return "", 0, false
+	}
+	if IsFloat(pf, v) {
+		f = f/pf.Scale - pf.Offset
+		if pf.Scale > 1 {
+			// Round away the float error of scaling, keeping the
+			// resolution given by the scale.
+			p := math.Pow(10, math.Ceil(math.Log10(pf.Scale)))
+			f = math.Round(f*p) / p
+		}
+		return strconv.FormatFloat(f, 'f', -1, 64), f, true
+	}
+
+	// Types have generated String methods giving their names, except
+	// message indexes which are numbers.
+	if mi, ok := v.Interface().(fit.MessageIndex); ok {
+		return strconv.Itoa(int(mi)), f, true
+	}
+	if s, ok := v.Interface().(fmt.Stringer); ok {
+		return s.String(), f, true
+	}
+	return fmt.Sprint(v.Interface()), f, true
+}
+
+// IsFloat reports whether the scalar field v described by pf has a floating
+// point value: a floating point base type, or a scale or offset.
+func IsFloat(pf fit.ProfileField, v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return true
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return pf.Scale != 1 || pf.Offset != 0
+	}
+	return false
+}
+
+// ValidTime reports whether t is a valid time field value, that is neither
+// the zero time nor the FIT base time of an invalid timestamp.
+func ValidTime(t time.Time) bool {
+	return !t.IsZero() && !fit.IsBaseTime(t)
+}
@@ -0,0 +1,56 @@
+package field
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+func TestScalar(t *testing.T) {
+	tests := []struct {
+		name  string
+		pf    fit.ProfileField
+		v     interface{}
+		want  string
+		num   float64
+		valid bool
+	}{
+		{"plain", fit.ProfileField{Type: fit.FitBaseTypeUint8, Scale: 1}, uint8(150), "150", 150, true},
+		{"invalid", fit.ProfileField{Type: fit.FitBaseTypeUint8, Scale: 1}, uint8(0xFF), "", 0, false},
+		{"scale", fit.ProfileField{Type: fit.FitBaseTypeUint32, Scale: 100}, uint32(123456), "1234.56", 1234.56, true},
+		{"scale rounding", fit.ProfileField{Type: fit.FitBaseTypeUint16, Scale: 5, Offset: 500}, uint16(1282), "-243.6", -243.6, true},
+		{"scale and offset", fit.ProfileField{Type: fit.FitBaseTypeUint16, Scale: 5, Offset: 500}, uint16(3000), "100", 100, true},
+		{"float", fit.ProfileField{Type: fit.FitBaseTypeFloat32, Scale: 1}, float32(1.5), "1.5", 1.5, true},
+		{"type name", fit.ProfileField{Type: fit.FitBaseTypeEnum, Scale: 1}, fit.EventTimer, "Timer", 0, true},
+		{"message index", fit.ProfileField{Type: fit.FitBaseTypeUint16, Scale: 1}, fit.MessageIndex(3), "3", 3, true},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			got, num, valid := Scalar(test.pf, reflect.ValueOf(test.v))
+			if valid != test.valid {
+				t.Fatalf("got valid %t, want %t", valid, test.valid)
+			}
+			if got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+			if IsFloat(test.pf, reflect.ValueOf(test.v)) && num != test.num {
+				t.Errorf("got number %v, want %v", num, test.num)
+			}
+		})
+	}
+}
+
+func TestValidTime(t *testing.T) {
+	if ValidTime(time.Time{}) {
+		t.Errorf("zero time: got valid, want invalid")
+	}
+	if ValidTime(time.Date(1989, 12, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("base time: got valid, want invalid")
+	}
+	if !ValidTime(time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("2020-06-01: got invalid, want valid")
+	}
+}
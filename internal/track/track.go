@@ -0,0 +1,225 @@
+// Package track extracts the geographic content of FIT activity and course
+// files in a form suitable for map exporters.
+package track
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+// Marker kinds.
+const (
+	KindLapStart    = "lap_start"
+	KindLapEnd      = "lap_end"
+	KindCoursePoint = "course_point"
+)
+
+// Track is the geographic content of a file.
+type Track struct {
+	Name     string
+	Sport    fit.Sport
+	Segments []Segment
+	Markers  []Marker
+}
+
+// Segment is a part of a track recorded without pauses.
+type Segment []Point
+
+// Point is a record with a valid position. Invalid values are NaN.
+type Point struct {
+	Time      time.Time
+	Lat, Lon  float64
+	Altitude  float64
+	HeartRate float64
+	Power     float64
+	Speed     float64
+}
+
+// Marker is a single position of interest, such as the start of a lap or a
+// course point.
+type Marker struct {
+	Kind      string
+	Index     int
+	Name      string
+	PointType string
+	Time      time.Time
+	Lat, Lon  float64
+}
+
+// FromFile returns the track of an activity or course file. Records are split
+// into segments at timer pauses, and records with an invalid position or
+// recorded while the timer was stopped are skipped.
+func FromFile(file *fit.File) (*Track, error) {
+	var (
+		t      Track
+		recs   []*fit.RecordMsg
+		events []*fit.EventMsg
+		laps   []*fit.LapMsg
+	)
+	switch file.Type() {
+	case fit.FileTypeActivity:
+		a, err := file.Activity()
+		if err != nil {
+			return nil, err
+		}
+		recs, events, laps = a.Records, a.Events, a.Laps
+		if len(a.Sessions) > 0 {
+			t.Sport = a.Sessions[0].Sport
+		} else if a.Sport != nil {
+			t.Sport = a.Sport.Sport
+			t.Name = a.Sport.Name
+		}
+	case fit.FileTypeCourse:
+		c, err := file.Course()
+		if err != nil {
+			return nil, err
+		}
+		recs, events = c.Records, c.Events
+		if c.Lap != nil {
+			laps = []*fit.LapMsg{c.Lap}
+		}
+		if c.Course != nil {
+			t.Sport = c.Course.Sport
+			t.Name = c.Course.Name
+		}
+		for i, cp := range c.CoursePoints {
+			if cp.PositionLat.Invalid() || cp.PositionLong.Invalid() {
+				continue
+			}
+			t.Markers = append(t.Markers, Marker{
+				Kind:      KindCoursePoint,
+				Index:     i,
+				Name:      cp.Name,
+				PointType: cp.Type.String(),
+				Time:      cp.Timestamp,
+				Lat:       cp.PositionLat.Degrees(),
+				Lon:       cp.PositionLong.Degrees(),
+			})
+		}
+	default:
+		return nil, fmt.Errorf("unsupported file type: %v", file.Type())
+	}
+
+	for i, l := range laps {
+		if !l.StartPositionLat.Invalid() && !l.StartPositionLong.Invalid() {
+			t.Markers = append(t.Markers, Marker{
+				Kind:  KindLapStart,
+				Index: i,
+				Time:  l.StartTime,
+				Lat:   l.StartPositionLat.Degrees(),
+				Lon:   l.StartPositionLong.Degrees(),
+			})
+		}
+		if !l.EndPositionLat.Invalid() && !l.EndPositionLong.Invalid() {
+			t.Markers = append(t.Markers, Marker{
+				Kind:  KindLapEnd,
+				Index: i,
+				Time:  l.Timestamp,
+				Lat:   l.EndPositionLat.Degrees(),
+				Lon:   l.EndPositionLong.Degrees(),
+			})
+		}
+	}
+
+	t.Segments = segments(recs, pauses(events))
+	return &t, nil
+}
+
+// pause is the time between a timer stop and the following timer start.
+type pause struct {
+	stop, start time.Time
+}
+
+func pauses(events []*fit.EventMsg) []pause {
+	var timer []*fit.EventMsg
+	for _, e := range events {
+		if e.Event == fit.EventTimer && !fit.IsBaseTime(e.Timestamp) {
+			timer = append(timer, e)
+		}
+	}
+	sort.SliceStable(timer, func(i, j int) bool { return timer[i].Timestamp.Before(timer[j].Timestamp) })
+
+	var (
+		ps      []pause
+		stopped bool
+		stop    time.Time
+	)
+	for _, e := range timer {
+		switch e.EventType {
+		case fit.EventTypeStop, fit.EventTypeStopAll,
+			fit.EventTypeStopDisable, fit.EventTypeStopDisableAll:
+			if !stopped {
+				stopped, stop = true, e.Timestamp
+			}
+		case fit.EventTypeStart:
+			if stopped {
+				stopped = false
+				ps = append(ps, pause{stop: stop, start: e.Timestamp})
+			}
+		}
+	}
+	return ps
+}
+
+// segments splits recs at the given pauses. A record at the time of a timer
+// stop ends the current segment, and a record at the time of a timer start
+// begins a new one.
+func segments(recs []*fit.RecordMsg, ps []pause) []Segment {
+	var (
+		segs []Segment
+		cur  Segment
+		next int
+	)
+	for _, r := range recs {
+		if r.PositionLat.Invalid() || r.PositionLong.Invalid() {
+			continue
+		}
+		for next < len(ps) && r.Timestamp.After(ps[next].stop) {
+			if len(cur) > 0 {
+				segs = append(segs, cur)
+				cur = nil
+			}
+			next++
+		}
+		if next > 0 && r.Timestamp.Before(ps[next-1].start) {
+			continue
+		}
+		cur = append(cur, point(r))
+	}
+	if len(cur) > 0 {
+		segs = append(segs, cur)
+	}
+	return segs
+}
+
+func point(r *fit.RecordMsg) Point {
+	p := Point{
+		Time:      r.Timestamp,
+		Lat:       r.PositionLat.Degrees(),
+		Lon:       r.PositionLong.Degrees(),
+		Altitude:  firstValid(r.GetEnhancedAltitudeScaled(), r.GetAltitudeScaled()),
+		HeartRate: math.NaN(),
+		Power:     math.NaN(),
+		Speed:     firstValid(r.GetEnhancedSpeedScaled(), r.GetSpeedScaled()),
+	}
+	if r.HeartRate != 0xFF {
+		p.HeartRate = float64(r.HeartRate)
+	}
+	if r.Power != 0xFFFF {
+		p.Power = float64(r.Power)
+	}
+	return p
+}
+
+func firstValid(vs ...float64) float64 {
+	for _, v := range vs {
+		if !math.IsNaN(v) {
+			return v
+		}
+	}
+	return math.NaN()
+}
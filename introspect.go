@@ -0,0 +1,226 @@
+package fit
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/tormoder/fit/internal/types"
+)
+
+// ProfileField describes a field of a message as defined by the FIT profile.
+type ProfileField struct {
+	// Num is the field definition number.
+	Num byte
+
+	// Name is the profile name of the field, e.g. "heart_rate".
+	Name string
+
+	// Index is the index of the field in the message struct, for use with
+	// reflect.Value.Field.
+	Index int
+
+	// Type is the FIT base type of the field, and Array reports whether
+	// the field is an array.
+	Type  FitBaseType
+	Array bool
+
+	// Scale, Offset and Units are the profile scale, offset and units of
+	// the field. A scaled value is given by value/Scale - Offset.
+	Scale  float64
+	Offset float64
+	Units  string
+}
+
+// Invalid returns the invalid value for the base type of the field.
+func (pf ProfileField) Invalid() interface{} {
+	return types.Base(pf.Type).Invalid()
+}
+
+// IsInvalid reports whether v, the value of an integer field or of an
+// element of an integer array field, is the invalid value for the base type
+// of the field. Values of other kinds are never reported as invalid.
+func (pf ProfileField) IsInvalid(v reflect.Value) bool {
+	invalid := reflect.ValueOf(pf.Invalid())
+	if !invalid.Type().ConvertibleTo(v.Type()) {
+		return false
+	}
+	return v.Interface() == invalid.Convert(v.Type()).Interface()
+}
+
+// ProfileSubfield describes a subfield of a dynamic field as defined by the
+// FIT profile. A dynamic field is interpreted as one of its subfields
+// depending on the values of other fields in the message, see for example
+// FileIdMsg.GetProduct. A subfield has the base type of the main field.
+type ProfileSubfield struct {
+	// Num is the field definition number of the main field.
+	Num byte
+
+	// Name is the profile name of the subfield, e.g. "garmin_product".
+	Name string
+
+	// Scale, Offset and Units are the profile scale, offset and units of
+	// the subfield.
+	Scale  float64
+	Offset float64
+	Units  string
+}
+
+var (
+	profileOnce    sync.Once
+	profileFields  [len(_fields)][]ProfileField
+	mesgNumsByName map[string]MesgNum
+	mesgNumsByType map[reflect.Type]MesgNum
+)
+
+func initProfileLookup() {
+	mesgNumsByName = make(map[string]MesgNum, len(knownMsgNums))
+	mesgNumsByType = make(map[reflect.Type]MesgNum, len(knownMsgNums))
+	for mn := range knownMsgNums {
+		mesgNumsByName[msgNames[mn]] = mn
+		mesgNumsByType[msgsTypes[mn]] = mn
+		var pfs []ProfileField
+		for _, f := range _fields[mn] {
+			if f == nil {
+				continue
+			}
+			pfs = append(pfs, ProfileField{
+				Num:    f.num,
+				Name:   f.name,
+				Index:  f.sindex,
+				Type:   FitBaseType(f.t.BaseType()),
+				Array:  f.t.Array(),
+				Scale:  f.scale,
+				Offset: f.offset,
+				Units:  f.units,
+			})
+		}
+		sorted := make([]ProfileField, len(pfs))
+		for _, pf := range pfs {
+			sorted[pf.Index] = pf
+		}
+		profileFields[mn] = sorted
+	}
+}
+
+// ProfileFields returns the profile fields of the message with the given
+// message number, ordered as the fields of the message struct. The returned
+// slice must not be modified.
+func ProfileFields(mn MesgNum) []ProfileField {
+	profileOnce.Do(initProfileLookup)
+	if int(mn) >= len(profileFields) {
+		return nil
+	}
+	return profileFields[mn]
+}
+
+// ProfileSubfields returns the profile subfields of the dynamic fields of
+// the message with the given message number, ordered by main field as in
+// the profile.
+func ProfileSubfields(mn MesgNum) []ProfileSubfield {
+	var psfs []ProfileSubfield
+	for _, sf := range _subfields[mn] {
+		psfs = append(psfs, ProfileSubfield{
+			Num:    sf.num,
+			Name:   sf.name,
+			Scale:  sf.scale,
+			Offset: sf.offset,
+			Units:  sf.units,
+		})
+	}
+	return psfs
+}
+
+// MesgName returns the profile name of the message with the given message
+// number, e.g. "file_id". An empty string is returned for unknown messages.
+func MesgName(mn MesgNum) string {
+	if !knownMsgNums[mn] {
+		return ""
+	}
+	return msgNames[mn]
+}
+
+// MesgNumByName returns the message number for the message with the given
+// profile name, e.g. "file_id".
+func MesgNumByName(name string) (MesgNum, bool) {
+	profileOnce.Do(initProfileLookup)
+	mn, found := mesgNumsByName[name]
+	return mn, found
+}
+
+// MesgNumOf returns the message number for msg, which must be a message
+// struct or a pointer to one, e.g. *RecordMsg.
+func MesgNumOf(msg interface{}) (MesgNum, bool) {
+	profileOnce.Do(initProfileLookup)
+	t := reflect.TypeOf(msg)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	mn, found := mesgNumsByType[t]
+	return mn, found
+}
+
+// NewMesg returns a pointer to a new message with the given message number,
+// initialized to all-invalid values, e.g. a *RecordMsg for MesgNumRecord.
+func NewMesg(mn MesgNum) (interface{}, bool) {
+	if !knownMsgNums[mn] {
+		return nil, false
+	}
+	return newMesgFuncs[mn]().Interface(), true
+}
+
+// Add adds a message to f. The message must be a pointer to a message
+// struct, e.g. *RecordMsg. Messages that are not part of f's file type are
+// ignored, as they are when decoding. Add copies the message and, as done
+// when decoding, expands components for the messages that have them.
+func (f *File) Add(msg interface{}) error {
+	if _, found := MesgNumOf(msg); !found {
+		return fmt.Errorf("not a message: %T", msg)
+	}
+	v := reflect.ValueOf(msg)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return fmt.Errorf("message must be a non-nil pointer, got %T", msg)
+	}
+	if f.msgAdder == nil {
+		if err := f.init(); err != nil {
+			return err
+		}
+	}
+	f.add(v.Elem())
+	return nil
+}
+
+// Messages returns pointers to all messages in f: the FileId message,
+// followed by the FileCreator and TimestampCorrelation messages if present,
+// followed by the messages specific to the file type in the order they are
+// declared in the file type struct (e.g. ActivityFile).
+func (f *File) Messages() []interface{} {
+	msgs := []interface{}{&f.FileId}
+	if f.FileCreator != nil {
+		msgs = append(msgs, f.FileCreator)
+	}
+	if f.TimestampCorrelation != nil {
+		msgs = append(msgs, f.TimestampCorrelation)
+	}
+	if f.msgAdder == nil {
+		return msgs
+	}
+
+	fv := reflect.ValueOf(f.msgAdder).Elem()
+	for i := 0; i < fv.NumField(); i++ {
+		v := fv.Field(i)
+		switch v.Kind() {
+		case reflect.Ptr:
+			if !v.IsNil() {
+				msgs = append(msgs, v.Interface())
+			}
+		case reflect.Slice:
+			for j := 0; j < v.Len(); j++ {
+				if !v.Index(j).IsNil() {
+					msgs = append(msgs, v.Index(j).Interface())
+				}
+			}
+		}
+	}
+	return msgs
+}
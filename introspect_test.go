@@ -0,0 +1,134 @@
+package fit_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tormoder/fit"
+)
+
+func TestProfileFields(t *testing.T) {
+	mn, found := fit.MesgNumByName("record")
+	if !found || mn != fit.MesgNumRecord {
+		t.Fatalf("MesgNumByName(record): got %v, %t, want %v, true", mn, found, fit.MesgNumRecord)
+	}
+	if got := fit.MesgName(fit.MesgNumFileId); got != "file_id" {
+		t.Errorf("MesgName: got %q, want %q", got, "file_id")
+	}
+
+	var altitude *fit.ProfileField
+	pfs := fit.ProfileFields(fit.MesgNumRecord)
+	for i := range pfs {
+		if pfs[i].Index != i {
+			t.Fatalf("field %q: got index %d, want %d", pfs[i].Name, pfs[i].Index, i)
+		}
+		if pfs[i].Name == "altitude" {
+			altitude = &pfs[i]
+		}
+	}
+	if altitude == nil {
+		t.Fatalf("no altitude field in record profile fields")
+	}
+	want := fit.ProfileField{
+		Num:    2,
+		Name:   "altitude",
+		Index:  altitude.Index,
+		Type:   fit.FitBaseTypeUint16,
+		Scale:  5,
+		Offset: 500,
+		Units:  "m",
+	}
+	if *altitude != want {
+		t.Errorf("altitude:\ngot  %+v\nwant %+v", *altitude, want)
+	}
+
+	msg, found := fit.NewMesg(fit.MesgNumRecord)
+	if !found {
+		t.Fatalf("NewMesg: record not found")
+	}
+	if mn, _ := fit.MesgNumOf(msg); mn != fit.MesgNumRecord {
+		t.Errorf("MesgNumOf: got %v, want %v", mn, fit.MesgNumRecord)
+	}
+}
+
+func TestProfileSubfields(t *testing.T) {
+	want := fit.ProfileSubfield{Num: 2, Name: "garmin_product", Scale: 1}
+	var found bool
+	for _, sf := range fit.ProfileSubfields(fit.MesgNumFileId) {
+		if sf == want {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("file_id subfields: got %+v, want to contain %+v", fit.ProfileSubfields(fit.MesgNumFileId), want)
+	}
+	if got := fit.ProfileSubfields(fit.MesgNumRecord); len(got) != 0 {
+		t.Errorf("record subfields: got %+v, want none", got)
+	}
+}
+
+func TestProfileFieldIsInvalid(t *testing.T) {
+	var heartRate, speed, timestamp fit.ProfileField
+	for _, pf := range fit.ProfileFields(fit.MesgNumRecord) {
+		switch pf.Name {
+		case "heart_rate":
+			heartRate = pf
+		case "speed":
+			speed = pf
+		case "timestamp":
+			timestamp = pf
+		}
+	}
+
+	rec := fit.NewRecordMsg()
+	tests := []struct {
+		name string
+		pf   fit.ProfileField
+		v    interface{}
+		want bool
+	}{
+		{"invalid uint8", heartRate, rec.HeartRate, true},
+		{"valid uint8", heartRate, uint8(140), false},
+		{"invalid uint16", speed, rec.Speed, true},
+		{"valid uint16", speed, uint16(0), false},
+		{"named type", speed, fit.MessageIndex(0xFFFF), true},
+		{"not an integer", timestamp, rec.Timestamp, false},
+	}
+	for _, test := range tests {
+		if got := test.pf.IsInvalid(reflect.ValueOf(test.v)); got != test.want {
+			t.Errorf("%s: got %t, want %t", test.name, got, test.want)
+		}
+	}
+}
+
+func TestFileAddMessages(t *testing.T) {
+	file, err := fit.NewFile(fit.FileTypeActivity, fit.NewHeader(fit.V20, true))
+	if err != nil {
+		t.Fatalf("NewFile: got error, want none; error is: %v", err)
+	}
+	rec := fit.NewRecordMsg()
+	rec.HeartRate = 100
+	if err = file.Add(rec); err != nil {
+		t.Fatalf("Add: got error, want none; error is: %v", err)
+	}
+	if err = file.Add(fit.NewSessionMsg()); err != nil {
+		t.Fatalf("Add: got error, want none; error is: %v", err)
+	}
+	if err = file.Add(42); err == nil {
+		t.Errorf("Add non-message: got no error, want one")
+	}
+
+	msgs := file.Messages()
+	if len(msgs) != 3 {
+		t.Fatalf("Messages: got %d messages, want 3", len(msgs))
+	}
+	if _, ok := msgs[0].(*fit.FileIdMsg); !ok {
+		t.Errorf("Messages: first message is %T, want *fit.FileIdMsg", msgs[0])
+	}
+	if _, ok := msgs[1].(*fit.SessionMsg); !ok {
+		t.Errorf("Messages: second message is %T, want *fit.SessionMsg", msgs[1])
+	}
+	if r, ok := msgs[2].(*fit.RecordMsg); !ok || r.HeartRate != 100 {
+		t.Errorf("Messages: third message is %+v, want record with heart rate 100", msgs[2])
+	}
+}
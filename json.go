@@ -2,13 +2,23 @@ package fit
 
 import (
 	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
 	"strconv"
+	"strings"
 	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/tormoder/fit/internal/types"
 )
 
 type jsonEncodeState struct {
 	bytes.Buffer
 	scratch [64]byte
+	comma   bool
 }
 
 var jsonEncodeStatePool sync.Pool
@@ -17,11 +27,19 @@ func newJSONEncodeState() *jsonEncodeState {
 	if v := jsonEncodeStatePool.Get(); v != nil {
 		e := v.(*jsonEncodeState)
 		e.Reset()
+		e.comma = false
 		return e
 	}
 	return new(jsonEncodeState)
 }
 
+// done returns a copy of the encoded bytes and puts j back in the pool.
+func (j *jsonEncodeState) done() []byte {
+	b := append([]byte(nil), j.Bytes()...)
+	jsonEncodeStatePool.Put(j)
+	return b
+}
+
 func (j *jsonEncodeState) writeFieldName(s string) {
 	j.WriteByte('"')
 	j.WriteString(s)
@@ -40,8 +58,61 @@ func (j *jsonEncodeState) writeUint(u uint64) {
 	j.Write(b)
 }
 
+func (j *jsonEncodeState) writeInt(i int64) {
+	b := strconv.AppendInt(j.scratch[:0], i, 10)
+	j.Write(b)
+}
+
+func (j *jsonEncodeState) writeFloat(f float64) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		j.writeNull()
+		return
+	}
+	b := strconv.AppendFloat(j.scratch[:0], f, 'g', -1, 64)
+	j.Write(b)
+}
+
+func (j *jsonEncodeState) writeNull() {
+	j.WriteString("null")
+}
+
+const hex = "0123456789abcdef"
+
+// writeString writes s as a JSON string. Invalid UTF-8 is replaced by
+// U+FFFD.
+func (j *jsonEncodeState) writeString(s string) {
+	j.WriteByte('"')
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c < utf8.RuneSelf {
+			switch {
+			case c == '"' || c == '\\':
+				j.WriteByte('\\')
+				j.WriteByte(c)
+			case c < 0x20:
+				j.WriteString(`\u00`)
+				j.WriteByte(hex[c>>4])
+				j.WriteByte(hex[c&0xF])
+			default:
+				j.WriteByte(c)
+			}
+			i++
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			j.WriteString(`�`)
+		} else {
+			j.WriteString(s[i : i+size])
+		}
+		i += size
+	}
+	j.WriteByte('"')
+}
+
 func (j *jsonEncodeState) open() {
 	j.WriteByte('{')
+	j.comma = false
 }
 
 func (j *jsonEncodeState) close() {
@@ -51,3 +122,321 @@ func (j *jsonEncodeState) close() {
 func (j *jsonEncodeState) c() {
 	j.WriteByte(',')
 }
+
+func (j *jsonEncodeState) arrayOpen() {
+	j.WriteByte('[')
+}
+
+func (j *jsonEncodeState) arrayClose() {
+	j.WriteByte(']')
+}
+
+// key writes a field name, preceded by a comma if needed.
+func (j *jsonEncodeState) key(name string) {
+	if j.comma {
+		j.c()
+	}
+	j.writeFieldName(name)
+	j.comma = true
+}
+
+// valueOpen and valueClose wrap a value as {"value":v,"units":"u"} if units
+// is non-empty.
+func (j *jsonEncodeState) valueOpen(units string) {
+	if units == "" {
+		return
+	}
+	j.WriteString(`{"value":`)
+}
+
+func (j *jsonEncodeState) valueClose(units string) {
+	if units == "" {
+		return
+	}
+	j.WriteString(`,"units":`)
+	j.writeString(units)
+	j.WriteByte('}')
+}
+
+// jsonField writes v encoded using encoding/json.
+func (j *jsonEncodeState) jsonField(name string, v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	j.key(name)
+	j.Write(b)
+	return nil
+}
+
+func (j *jsonEncodeState) stringField(name, s string) {
+	j.key(name)
+	j.writeString(s)
+}
+
+func (j *jsonEncodeState) uintField(name string, u uint64, units string) {
+	j.key(name)
+	j.valueOpen(units)
+	j.writeUint(u)
+	j.valueClose(units)
+}
+
+func (j *jsonEncodeState) intField(name string, i int64, units string) {
+	j.key(name)
+	j.valueOpen(units)
+	j.writeInt(i)
+	j.valueClose(units)
+}
+
+func (j *jsonEncodeState) floatField(name string, f float64, units string) {
+	j.key(name)
+	j.valueOpen(units)
+	j.writeFloat(f)
+	j.valueClose(units)
+}
+
+// enumField writes the name of a type value. Values without a name, for
+// which String returns the form "Type(n)", are written as numbers.
+func (j *jsonEncodeState) enumField(name, s string, u uint64) {
+	j.key(name)
+	if i := strings.LastIndexByte(s, '('); i >= 0 && strings.HasSuffix(s, ")") &&
+		s[i+1:len(s)-1] == strconv.FormatUint(u, 10) {
+		j.writeUint(u)
+		return
+	}
+	j.writeString(s)
+}
+
+func (j *jsonEncodeState) timeField(name string, t time.Time) {
+	if t.IsZero() || IsBaseTime(t) {
+		return
+	}
+	j.key(name)
+	j.WriteByte('"')
+	j.Write(t.AppendFormat(j.scratch[:0], time.RFC3339))
+	j.WriteByte('"')
+}
+
+func (j *jsonEncodeState) latField(name string, lat Latitude) {
+	if lat.Invalid() {
+		return
+	}
+	j.key(name)
+	j.writeFloat(lat.Degrees())
+}
+
+func (j *jsonEncodeState) lngField(name string, lng Longitude) {
+	if lng.Invalid() {
+		return
+	}
+	j.key(name)
+	j.writeFloat(lng.Degrees())
+}
+
+// unmarshalMsgJSON decodes the JSON object in data into the message struct
+// v of message type mn. It accepts the output of the generated MarshalJSON
+// methods. Fields not present in data are left as-is.
+func unmarshalMsgJSON(data []byte, mn MesgNum, v reflect.Value) error {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+	if obj == nil {
+		return nil
+	}
+	for _, pfield := range _fields[mn] {
+		if pfield == nil {
+			continue
+		}
+		sfield := v.Type().Field(pfield.sindex)
+		raw, found := obj[sfield.Name]
+		if !found {
+			continue
+		}
+		if err := unmarshalFieldJSON(raw, pfield, v.Field(pfield.sindex)); err != nil {
+			return fmt.Errorf("%v: field %s: %w", mn, sfield.Name, err)
+		}
+	}
+	return nil
+}
+
+func unmarshalFieldJSON(raw json.RawMessage, pfield *field, fieldv reflect.Value) error {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) > 0 && raw[0] == '{' {
+		var wrapped struct {
+			Value json.RawMessage `json:"value"`
+		}
+		if err := json.Unmarshal(raw, &wrapped); err != nil {
+			return err
+		}
+		raw = bytes.TrimSpace(wrapped.Value)
+	}
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+		return nil
+	}
+
+	switch pfield.t.Kind() {
+	case types.TimeUTC, types.TimeLocal:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return err
+		}
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return err
+		}
+		if pfield.t.Kind() == types.TimeLocal {
+			_, offs := t.Zone()
+			t = t.In(time.FixedZone(localZoneName, offs))
+		} else {
+			t = t.UTC()
+		}
+		fieldv.Set(reflect.ValueOf(t))
+		return nil
+	case types.Lat, types.Lng:
+		var deg float64
+		if err := json.Unmarshal(raw, &deg); err != nil {
+			return err
+		}
+		semi := degreesToSemicircles(deg)
+		if semi < math.MinInt32 || semi > math.MaxInt32 {
+			return fmt.Errorf("coordinate out of range: %v", deg)
+		}
+		if pfield.t.Kind() == types.Lat {
+			fieldv.Set(reflect.ValueOf(NewLatitude(int32(semi))))
+		} else {
+			fieldv.Set(reflect.ValueOf(NewLongitude(int32(semi))))
+		}
+		return nil
+	}
+
+	if !pfield.t.Array() {
+		return unmarshalValueJSON(raw, pfield, fieldv)
+	}
+
+	var elems []json.RawMessage
+	if err := json.Unmarshal(raw, &elems); err != nil {
+		return err
+	}
+	slice := reflect.MakeSlice(fieldv.Type(), len(elems), len(elems))
+	for i, elem := range elems {
+		ev := slice.Index(i)
+		if bytes.Equal(bytes.TrimSpace(elem), []byte("null")) {
+			ev.Set(reflect.ValueOf(pfield.t.BaseType().Invalid()).Convert(ev.Type()))
+			continue
+		}
+		if err := unmarshalValueJSON(elem, pfield, ev); err != nil {
+			return err
+		}
+	}
+	fieldv.Set(slice)
+	return nil
+}
+
+func unmarshalValueJSON(raw json.RawMessage, pfield *field, v reflect.Value) error {
+	if v.Kind() == reflect.String {
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return err
+		}
+		v.SetString(s)
+		return nil
+	}
+
+	if raw[0] == '"' {
+		var name string
+		if err := json.Unmarshal(raw, &name); err != nil {
+			return err
+		}
+		u, found := enumValueByName(v.Type(), name)
+		if !found {
+			return fmt.Errorf("unknown %v value: %q", v.Type(), name)
+		}
+		v.SetUint(u)
+		return nil
+	}
+
+	scaled := pfield.scale != 1 || pfield.offset != 0
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		var f float64
+		if err := json.Unmarshal(raw, &f); err != nil {
+			return err
+		}
+		v.SetFloat((f + pfield.offset) * pfield.scale)
+		return nil
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var i int64
+		if scaled {
+			var f float64
+			if err := json.Unmarshal(raw, &f); err != nil {
+				return err
+			}
+			f = math.Round((f + pfield.offset) * pfield.scale)
+			if f < math.MinInt64 || f >= math.MaxInt64 {
+				return fmt.Errorf("value out of range: %s", raw)
+			}
+			i = int64(f)
+		} else if err := json.Unmarshal(raw, &i); err != nil {
+			return err
+		}
+		if v.OverflowInt(i) {
+			return fmt.Errorf("value out of range: %s", raw)
+		}
+		v.SetInt(i)
+		return nil
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var u uint64
+		if scaled {
+			var f float64
+			if err := json.Unmarshal(raw, &f); err != nil {
+				return err
+			}
+			f = math.Round((f + pfield.offset) * pfield.scale)
+			if f < 0 || f >= math.MaxUint64 {
+				return fmt.Errorf("value out of range: %s", raw)
+			}
+			u = uint64(f)
+		} else if err := json.Unmarshal(raw, &u); err != nil {
+			return err
+		}
+		if v.OverflowUint(u) {
+			return fmt.Errorf("value out of range: %s", raw)
+		}
+		v.SetUint(u)
+		return nil
+	default:
+		return fmt.Errorf("unsupported field type %v", v.Type())
+	}
+}
+
+// typeValue is a named value of a FIT type, in the profile type value
+// lookup table.
+type typeValue struct {
+	name  string
+	value uint64
+}
+
+var enumNames sync.Map // map[reflect.Type]map[string]uint64
+
+// enumValueByName returns the value of the type t with the given name, as
+// returned by the String method of t.
+func enumValueByName(t reflect.Type, name string) (uint64, bool) {
+	if names, found := enumNames.Load(t); found {
+		u, ok := names.(map[string]uint64)[name]
+		return u, ok
+	}
+	tvs, found := _typeValues[t]
+	if !found {
+		return 0, false
+	}
+	names := make(map[string]uint64, len(tvs))
+	for _, tv := range tvs {
+		if _, dup := names[tv.name]; !dup {
+			names[tv.name] = tv.value
+		}
+	}
+	enumNames.Store(t, names)
+	u, ok := names[name]
+	return u, ok
+}
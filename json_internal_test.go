@@ -0,0 +1,33 @@
+package fit
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestEnumValueByName(t *testing.T) {
+	str := func(typ reflect.Type, u uint64) string {
+		v := reflect.New(typ).Elem()
+		v.SetUint(u)
+		return v.Interface().(fmt.Stringer).String()
+	}
+	for typ, tvs := range _typeValues {
+		for _, tv := range tvs {
+			name := str(typ, tv.value)
+			u, found := enumValueByName(typ, name)
+			if !found {
+				t.Fatalf("%v: no value for name %q", typ, name)
+			}
+			if got := str(typ, u); got != name {
+				t.Errorf("%v: name %q: got value %d named %q", typ, name, u, got)
+			}
+		}
+	}
+	if _, found := enumValueByName(reflect.TypeOf(Sport(0)), "Sport(42)"); found {
+		t.Errorf("Sport(42): got value, want none")
+	}
+	if _, found := enumValueByName(reflect.TypeOf(uint8(0)), "Invalid"); found {
+		t.Errorf("uint8: got value, want none")
+	}
+}
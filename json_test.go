@@ -0,0 +1,182 @@
+package fit_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+func TestMsgMarshalJSON(t *testing.T) {
+	b, err := json.Marshal(fit.NewRecordMsg())
+	if err != nil {
+		t.Fatalf("marshal: got error, want none; error is: %v", err)
+	}
+	if got := string(b); got != "{}" {
+		t.Errorf("all-invalid record: got %s, want {}", got)
+	}
+
+	rec := fit.NewRecordMsg()
+	rec.PositionLat = fit.NewLatitudeDegrees(59.9)
+	rec.Altitude = 2600
+	rec.HeartRate = 140
+	rec.Speed = 0
+	b, err = json.Marshal(rec)
+	if err != nil {
+		t.Fatalf("marshal: got error, want none; error is: %v", err)
+	}
+	const want = `{"PositionLat":59.89999998360872,"Altitude":{"value":20,"units":"m"},"HeartRate":{"value":140,"units":"bpm"},"Speed":{"value":0,"units":"m/s"}}`
+	if got := string(b); got != want {
+		t.Errorf("record:\ngot  %s\nwant %s", got, want)
+	}
+
+	session := fit.NewSessionMsg()
+	session.Sport = fit.SportCycling
+	session.SubSport = fit.SubSport(200)
+	b, err = json.Marshal(session)
+	if err != nil {
+		t.Fatalf("marshal: got error, want none; error is: %v", err)
+	}
+	if got, want := string(b), `{"Sport":"Cycling","SubSport":200}`; got != want {
+		t.Errorf("session: got %s, want %s", got, want)
+	}
+
+	var got fit.SessionMsg
+	if err = json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("unmarshal: got error, want none; error is: %v", err)
+	}
+	if got.Sport != session.Sport || got.SubSport != session.SubSport {
+		t.Errorf("unmarshal session: got sport %v/%v, want %v/%v",
+			got.Sport, got.SubSport, session.Sport, session.SubSport)
+	}
+	if got.TotalTimerTime != 0xFFFFFFFF {
+		t.Errorf("unmarshal session: field not present in JSON was not set invalid")
+	}
+
+	if err = json.Unmarshal([]byte(`{"Sport":"NoSuchSport"}`), &got); err == nil {
+		t.Errorf("unmarshal unknown sport name: got no error, want one")
+	}
+}
+
+func TestTimeInZoneMsgJSONRoundTrip(t *testing.T) {
+	tiz := fit.NewTimeInZoneMsg()
+	tiz.Timestamp = time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	tiz.ReferenceMesg = fit.MesgNumSession
+	tiz.ReferenceIndex = 0
+	tiz.TimeInHrZone = []uint32{60000, 120500, 0xFFFFFFFF}
+	tiz.TimeInPowerZone = []uint32{30000, 90000}
+	tiz.HrZoneHighBoundary = []uint8{120, 140, 160}
+	tiz.SpeedZoneHighBoundary = []uint16{2500, 4000}
+	tiz.HrCalcType = fit.HrZoneCalcPercentMaxHr
+	tiz.MaxHeartRate = 190
+	tiz.ThresholdHeartRate = 170
+	tiz.FunctionalThresholdPower = 250
+
+	b, err := json.Marshal(tiz)
+	if err != nil {
+		t.Fatalf("marshal: got error, want none; error is: %v", err)
+	}
+	for _, key := range []string{`"TimeInHrZone":{"value":[60,120.5,null],"units":"s"}`, `"FunctionalThresholdPower":250`} {
+		if !strings.Contains(string(b), key) {
+			t.Errorf("marshal: %s missing from output %s", key, b)
+		}
+	}
+
+	got := new(fit.TimeInZoneMsg)
+	if err = json.Unmarshal(b, got); err != nil {
+		t.Fatalf("unmarshal: got error, want none; error is: %v", err)
+	}
+	if !reflect.DeepEqual(got, tiz) {
+		t.Errorf("JSON round trip:\ngot  %+v\nwant %+v", got, tiz)
+	}
+}
+
+func TestBeatIntervalsMsgJSONRoundTrip(t *testing.T) {
+	bi := fit.NewBeatIntervalsMsg()
+	bi.Timestamp = time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	bi.TimestampMs = 250
+	bi.Time = []uint16{812, 798, 0xFFFF, 805}
+
+	b, err := json.Marshal(bi)
+	if err != nil {
+		t.Fatalf("marshal: got error, want none; error is: %v", err)
+	}
+	const want = `"Time":{"value":[812,798,null,805],"units":"ms"}`
+	if !strings.Contains(string(b), want) {
+		t.Errorf("marshal: %s missing from output %s", want, b)
+	}
+
+	got := new(fit.BeatIntervalsMsg)
+	if err = json.Unmarshal(b, got); err != nil {
+		t.Fatalf("unmarshal: got error, want none; error is: %v", err)
+	}
+	if !reflect.DeepEqual(got, bi) {
+		t.Errorf("JSON round trip:\ngot  %+v\nwant %+v", got, bi)
+	}
+}
+
+func TestFileJSONRoundTrip(t *testing.T) {
+	files := []string{
+		"Activity.fit",
+		"MonitoringFile.fit",
+		"Settings.fit",
+		"WeightScaleMultiUser.fit",
+		"WorkoutCustomTargetValues.fit",
+		"WorkoutRepeatSteps.fit",
+	}
+
+	for _, name := range files {
+		name := name
+		t.Run(name, func(t *testing.T) {
+			data, err := os.ReadFile(filepath.Join(tdfolder, "fitsdk", name))
+			if err != nil {
+				t.Fatalf("reading file failed: %v", err)
+			}
+			orig, err := fit.Decode(bytes.NewReader(data))
+			if err != nil {
+				t.Fatalf("decode: got error, want none; error is: %v", err)
+			}
+			origJSON, err := json.Marshal(orig)
+			if err != nil {
+				t.Fatalf("marshal: got error, want none; error is: %v", err)
+			}
+			if !json.Valid(origJSON) {
+				t.Fatalf("marshal: output is not valid JSON")
+			}
+			if !strings.Contains(string(origJSON), `"`+orig.Type().String()+`":{`) {
+				t.Fatalf("marshal: no %q object in output", orig.Type())
+			}
+
+			var file fit.File
+			if err = json.Unmarshal(origJSON, &file); err != nil {
+				t.Fatalf("unmarshal: got error, want none; error is: %v", err)
+			}
+
+			buf := new(bytes.Buffer)
+			if err = fit.Encode(buf, &file, binary.LittleEndian); err != nil {
+				t.Fatalf("encode: got error, want none; error is: %v", err)
+			}
+			decoded, err := fit.Decode(buf)
+			if err != nil {
+				t.Fatalf("decode encoded: got error, want none; error is: %v", err)
+			}
+
+			// The header and CRC changes when re-encoding.
+			decoded.Header, decoded.CRC = orig.Header, orig.CRC
+			gotJSON, err := json.Marshal(decoded)
+			if err != nil {
+				t.Fatalf("marshal decoded: got error, want none; error is: %v", err)
+			}
+			if !bytes.Equal(gotJSON, origJSON) {
+				t.Errorf("JSON -> File -> FIT round trip: JSON output differs")
+			}
+		})
+	}
+}
@@ -0,0 +1,220 @@
+// Package kml implements conversion of FIT activity and course files to KML
+// 2.2 documents.
+package kml
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/internal/track"
+)
+
+const (
+	nsKML = "http://www.opengis.net/kml/2.2"
+	nsGX  = "http://www.google.com/kml/ext/2.2"
+)
+
+// Names of the per-position data arrays.
+var arrayNames = []string{"heart_rate", "power", "speed"}
+
+type kmlDoc struct {
+	XMLName  xml.Name `xml:"kml"`
+	Xmlns    string   `xml:"xmlns,attr"`
+	XmlnsGX  string   `xml:"xmlns:gx,attr"`
+	Document document `xml:"Document"`
+}
+
+type document struct {
+	Name       string      `xml:"name,omitempty"`
+	Schema     schema      `xml:"Schema"`
+	Placemarks []placemark `xml:"Placemark"`
+}
+
+type schema struct {
+	ID     string       `xml:"id,attr"`
+	Fields []arrayField `xml:"gx:SimpleArrayField"`
+}
+
+type arrayField struct {
+	Name string `xml:"name,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type placemark struct {
+	Name         string        `xml:"name,omitempty"`
+	TimeStamp    *timeStamp    `xml:"TimeStamp,omitempty"`
+	ExtendedData *extendedData `xml:"ExtendedData,omitempty"`
+	Point        *point        `xml:"Point,omitempty"`
+	MultiTrack   *multiTrack   `xml:"gx:MultiTrack,omitempty"`
+}
+
+type timeStamp struct {
+	When string `xml:"when"`
+}
+
+type point struct {
+	Coordinates string `xml:"coordinates"`
+}
+
+type multiTrack struct {
+	Interpolate int       `xml:"gx:interpolate"`
+	Tracks      []gxTrack `xml:"gx:Track"`
+}
+
+type gxTrack struct {
+	AltitudeMode string       `xml:"altitudeMode"`
+	When         []string     `xml:"when"`
+	Coords       []string     `xml:"gx:coord"`
+	ExtendedData extendedData `xml:"ExtendedData"`
+}
+
+type extendedData struct {
+	Data       []data      `xml:"Data"`
+	SchemaData *schemaData `xml:"SchemaData,omitempty"`
+}
+
+type data struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value"`
+}
+
+type schemaData struct {
+	SchemaURL string      `xml:"schemaUrl,attr"`
+	Arrays    []arrayData `xml:"gx:SimpleArrayData"`
+}
+
+type arrayData struct {
+	Name   string   `xml:"name,attr"`
+	Values []string `xml:"gx:value"`
+}
+
+// WriteKML writes the activity or course file to w as a KML document.
+//
+// The structure follows geojson.WriteGeoJSON. Records with a valid position
+// are written as a gx:MultiTrack placemark, with one gx:Track for every part
+// of the activity between timer pauses. Heart rate, power and speed are
+// written as gx:SimpleArrayData of every track, with empty values for invalid
+// data. The start and end position of every lap, and the course points of
+// course files, are written as Point placemarks.
+func WriteKML(w io.Writer, file *fit.File) error {
+	t, err := track.FromFile(file)
+	if err != nil {
+		return fmt.Errorf("kml: %w", err)
+	}
+
+	doc := kmlDoc{
+		Xmlns:   nsKML,
+		XmlnsGX: nsGX,
+		Document: document{
+			Name:   t.Name,
+			Schema: schema{ID: "track"},
+		},
+	}
+	for _, name := range arrayNames {
+		doc.Document.Schema.Fields = append(doc.Document.Schema.Fields, arrayField{Name: name, Type: "float"})
+	}
+
+	if len(t.Segments) > 0 {
+		mt := multiTrack{}
+		for _, seg := range t.Segments {
+			mt.Tracks = append(mt.Tracks, trackOf(seg))
+		}
+		pm := placemark{Name: t.Name, MultiTrack: &mt}
+		if pm.Name == "" {
+			pm.Name = "Track"
+		}
+		if t.Sport != fit.SportInvalid {
+			pm.ExtendedData = &extendedData{
+				Data: []data{{Name: "sport", Value: strings.ToLower(t.Sport.String())}},
+			}
+		}
+		doc.Document.Placemarks = append(doc.Document.Placemarks, pm)
+	}
+	for _, m := range t.Markers {
+		doc.Document.Placemarks = append(doc.Document.Placemarks, markerPlacemark(m))
+	}
+
+	if _, err = io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err = enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "\n")
+	return err
+}
+
+func trackOf(seg track.Segment) gxTrack {
+	gt := gxTrack{AltitudeMode: "absolute"}
+	for _, p := range seg {
+		if math.IsNaN(p.Altitude) {
+			gt.AltitudeMode = "clampToGround"
+			break
+		}
+	}
+	arrays := make([]arrayData, len(arrayNames))
+	for i, name := range arrayNames {
+		arrays[i].Name = name
+	}
+	for _, p := range seg {
+		gt.When = append(gt.When, formatTime(p.Time))
+		alt := p.Altitude
+		if math.IsNaN(alt) {
+			alt = 0
+		}
+		gt.Coords = append(gt.Coords, formatFloat(p.Lon)+" "+formatFloat(p.Lat)+" "+formatFloat(alt))
+		for i, v := range []float64{p.HeartRate, p.Power, p.Speed} {
+			arrays[i].Values = append(arrays[i].Values, formatFloat(v))
+		}
+	}
+	gt.ExtendedData.SchemaData = &schemaData{SchemaURL: "#track", Arrays: arrays}
+	return gt
+}
+
+func markerPlacemark(m track.Marker) placemark {
+	pm := placemark{
+		Point: &point{Coordinates: formatFloat(m.Lon) + "," + formatFloat(m.Lat)},
+		ExtendedData: &extendedData{
+			Data: []data{{Name: "kind", Value: m.Kind}},
+		},
+	}
+	switch m.Kind {
+	case track.KindCoursePoint:
+		pm.Name = m.Name
+		if pm.Name == "" {
+			pm.Name = m.PointType
+		}
+		pm.ExtendedData.Data = append(pm.ExtendedData.Data, data{Name: "type", Value: m.PointType})
+	case track.KindLapStart:
+		pm.Name = "Lap " + strconv.Itoa(m.Index+1) + " start"
+	case track.KindLapEnd:
+		pm.Name = "Lap " + strconv.Itoa(m.Index+1) + " end"
+	}
+	if s := formatTime(m.Time); s != "" {
+		pm.TimeStamp = &timeStamp{When: s}
+	}
+	return pm
+}
+
+// formatFloat formats f without exponent, or as the empty string if f is NaN.
+func formatFloat(f float64) string {
+	if math.IsNaN(f) {
+		return ""
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() || fit.IsBaseTime(t) {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,159 @@
+package kml_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/kml"
+)
+
+type kmlDoc struct {
+	Placemarks []struct {
+		Name  string `xml:"name"`
+		Point *struct {
+			Coordinates string `xml:"coordinates"`
+		} `xml:"Point"`
+		Tracks []struct {
+			When   []string `xml:"when"`
+			Coords []string `xml:"coord"`
+			Arrays []struct {
+				Name   string   `xml:"name,attr"`
+				Values []string `xml:"value"`
+			} `xml:"ExtendedData>SchemaData>SimpleArrayData"`
+		} `xml:"MultiTrack>Track"`
+	} `xml:"Document>Placemark"`
+}
+
+func writeAndParse(t *testing.T, file *fit.File) (string, kmlDoc) {
+	t.Helper()
+	buf := new(bytes.Buffer)
+	if err := kml.WriteKML(buf, file); err != nil {
+		t.Fatalf("WriteKML: got error, want none; error is: %v", err)
+	}
+	var doc kmlDoc
+	if err := xml.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("unmarshal: got error, want none; error is: %v", err)
+	}
+	return buf.String(), doc
+}
+
+func TestWriteKMLActivity(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "testdata", "dcrainmaker", "Edge810-Vector-2013-08-16-15-35-10.fit"))
+	if err != nil {
+		t.Fatalf("reading file failed: %v", err)
+	}
+	file, err := fit.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("decode: got error, want none; error is: %v", err)
+	}
+	activity, err := file.Activity()
+	if err != nil {
+		t.Fatalf("activity: got error, want none; error is: %v", err)
+	}
+	var wantPts int
+	for _, r := range activity.Records {
+		if !r.PositionLat.Invalid() && !r.PositionLong.Invalid() {
+			wantPts++
+		}
+	}
+
+	out, doc := writeAndParse(t, file)
+	if !strings.Contains(out, `xmlns:gx="http://www.google.com/kml/ext/2.2"`) {
+		t.Errorf("output has no gx namespace declaration")
+	}
+	if len(doc.Placemarks) != 1+2*len(activity.Laps) {
+		t.Fatalf("got %d placemarks, want %d", len(doc.Placemarks), 1+2*len(activity.Laps))
+	}
+	tracks := doc.Placemarks[0].Tracks
+	if len(tracks) != 1 {
+		t.Fatalf("got %d tracks, want 1", len(tracks))
+	}
+	trk := tracks[0]
+	if len(trk.When) != wantPts || len(trk.Coords) != wantPts {
+		t.Errorf("got %d times and %d coordinates, want %d", len(trk.When), len(trk.Coords), wantPts)
+	}
+	if len(trk.Arrays) != 3 {
+		t.Fatalf("got %d data arrays, want 3", len(trk.Arrays))
+	}
+	for _, a := range trk.Arrays {
+		if len(a.Values) != wantPts {
+			t.Errorf("%s: got %d values, want %d", a.Name, len(a.Values), wantPts)
+		}
+	}
+	if doc.Placemarks[1].Point == nil || doc.Placemarks[1].Name != "Lap 1 start" {
+		t.Errorf("got placemark %q, want Lap 1 start point", doc.Placemarks[1].Name)
+	}
+}
+
+func TestWriteKMLPauses(t *testing.T) {
+	file, err := fit.NewFile(fit.FileTypeActivity, fit.NewHeader(fit.V20, true))
+	if err != nil {
+		t.Fatalf("NewFile: got error, want none; error is: %v", err)
+	}
+	start := time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)
+	for _, ev := range []struct {
+		offset int
+		typ    fit.EventType
+	}{{0, fit.EventTypeStart}, {9, fit.EventTypeStop}, {15, fit.EventTypeStart}} {
+		e := fit.NewEventMsg()
+		e.Timestamp = start.Add(time.Duration(ev.offset) * time.Second)
+		e.Event = fit.EventTimer
+		e.EventType = ev.typ
+		mustAdd(t, file, e)
+	}
+	for i := 0; i < 20; i++ {
+		r := fit.NewRecordMsg()
+		r.Timestamp = start.Add(time.Duration(i) * time.Second)
+		r.PositionLat = fit.NewLatitudeDegrees(59.9 + float64(i)*0.0001)
+		r.PositionLong = fit.NewLongitudeDegrees(10.7)
+		r.Power = uint16(200 + i)
+		mustAdd(t, file, r)
+	}
+
+	_, doc := writeAndParse(t, file)
+	if len(doc.Placemarks) != 1 {
+		t.Fatalf("got %d placemarks, want 1", len(doc.Placemarks))
+	}
+	tracks := doc.Placemarks[0].Tracks
+	if len(tracks) != 2 || len(tracks[0].Coords) != 10 || len(tracks[1].Coords) != 5 {
+		t.Fatalf("got %d tracks, want 2 tracks of 10 and 5 positions", len(tracks))
+	}
+	if got := tracks[1].When[0]; got != "2020-06-01T10:00:15Z" {
+		t.Errorf("second track: got start %q, want %q", got, "2020-06-01T10:00:15Z")
+	}
+	for _, a := range tracks[0].Arrays {
+		switch a.Name {
+		case "power":
+			if a.Values[0] != "200" {
+				t.Errorf("power: got %q, want 200", a.Values[0])
+			}
+		case "heart_rate":
+			if a.Values[0] != "" {
+				t.Errorf("heart_rate: got %q, want empty", a.Values[0])
+			}
+		}
+	}
+}
+
+func TestWriteKMLUnsupported(t *testing.T) {
+	settings, err := fit.NewFile(fit.FileTypeSettings, fit.NewHeader(fit.V20, true))
+	if err != nil {
+		t.Fatalf("NewFile: got error, want none; error is: %v", err)
+	}
+	if err = kml.WriteKML(new(bytes.Buffer), settings); err == nil {
+		t.Errorf("settings file: got no error, want one")
+	}
+}
+
+func mustAdd(t *testing.T, file *fit.File, msg interface{}) {
+	t.Helper()
+	if err := file.Add(msg); err != nil {
+		t.Fatalf("Add: got error, want none; error is: %v", err)
+	}
+}
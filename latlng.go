@@ -16,19 +16,26 @@ var (
 	degToSemiFactor = math.Pow(2, 31) / 180
 )
 
+// degreesToSemicircles returns degrees in semicircles, truncated to a whole
+// number of semicircles. Degrees given with the precision returned by the
+// Degrees methods are converted back to the same semicircles.
+func degreesToSemicircles(degrees float64) float64 {
+	return math.Trunc(degrees * degToSemiFactor)
+}
+
 // Latitude represents the geographical coordinate latitude.
 type Latitude struct {
 	semicircles int32
 }
 
 // NewLatitude returns a new latitude from a semicircle. If semicircles is
-// outside the range of a latitude, (math.MinInt32/2, math.MaxInt32/2) then an
-// invalid latitude is returned.
+// outside the range of a latitude, [-2^30, 2^30] (+/- 90°), then an invalid
+// latitude is returned.
 func NewLatitude(semicircles int32) Latitude {
 	if semicircles == sint32Invalid {
 		return NewLatitudeInvalid()
 	}
-	if semicircles < math.MinInt32/2 || semicircles > math.MaxInt32/2 {
+	if semicircles < -1<<30 || semicircles > 1<<30 {
 		return NewLatitudeInvalid()
 	}
 	return Latitude{semicircles: semicircles}
@@ -38,10 +45,10 @@ func NewLatitude(semicircles int32) Latitude {
 // outside the range of a latitude (+/- 90°) then an invalid latitude is
 // returned.
 func NewLatitudeDegrees(degrees float64) Latitude {
-	if degrees >= 90 || degrees <= -90 {
+	if !(degrees >= -90 && degrees <= 90) {
 		return NewLatitudeInvalid()
 	}
-	return Latitude{semicircles: int32(degrees * degToSemiFactor)}
+	return Latitude{semicircles: int32(degreesToSemicircles(degrees))}
 }
 
 // NewLatitudeInvalid returns an invalid latitude. The underlying storage is
@@ -90,12 +97,17 @@ func NewLongitude(semicircles int32) Longitude {
 
 // NewLongitudeDegrees returns a new longitude from a degree. If degrees is
 // outside the range of a longitude (+/- 180°) then an invalid longitude is
-// returned.
+// returned. The longitudes 180° and -180° are the same meridian, and both
+// are stored as -180°, since 180° can not be represented in semicircles.
 func NewLongitudeDegrees(degrees float64) Longitude {
-	if degrees >= 180 || degrees <= -180 {
-		return Longitude{semicircles: sint32Invalid}
+	if !(degrees >= -180 && degrees <= 180) {
+		return NewLongitudeInvalid()
+	}
+	semi := degreesToSemicircles(degrees)
+	if semi >= 1<<31 {
+		semi -= 1 << 32
 	}
-	return Longitude{semicircles: int32(degrees * degToSemiFactor)}
+	return Longitude{semicircles: int32(semi)}
 }
 
 // NewLongitudeInvalid returns an invalid longitude. The underlying storage is
@@ -62,9 +62,9 @@ var latlngSemiTests = []struct {
 	},
 	{
 		(math.MaxInt32 / 2) + 1,
-		sint32Invalid,
 		(math.MaxInt32 / 2) + 1,
-		"Invalid",
+		(math.MaxInt32 / 2) + 1,
+		"90.00000",
 		"90.00000",
 	},
 	{
@@ -98,9 +98,9 @@ var latlngSemiTests = []struct {
 	},
 	{
 		(math.MaxInt32 / 2) + 1,
-		sint32Invalid,
 		(math.MaxInt32 / 2) + 1,
-		"Invalid",
+		(math.MaxInt32 / 2) + 1,
+		"90.00000",
 		"90.00000",
 	},
 	{
@@ -175,6 +175,20 @@ var latlngDegTests = []struct {
 	{
 		180.00000,
 		sint32Invalid,
+		math.MinInt32,
+		"Invalid",
+		"-180.00000",
+	},
+	{
+		-180.00000,
+		sint32Invalid,
+		math.MinInt32,
+		"Invalid",
+		"-180.00000",
+	},
+	{
+		-180.00001,
+		sint32Invalid,
 		sint32Invalid,
 		"Invalid",
 		"Invalid",
@@ -195,10 +209,17 @@ var latlngDegTests = []struct {
 	},
 	{
 		90.00000,
-		sint32Invalid,
 		1073741824,
-		"Invalid",
+		1073741824,
 		"90.00000",
+		"90.00000",
+	},
+	{
+		-90.00000,
+		-1073741824,
+		-1073741824,
+		"-90.00000",
+		"-90.00000",
 	},
 	{
 		89.99999,
@@ -214,6 +235,13 @@ var latlngDegTests = []struct {
 		"Invalid",
 		"Invalid",
 	},
+	{
+		math.NaN(),
+		sint32Invalid,
+		sint32Invalid,
+		"Invalid",
+		"Invalid",
+	},
 }
 
 func TestLatLngFromDegrees(t *testing.T) {
@@ -235,3 +263,14 @@ func TestLatLngFromDegrees(t *testing.T) {
 		}
 	}
 }
+
+func TestLatLngDegreesRoundTrip(t *testing.T) {
+	for semi := int32(-1 << 30); semi < 1<<30; semi += 65537 {
+		if got := NewLatitudeDegrees(NewLatitude(semi).Degrees()).Semicircles(); got != semi {
+			t.Fatalf("latitude: got %d semicircles, want %d", got, semi)
+		}
+		if got := NewLongitudeDegrees(NewLongitude(2 * semi).Degrees()).Semicircles(); got != 2*semi {
+			t.Fatalf("longitude: got %d semicircles, want %d", got, 2*semi)
+		}
+	}
+}
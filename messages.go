@@ -662,12 +662,117 @@ func NewOhrSettingsMsg() *OhrSettingsMsg {
 
 // TimeInZoneMsg represents the time_in_zone FIT message type.
 type TimeInZoneMsg struct {
+	Timestamp                time.Time
+	ReferenceMesg            MesgNum
+	ReferenceIndex           MessageIndex
+	TimeInHrZone             []uint32
+	TimeInSpeedZone          []uint32
+	TimeInCadenceZone        []uint32
+	TimeInPowerZone          []uint32
+	HrZoneHighBoundary       []uint8
+	SpeedZoneHighBoundary    []uint16
+	CadenceZoneHighBondary   []uint8
+	PowerZoneHighBoundary    []uint16
+	HrCalcType               HrZoneCalc
+	MaxHeartRate             uint8
+	RestingHeartRate         uint8
+	ThresholdHeartRate       uint8
+	PwrCalcType              PwrZoneCalc
+	FunctionalThresholdPower uint16
 }
 
 // NewTimeInZoneMsg returns a time_in_zone FIT message
 // initialized to all-invalid values.
 func NewTimeInZoneMsg() *TimeInZoneMsg {
-	return &TimeInZoneMsg{}
+	return &TimeInZoneMsg{
+		Timestamp:                timeBase,
+		ReferenceMesg:            0xFFFF,
+		ReferenceIndex:           0xFFFF,
+		TimeInHrZone:             nil,
+		TimeInSpeedZone:          nil,
+		TimeInCadenceZone:        nil,
+		TimeInPowerZone:          nil,
+		HrZoneHighBoundary:       nil,
+		SpeedZoneHighBoundary:    nil,
+		CadenceZoneHighBondary:   nil,
+		PowerZoneHighBoundary:    nil,
+		HrCalcType:               0xFF,
+		MaxHeartRate:             0xFF,
+		RestingHeartRate:         0xFF,
+		ThresholdHeartRate:       0xFF,
+		PwrCalcType:              0xFF,
+		FunctionalThresholdPower: 0xFFFF,
+	}
+}
+
+// GetTimeInHrZoneScaled returns TimeInHrZone
+// as a slice with scale and any offset applied to every element.
+// Units: s
+func (x *TimeInZoneMsg) GetTimeInHrZoneScaled() []float64 {
+	if len(x.TimeInHrZone) == 0 {
+		return nil
+	}
+	s := make([]float64, len(x.TimeInHrZone))
+	for i, v := range x.TimeInHrZone {
+		s[i] = float64(v) / 1000
+	}
+	return s
+}
+
+// GetTimeInSpeedZoneScaled returns TimeInSpeedZone
+// as a slice with scale and any offset applied to every element.
+// Units: s
+func (x *TimeInZoneMsg) GetTimeInSpeedZoneScaled() []float64 {
+	if len(x.TimeInSpeedZone) == 0 {
+		return nil
+	}
+	s := make([]float64, len(x.TimeInSpeedZone))
+	for i, v := range x.TimeInSpeedZone {
+		s[i] = float64(v) / 1000
+	}
+	return s
+}
+
+// GetTimeInCadenceZoneScaled returns TimeInCadenceZone
+// as a slice with scale and any offset applied to every element.
+// Units: s
+func (x *TimeInZoneMsg) GetTimeInCadenceZoneScaled() []float64 {
+	if len(x.TimeInCadenceZone) == 0 {
+		return nil
+	}
+	s := make([]float64, len(x.TimeInCadenceZone))
+	for i, v := range x.TimeInCadenceZone {
+		s[i] = float64(v) / 1000
+	}
+	return s
+}
+
+// GetTimeInPowerZoneScaled returns TimeInPowerZone
+// as a slice with scale and any offset applied to every element.
+// Units: s
+func (x *TimeInZoneMsg) GetTimeInPowerZoneScaled() []float64 {
+	if len(x.TimeInPowerZone) == 0 {
+		return nil
+	}
+	s := make([]float64, len(x.TimeInPowerZone))
+	for i, v := range x.TimeInPowerZone {
+		s[i] = float64(v) / 1000
+	}
+	return s
+}
+
+// GetSpeedZoneHighBoundaryScaled returns SpeedZoneHighBoundary
+// as a slice with scale and any offset applied to every element.
+// Units: m/s
+func (x *TimeInZoneMsg) GetSpeedZoneHighBoundaryScaled() []float64 {
+	if len(x.SpeedZoneHighBoundary) == 0 {
+		return nil
+	}
+	s := make([]float64, len(x.SpeedZoneHighBoundary))
+	for i, v := range x.SpeedZoneHighBoundary {
+		s[i] = float64(v) / 1000
+	}
+	return s
 }
 
 // ZonesTargetMsg represents the zones_target FIT message type.
@@ -5587,12 +5692,19 @@ func (x *HrvMsg) GetTimeScaled() []float64 {
 
 // BeatIntervalsMsg represents the beat_intervals FIT message type.
 type BeatIntervalsMsg struct {
+	Timestamp   time.Time
+	TimestampMs uint16   // Milliseconds past date_time
+	Time        []uint16 // Array of millisecond times between beats
 }
 
 // NewBeatIntervalsMsg returns a beat_intervals FIT message
 // initialized to all-invalid values.
 func NewBeatIntervalsMsg() *BeatIntervalsMsg {
-	return &BeatIntervalsMsg{}
+	return &BeatIntervalsMsg{
+		Timestamp:   timeBase,
+		TimestampMs: 0xFFFF,
+		Time:        nil,
+	}
 }
 
 // HrvStatusSummaryMsg represents the hrv_status_summary FIT message type.
@@ -9,6 +9,7 @@ type decodeOptions struct {
 	logger          Logger
 	unknownFields   bool
 	unknownMessages bool
+	messages        map[MesgNum]bool
 }
 
 // DecodeOption configures a decoder.
@@ -47,3 +48,17 @@ func WithUnknownMessages() DecodeOption {
 		o.unknownMessages = true
 	}
 }
+
+// WithMessages configures the decoder to only decode messages with the given
+// message numbers, e.g. MesgNumSession, skipping the data of other messages.
+// This is useful to quickly read a summary of large files. The FileId message
+// is always decoded. Skipped messages are not recorded as unknown messages.
+func WithMessages(mesgNums ...MesgNum) DecodeOption {
+	return func(o *decodeOptions) {
+		o.messages = make(map[MesgNum]bool, len(mesgNums)+1)
+		o.messages[MesgNumFileId] = true
+		for _, mn := range mesgNums {
+			o.messages[mn] = true
+		}
+	}
+}
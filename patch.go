@@ -0,0 +1,252 @@
+package fit
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"time"
+
+	"github.com/tormoder/fit/dyncrc16"
+	"github.com/tormoder/fit/internal/types"
+)
+
+// Patch calls fn for every data message record in the FIT file(s) held in
+// data. Any field modified using the record's Set and SetField methods is
+// written to the record's bytes. Field sizes can't be changed, which means
+// that the layout of the file is kept as-is, including unknown messages,
+// unknown fields and developer data. Only the file CRC is recomputed after
+// all records have been visited.
+//
+// The records passed to fn alias a copy of data. The copy is written back
+// to data only after every file has been patched and its CRC verified. If
+// fn returns an error, or the file is corrupt, Patch returns the error and
+// data is left unchanged.
+//
+// Chained FIT files are patched one after the other.
+func Patch(data []byte, fn func(rec *RawRecord) error) error {
+	patched := append([]byte(nil), data...)
+	for rest := patched; len(rest) > 0; {
+		n, err := patchFile(rest, fn)
+		if err != nil {
+			return err
+		}
+		rest = rest[n:]
+	}
+	copy(data, patched)
+	return nil
+}
+
+func patchFile(data []byte, fn func(rec *RawRecord) error) (int, error) {
+	rr, err := NewRawReader(bytes.NewReader(data))
+	if err != nil {
+		return 0, err
+	}
+
+	for {
+		rec, err := rr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+		if rec.IsDefinition() {
+			continue
+		}
+		rec.Bytes = data[rec.Offset : rec.Offset+int64(len(rec.Bytes))]
+		if err = fn(rec); err != nil {
+			return 0, err
+		}
+	}
+
+	end := int(rr.h.Size) + int(rr.h.DataSize)
+	le.PutUint16(data[end:end+int(bytesForCRC)], dyncrc16.Checksum(data[:end]))
+
+	return end + int(bytesForCRC), nil
+}
+
+// ShiftTimestamps shifts every timestamp in the FIT file(s) held in data by
+// d. Both UTC and local date_time fields in known messages are shifted, in
+// addition to compressed timestamp record headers. Timestamps relative to
+// device power on are left untouched. The shift is applied in place and d
+// must be a whole number of seconds.
+func ShiftTimestamps(data []byte, d time.Duration) error {
+	if d%time.Second != 0 {
+		return fmt.Errorf("timestamp shift must be a whole number of seconds, got %v", d)
+	}
+	secs := int64(d / time.Second)
+
+	return Patch(data, func(rec *RawRecord) error {
+		if rec.IsCompressed() && rec.timestamp >= systemTimeMarker {
+			ts := byte(int64(rec.timestamp)+secs) & compressedTimeMask
+			rec.Bytes[0] = rec.Bytes[0]&^compressedTimeMask | ts
+		}
+		if !knownMsgNums[rec.MesgNum()] {
+			return nil
+		}
+		for _, fd := range rec.Def.Fields {
+			pfield, found := getField(rec.MesgNum(), fd.Num)
+			if !found {
+				continue
+			}
+			kind := pfield.t.Kind()
+			if kind != types.TimeUTC && kind != types.TimeLocal {
+				continue
+			}
+			if fd.Size != 4 {
+				continue
+			}
+			b, _ := rec.Field(fd.Num)
+			u32 := rec.Def.Arch.Uint32(b)
+			if u32 == 0xFFFFFFFF || u32 < systemTimeMarker {
+				continue
+			}
+			rec.Def.Arch.PutUint32(b, uint32(int64(u32)+secs))
+		}
+		return nil
+	})
+}
+
+// Set sets the field with the given name in a data message record. The name
+// is the name of the field in the corresponding message struct, for example
+// "Sport" for SessionMsg.Sport. See SetField for details.
+func (rr *RawRecord) Set(name string, value interface{}) error {
+	num, err := fieldNumByName(rr.MesgNum(), name)
+	if err != nil {
+		return err
+	}
+	return rr.SetField(num, value)
+}
+
+// SetField sets the field with the given field number in a data message
+// record. The field must be present in the record, and value must fit in
+// the size given for the field by the record's definition message.
+//
+// Integer, floating point and string values, including all FIT types (e.g.
+// Sport), as well as time.Time, Latitude and Longitude values are
+// supported. Array fields are not supported.
+func (rr *RawRecord) SetField(num byte, value interface{}) error {
+	if rr.IsDefinition() {
+		return errors.New("can't set field in a definition message record")
+	}
+	b, found := rr.Field(num)
+	if !found {
+		return fmt.Errorf("field %d not present in %v record", num, rr.MesgNum())
+	}
+	_, fd, _ := rr.Def.fieldOffset(num)
+	bt := types.Base(fd.BaseType)
+	if !bt.Known() {
+		return fmt.Errorf("field %d: unknown base type: %v", num, bt)
+	}
+	if bt != types.BaseString && int(fd.Size) != bt.Size() {
+		return fmt.Errorf("field %d: array fields are not supported", num)
+	}
+
+	var pfield *field
+	if knownMsgNums[rr.MesgNum()] {
+		pfield, _ = getField(rr.MesgNum(), num)
+	}
+
+	return putRawValue(b, bt, rr.Def, pfield, value)
+}
+
+func putRawValue(b []byte, bt types.Base, def *RawDefinition, pfield *field, value interface{}) error {
+	switch x := value.(type) {
+	case time.Time:
+		u32 := int64(encodeTime(x))
+		if pfield != nil && pfield.t.Kind() == types.TimeLocal {
+			_, offs := x.Zone()
+			u32 += int64(offs)
+		}
+		return putRawInt(b, bt, def, u32)
+	case Latitude:
+		return putRawInt(b, bt, def, int64(x.Semicircles()))
+	case Longitude:
+		return putRawInt(b, bt, def, int64(x.Semicircles()))
+	case string:
+		if bt != types.BaseString {
+			return fmt.Errorf("can't set %v field to string value", bt)
+		}
+		str, err := encodeString(x, byte(len(b)))
+		if err != nil {
+			return err
+		}
+		copy(b, str)
+		return nil
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uint:
+		u := v.Uint()
+		if u > math.MaxInt64 {
+			return fmt.Errorf("value %d out of range for %v", u, bt)
+		}
+		return putRawInt(b, bt, def, int64(u))
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Int:
+		return putRawInt(b, bt, def, v.Int())
+	case reflect.Float32, reflect.Float64:
+		switch bt {
+		case types.BaseFloat32:
+			def.Arch.PutUint32(b, math.Float32bits(float32(v.Float())))
+		case types.BaseFloat64:
+			def.Arch.PutUint64(b, math.Float64bits(v.Float()))
+		default:
+			return fmt.Errorf("can't set %v field to floating point value", bt)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported value type %T", value)
+	}
+}
+
+func putRawInt(b []byte, bt types.Base, def *RawDefinition, i int64) error {
+	if bt.Float() || bt == types.BaseString {
+		return fmt.Errorf("can't set %v field to integer value", bt)
+	}
+
+	bits := uint(8 * bt.Size())
+	var lo, hi int64
+	if bt.Signed() {
+		lo, hi = -(1 << (bits - 1)), (1<<(bits-1))-1
+	} else {
+		lo, hi = 0, (1<<bits)-1
+		if bits == 64 {
+			hi = math.MaxInt64
+		}
+	}
+	if i < lo || i > hi {
+		return fmt.Errorf("value %d out of range for %v", i, bt)
+	}
+
+	switch bt.Size() {
+	case 1:
+		b[0] = byte(i)
+	case 2:
+		def.Arch.PutUint16(b, uint16(i))
+	case 4:
+		def.Arch.PutUint32(b, uint32(i))
+	case 8:
+		def.Arch.PutUint64(b, uint64(i))
+	}
+	return nil
+}
+
+func fieldNumByName(mn MesgNum, name string) (byte, error) {
+	if !knownMsgNums[mn] || int(mn) >= len(msgsTypes) || msgsTypes[mn] == nil {
+		return 0, fmt.Errorf("unknown message: %v", mn)
+	}
+	sfield, found := msgsTypes[mn].FieldByName(name)
+	if !found {
+		return 0, fmt.Errorf("%v has no field named %q", mn, name)
+	}
+	for _, f := range _fields[mn] {
+		if f != nil && f.sindex == sfield.Index[0] {
+			return f.num, nil
+		}
+	}
+	return 0, fmt.Errorf("%v: no profile field found for %q", mn, name)
+}
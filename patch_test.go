@@ -0,0 +1,191 @@
+package fit_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+func TestPatch(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join(tdfolder, "fitsdk", "Activity.fit"))
+	if err != nil {
+		t.Fatalf("reading file failed: %v", err)
+	}
+	orig := append([]byte(nil), data...)
+
+	const serial = 12345
+	err = fit.Patch(data, func(rec *fit.RawRecord) error {
+		switch rec.MesgNum() {
+		case fit.MesgNumSession:
+			return rec.Set("Sport", fit.SportCycling)
+		case fit.MesgNumFileId:
+			return rec.Set("SerialNumber", uint32(serial))
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("patch: got error, want none; error is: %v", err)
+	}
+	if len(data) != len(orig) {
+		t.Fatalf("patched file size: got %d, want %d", len(data), len(orig))
+	}
+
+	file, err := fit.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("decode patched file: got error, want none; error is: %v", err)
+	}
+	if file.FileId.SerialNumber != serial {
+		t.Errorf("serial number: got %d, want %d", file.FileId.SerialNumber, serial)
+	}
+	activity, err := file.Activity()
+	if err != nil {
+		t.Fatalf("activity: got error, want none; error is: %v", err)
+	}
+	for i, s := range activity.Sessions {
+		if s.Sport != fit.SportCycling {
+			t.Errorf("session %d: sport: got %v, want %v", i, s.Sport, fit.SportCycling)
+		}
+	}
+
+	err = fit.Patch(data, func(rec *fit.RawRecord) error {
+		if rec.MesgNum() == fit.MesgNumFileId {
+			return rec.Set("Product", uint32(1<<20))
+		}
+		return nil
+	})
+	if err == nil {
+		t.Errorf("patch with out of range value: got no error, want one")
+	}
+	if file, err = fit.Decode(bytes.NewReader(data)); err != nil {
+		t.Fatalf("decode after failed patch: got error, want none; error is: %v", err)
+	}
+	if file.FileId.SerialNumber != serial {
+		t.Errorf("failed patch modified data: serial number: got %d, want %d", file.FileId.SerialNumber, serial)
+	}
+}
+
+func TestPatchCorruptCRC(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join(tdfolder, "fitsdk", "Activity.fit"))
+	if err != nil {
+		t.Fatalf("reading file failed: %v", err)
+	}
+	data[len(data)-1] ^= 0xFF
+	orig := append([]byte(nil), data...)
+
+	var patched int
+	err = fit.Patch(data, func(rec *fit.RawRecord) error {
+		if rec.MesgNum() != fit.MesgNumSession {
+			return nil
+		}
+		patched++
+		return rec.Set("Sport", fit.SportCycling)
+	})
+	if err == nil {
+		t.Fatalf("patch with corrupt file CRC: got no error, want one")
+	}
+	if patched == 0 {
+		t.Fatalf("patch with corrupt file CRC: no session record was patched")
+	}
+	if !bytes.Equal(data, orig) {
+		t.Errorf("patch with corrupt file CRC: data was modified")
+	}
+}
+
+func TestShiftTimestamps(t *testing.T) {
+	const shift = 3*time.Hour + 7*time.Second
+
+	tests := []struct {
+		name      string
+		path      string
+		absolute  bool
+		wantShift time.Duration
+	}{
+		{
+			name:      "Activity",
+			path:      filepath.Join(tdfolder, "fitsdk", "Activity.fit"),
+			wantShift: shift,
+		},
+		{
+			// Timestamps are relative to device power on and
+			// should not be shifted.
+			name:      "CompressedRelative",
+			path:      filepath.Join(tdfolder, "python-fitparse", "compressed-speed-distance.fit"),
+			wantShift: 0,
+		},
+		{
+			name:      "CompressedAbsolute",
+			path:      filepath.Join(tdfolder, "python-fitparse", "compressed-speed-distance.fit"),
+			absolute:  true,
+			wantShift: shift,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			data, err := os.ReadFile(test.path)
+			if err != nil {
+				t.Fatalf("reading file failed: %v", err)
+			}
+			if test.absolute {
+				makeTimestampsAbsolute(t, data)
+			}
+			want, err := fit.Decode(bytes.NewReader(data))
+			if err != nil {
+				t.Fatalf("decode: got error, want none; error is: %v", err)
+			}
+
+			if err = fit.ShiftTimestamps(data, shift); err != nil {
+				t.Fatalf("shift: got error, want none; error is: %v", err)
+			}
+
+			got, err := fit.Decode(bytes.NewReader(data))
+			if err != nil {
+				t.Fatalf("decode shifted: got error, want none; error is: %v", err)
+			}
+
+			wantAct, _ := want.Activity()
+			gotAct, _ := got.Activity()
+			if len(gotAct.Records) != len(wantAct.Records) {
+				t.Fatalf("records: got %d, want %d", len(gotAct.Records), len(wantAct.Records))
+			}
+			for i := range gotAct.Records {
+				g, w := gotAct.Records[i].Timestamp, wantAct.Records[i].Timestamp
+				if d := g.Sub(w); d != test.wantShift {
+					t.Fatalf("record %d: timestamp shifted by %v, want %v", i, d, test.wantShift)
+				}
+			}
+		})
+	}
+
+	if err := fit.ShiftTimestamps(nil, time.Millisecond); err == nil {
+		t.Errorf("sub-second shift: got no error, want one")
+	}
+}
+
+// makeTimestampsAbsolute moves every timestamp field in data that is relative
+// to device power on to system time. The lower five bits are kept so that
+// compressed timestamp headers remain valid.
+func makeTimestampsAbsolute(t *testing.T, data []byte) {
+	t.Helper()
+	const systemTimeMarker = 0x10000000
+	err := fit.Patch(data, func(rec *fit.RawRecord) error {
+		b, found := rec.Field(253)
+		if !found || len(b) != 4 {
+			return nil
+		}
+		ts := rec.Def.Arch.Uint32(b)
+		if ts >= systemTimeMarker {
+			return nil
+		}
+		rec.Def.Arch.PutUint32(b, ts+0x20000000)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("patch: got error, want none; error is: %v", err)
+	}
+}
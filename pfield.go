@@ -12,8 +12,29 @@ type field struct {
 	num    byte
 	t      types.Fit
 	length byte
+
+	// Profile field name, scale, offset and units.
+	name   string
+	scale  float64
+	offset float64
+	units  string
+}
+
+// subfield represents a subfield of a dynamic fit message field in the
+// profile subfield lookup table.
+type subfield struct {
+	num byte // Definition number of the main field.
+
+	// Profile subfield name, scale, offset and units.
+	name   string
+	scale  float64
+	offset float64
+	units  string
 }
 
 func (f field) String() string {
-	return fmt.Sprintf("sindex: %d | num: %d | type: %v | length: %v", f.sindex, f.num, f.t, f.length)
+	return fmt.Sprintf(
+		"sindex: %d | num: %d | type: %v | length: %v | name: %s | scale: %v | offset: %v | units: %s",
+		f.sindex, f.num, f.t, f.length, f.name, f.scale, f.offset, f.units,
+	)
 }
@@ -0,0 +1,113 @@
+// Package polyline simplifies tracks of positions and encodes them in the
+// Google encoded polyline format, e.g. for showing activities on maps.
+package polyline
+
+import (
+	"errors"
+	"math"
+	"strings"
+
+	"github.com/tormoder/fit"
+)
+
+// precision is the number of decimal places of encoded coordinates.
+const precision = 1e5
+
+// FromRecords returns the valid positions of the records.
+func FromRecords(recs []*fit.RecordMsg) []fit.Position {
+	var ps []fit.Position
+	for _, r := range recs {
+		p := fit.Position{Lat: r.PositionLat, Long: r.PositionLong}
+		if !p.Invalid() {
+			ps = append(ps, p)
+		}
+	}
+	return ps
+}
+
+// Encode returns positions as an encoded polyline with five decimal places.
+// Invalid positions are skipped.
+func Encode(ps []fit.Position) string {
+	var sb strings.Builder
+	var lastLat, lastLong int64
+	for _, p := range ps {
+		if p.Invalid() {
+			continue
+		}
+		lat := int64(math.Round(p.Lat.Degrees() * precision))
+		long := int64(math.Round(p.Long.Degrees() * precision))
+		encodeValue(&sb, lat-lastLat)
+		encodeValue(&sb, long-lastLong)
+		lastLat, lastLong = lat, long
+	}
+	return sb.String()
+}
+
+func encodeValue(sb *strings.Builder, v int64) {
+	u := uint64(v) << 1
+	if v < 0 {
+		u = ^u
+	}
+	for u >= 0x20 {
+		sb.WriteByte(byte(0x20|u&0x1f) + 63)
+		u >>= 5
+	}
+	sb.WriteByte(byte(u) + 63)
+}
+
+// Decode returns the positions of an encoded polyline with five decimal
+// places. An error is returned if the polyline is malformed or a position is
+// out of range.
+func Decode(s string) ([]fit.Position, error) {
+	var (
+		ps        []fit.Position
+		lat, long int64
+	)
+	for i := 0; i < len(s); {
+		dlat, n, err := decodeValue(s[i:])
+		if err != nil {
+			return nil, err
+		}
+		i += n
+		dlong, n, err := decodeValue(s[i:])
+		if err != nil {
+			return nil, err
+		}
+		i += n
+		lat += dlat
+		long += dlong
+		p := fit.NewPositionDegrees(float64(lat)/precision, float64(long)/precision)
+		if p.Invalid() {
+			return nil, errors.New("polyline position out of range")
+		}
+		ps = append(ps, p)
+	}
+	return ps, nil
+}
+
+func decodeValue(s string) (int64, int, error) {
+	var (
+		u     uint64
+		shift uint
+	)
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c < 63 || c > 127 {
+			return 0, 0, errors.New("invalid polyline character")
+		}
+		if shift > 60 {
+			return 0, 0, errors.New("polyline value overflows")
+		}
+		b := uint64(c - 63)
+		u |= (b & 0x1f) << shift
+		shift += 5
+		if b < 0x20 {
+			v := int64(u >> 1)
+			if u&1 != 0 {
+				v = ^v
+			}
+			return v, i + 1, nil
+		}
+	}
+	return 0, 0, errors.New("truncated polyline")
+}
@@ -0,0 +1,165 @@
+package polyline_test
+
+import (
+	"bytes"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/polyline"
+)
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name      string
+		positions []fit.Position
+		want      string
+	}{
+		{
+			name: "google example",
+			positions: []fit.Position{
+				fit.NewPositionDegrees(38.5, -120.2),
+				fit.NewPositionDegrees(40.7, -120.95),
+				fit.NewPositionDegrees(43.252, -126.453),
+			},
+			want: "_p~iF~ps|U_ulLnnqC_mqNvxq`@",
+		},
+		{
+			name: "invalid skipped",
+			positions: []fit.Position{
+				fit.NewPositionDegrees(38.5, -120.2),
+				{Lat: fit.NewLatitudeInvalid(), Long: fit.NewLongitudeInvalid()},
+			},
+			want: "_p~iF~ps|U",
+		},
+		{
+			name: "empty",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := polyline.Encode(test.positions); got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	got, err := polyline.Decode("_p~iF~ps|U_ulLnnqC_mqNvxq`@")
+	if err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	want := [][2]float64{{38.5, -120.2}, {40.7, -120.95}, {43.252, -126.453}}
+	if len(got) != len(want) {
+		t.Fatalf("got %d positions, want %d", len(got), len(want))
+	}
+	for i, p := range got {
+		if math.Abs(p.Lat.Degrees()-want[i][0]) > 1e-6 || math.Abs(p.Long.Degrees()-want[i][1]) > 1e-6 {
+			t.Errorf("position %d: got %v, want %v", i, p, want[i])
+		}
+	}
+
+	for _, s := range []string{"_p~iF", "_p~iF~ps|", "_p~iF ps|U", "~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~"} {
+		if _, err := polyline.Decode(s); err == nil {
+			t.Errorf("Decode(%q): got no error, want one", s)
+		}
+	}
+}
+
+func TestSimplify(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "testdata", "dcrainmaker", "Edge810-Vector-2013-08-16-15-35-10.fit"))
+	if err != nil {
+		t.Fatalf("reading file failed: %v", err)
+	}
+	file, err := fit.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("decode: got error, want none; error is: %v", err)
+	}
+	activity, err := file.Activity()
+	if err != nil {
+		t.Fatalf("activity: got error, want none; error is: %v", err)
+	}
+	track := polyline.FromRecords(activity.Records)
+	if len(track) < 1000 {
+		t.Fatalf("got %d positions, want more", len(track))
+	}
+
+	const tolerance = 10.0
+	tests := []struct {
+		name     string
+		simplify func([]fit.Position, float64) []fit.Position
+	}{
+		{"ramer-douglas-peucker", polyline.Simplify},
+		{"visvalingam", polyline.SimplifyVisvalingam},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			simple := test.simplify(track, tolerance)
+			if len(simple) < 10 || len(simple) > len(track)/5 {
+				t.Errorf("got %d of %d positions", len(simple), len(track))
+			}
+			if simple[0] != track[0] || simple[len(simple)-1] != track[len(track)-1] {
+				t.Errorf("first or last position not kept")
+			}
+
+			// Every position should be close to the simplified track.
+			worst := 0.0
+			for _, p := range track {
+				min := math.Inf(1)
+				for i := 1; i < len(simple); i++ {
+					min = math.Min(min, p.DistanceToSegment(simple[i-1], simple[i]))
+				}
+				worst = math.Max(worst, min)
+			}
+			if worst > 5*tolerance {
+				t.Errorf("got position %v m from simplified track", worst)
+			}
+
+			decoded, err := polyline.Decode(polyline.Encode(simple))
+			if err != nil {
+				t.Fatalf("decode: got error, want none; error is: %v", err)
+			}
+			if len(decoded) != len(simple) {
+				t.Fatalf("round trip: got %d positions, want %d", len(decoded), len(simple))
+			}
+			for i := range decoded {
+				if d := decoded[i].Distance(simple[i]); d > 1 {
+					t.Errorf("round trip: position %d moved %v m", i, d)
+				}
+			}
+		})
+	}
+}
+
+func TestSimplifyLine(t *testing.T) {
+	// Positions along the equator with small deviations and one spike.
+	var ps []fit.Position
+	for i := 0; i <= 100; i++ {
+		lat := 0.00001 * float64(i%2)
+		if i == 50 {
+			lat = 0.01
+		}
+		ps = append(ps, fit.NewPositionDegrees(lat, float64(i)*0.001))
+	}
+	for _, simplify := range []func([]fit.Position, float64) []fit.Position{polyline.Simplify, polyline.SimplifyVisvalingam} {
+		// The ends, the spike and its base, and for Visvalingam possibly
+		// one more position on each side, since the areas of triangles
+		// along long segments are large even for small deviations.
+		got := simplify(ps, 20)
+		if len(got) < 5 || len(got) > 7 {
+			t.Errorf("got %d positions, want the ends and the spike: %v", len(got), got)
+		}
+		found := false
+		for _, p := range got {
+			if p == ps[50] {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("spike removed: got %v", got)
+		}
+	}
+}
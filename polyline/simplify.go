@@ -0,0 +1,161 @@
+package polyline
+
+import (
+	"container/heap"
+	"math"
+
+	"github.com/tormoder/fit"
+)
+
+// Simplify returns the positions simplified with the Ramer-Douglas-Peucker
+// algorithm: positions closer than tolerance meters to the simplified track
+// are removed. The first and last positions are always kept. Invalid
+// positions are skipped.
+func Simplify(ps []fit.Position, tolerance float64) []fit.Position {
+	ps = valid(ps)
+	if len(ps) < 3 {
+		return ps
+	}
+	keep := make([]bool, len(ps))
+	keep[0], keep[len(ps)-1] = true, true
+
+	type span struct{ first, last int }
+	stack := []span{{0, len(ps) - 1}}
+	for len(stack) > 0 {
+		s := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		max, index := 0.0, -1
+		for i := s.first + 1; i < s.last; i++ {
+			if d := ps[i].DistanceToSegment(ps[s.first], ps[s.last]); d > max {
+				max, index = d, i
+			}
+		}
+		if index < 0 || max <= tolerance {
+			continue
+		}
+		keep[index] = true
+		stack = append(stack, span{s.first, index}, span{index, s.last})
+	}
+
+	var out []fit.Position
+	for i, p := range ps {
+		if keep[i] {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// SimplifyVisvalingam returns the positions simplified with the
+// Visvalingam-Whyatt algorithm: the position forming the triangle with the
+// smallest area with its neighbours is removed until all triangles have an
+// area of at least tolerance squared, in square meters. It tends to give
+// smoother results than Simplify. The first and last positions are always
+// kept. Invalid positions are skipped.
+func SimplifyVisvalingam(ps []fit.Position, tolerance float64) []fit.Position {
+	ps = valid(ps)
+	if len(ps) < 3 {
+		return ps
+	}
+	minArea := tolerance * tolerance
+
+	n := len(ps)
+	prev := make([]int, n)
+	next := make([]int, n)
+	items := make([]*vertex, n)
+	h := make(vertexHeap, 0, n-2)
+	for i := range ps {
+		prev[i], next[i] = i-1, i+1
+		if i == 0 || i == n-1 {
+			continue
+		}
+		items[i] = &vertex{index: i, area: area(ps[i-1], ps[i], ps[i+1]), heapIndex: len(h)}
+		h = append(h, items[i])
+	}
+	heap.Init(&h)
+
+	removed := make([]bool, n)
+	for h.Len() > 0 && h[0].area < minArea {
+		v := heap.Pop(&h).(*vertex)
+		removed[v.index] = true
+		p, q := prev[v.index], next[v.index]
+		next[p], prev[q] = q, p
+
+		// The area of removed vertices never decreases the area of the
+		// neighbours below it, so that they are not removed before it.
+		for _, i := range []int{p, q} {
+			if items[i] == nil {
+				continue
+			}
+			a := math.Max(area(ps[prev[i]], ps[i], ps[next[i]]), v.area)
+			items[i].area = a
+			heap.Fix(&h, items[i].heapIndex)
+		}
+	}
+
+	var out []fit.Position
+	for i, p := range ps {
+		if !removed[i] {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+func valid(ps []fit.Position) []fit.Position {
+	out := make([]fit.Position, 0, len(ps))
+	for _, p := range ps {
+		if !p.Invalid() {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// area returns the area in square meters of the triangle abc, in a local
+// projection centered at b.
+func area(a, b, c fit.Position) float64 {
+	ax, ay := project(a, b)
+	cx, cy := project(c, b)
+	return math.Abs(ax*cy-cx*ay) / 2
+}
+
+// project returns the position p in meters east and north of origin, using
+// an equirectangular projection.
+func project(p, origin fit.Position) (x, y float64) {
+	const metersPerDegree = 6371008.8 * math.Pi / 180
+	dLong := math.Mod(p.Long.Degrees()-origin.Long.Degrees()+540, 360) - 180
+	x = dLong * metersPerDegree * math.Cos(origin.Lat.Degrees()*math.Pi/180)
+	y = (p.Lat.Degrees() - origin.Lat.Degrees()) * metersPerDegree
+	return x, y
+}
+
+type vertex struct {
+	index     int
+	area      float64
+	heapIndex int
+}
+
+type vertexHeap []*vertex
+
+func (h vertexHeap) Len() int           { return len(h) }
+func (h vertexHeap) Less(i, j int) bool { return h[i].area < h[j].area }
+
+func (h vertexHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].heapIndex = i
+	h[j].heapIndex = j
+}
+
+func (h *vertexHeap) Push(x interface{}) {
+	v := x.(*vertex)
+	v.heapIndex = len(*h)
+	*h = append(*h, v)
+}
+
+func (h *vertexHeap) Pop() interface{} {
+	old := *h
+	v := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return v
+}
@@ -0,0 +1,75 @@
+package power
+
+import (
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/zones"
+)
+
+// DefaultDurations are commonly used durations for the power curve.
+var DefaultDurations = []time.Duration{
+	1 * time.Second,
+	5 * time.Second,
+	10 * time.Second,
+	30 * time.Second,
+	1 * time.Minute,
+	2 * time.Minute,
+	5 * time.Minute,
+	10 * time.Minute,
+	20 * time.Minute,
+	30 * time.Minute,
+	1 * time.Hour,
+}
+
+// CurvePoint is the mean-maximal power for a duration.
+type CurvePoint struct {
+	Duration time.Duration
+	Watts    float64
+
+	// Start is the time of the first sample of the best effort.
+	Start time.Time
+}
+
+// Curve returns the mean-maximal power curve of the series: the highest
+// average power sustained for each of the given durations. Durations longer
+// than the series, or shorter than one second, are omitted.
+func (s *Series) Curve(durations []time.Duration) []CurvePoint {
+	prefix := make([]float64, len(s.Watts)+1)
+	for i, w := range s.Watts {
+		prefix[i+1] = prefix[i] + w
+	}
+
+	var curve []CurvePoint
+	for _, d := range durations {
+		n := int(d / time.Second)
+		if n < 1 || n > len(s.Watts) {
+			continue
+		}
+		best, start := -1.0, 0
+		for i := 0; i+n <= len(s.Watts); i++ {
+			if sum := prefix[i+n] - prefix[i]; sum > best {
+				best, start = sum, i
+			}
+		}
+		curve = append(curve, CurvePoint{
+			Duration: d,
+			Watts:    best / float64(n),
+			Start:    s.Times[start],
+		})
+	}
+	return curve
+}
+
+// TimeInZones returns the time spent in each of the power zones, as
+// computed by package zones. The zones must be sorted by high value, and
+// zones with an invalid high value are left out. The returned slice has one
+// more element than the valid zones, for the time above the highest zone.
+func (s *Series) TimeInZones(pzs []*fit.PowerZoneMsg) []time.Duration {
+	bounds := zones.FromSport(&fit.SportFile{PowerZones: pzs}).Power
+	times := make([]time.Duration, len(bounds)+1)
+	for _, w := range s.Watts {
+		times[zones.Zone(bounds, w)] += time.Second
+	}
+	return times
+}
@@ -0,0 +1,210 @@
+// Package power implements power analytics for activities: normalized power,
+// intensity factor, training stress score, the mean-maximal power curve and
+// time in power zones.
+package power
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/internal/activity"
+)
+
+// maxFill is the longest gap between records that is filled by repeating the
+// power of the previous record. Longer gaps are treated as pauses.
+const maxFill = 10 * time.Second
+
+// npWindow is the length of the rolling average used for normalized power.
+const npWindow = 30
+
+// ErrNoPower is returned when there is no power data to analyze.
+var ErrNoPower = errors.New("no power data")
+
+// Series is power data resampled to one sample per second.
+type Series struct {
+	// Times and Watts hold the time and power of every sample. Samples are
+	// one second apart, except at pauses.
+	Times []time.Time
+	Watts []float64
+}
+
+// NewSeries returns the power of the records resampled to one sample per
+// second. Records while the timer was stopped, according to the timer
+// events, are skipped. Records without power are treated as zero power.
+// Gaps of up to 10 seconds between records are filled with the power of the
+// previous record; longer gaps are treated as pauses. Records must be sorted
+// by time.
+func NewSeries(recs []*fit.RecordMsg, events []*fit.EventMsg) (*Series, error) {
+	timer := activity.TimerPeriods(events)
+
+	s := new(Series)
+	valid := false
+	var prev *fit.RecordMsg
+	for _, r := range recs {
+		if !timer.Running(r.Timestamp) {
+			prev = nil
+			continue
+		}
+		w := 0.0
+		if r.Power != 0xFFFF {
+			w, valid = float64(r.Power), true
+		}
+		if prev != nil {
+			gap := r.Timestamp.Sub(prev.Timestamp)
+			if gap <= 0 {
+				continue
+			}
+			if gap <= maxFill {
+				last := s.Watts[len(s.Watts)-1]
+				for t := prev.Timestamp.Add(time.Second); t.Before(r.Timestamp); t = t.Add(time.Second) {
+					s.Times = append(s.Times, t)
+					s.Watts = append(s.Watts, last)
+				}
+			}
+		}
+		s.Times = append(s.Times, r.Timestamp)
+		s.Watts = append(s.Watts, w)
+		prev = r
+	}
+	if !valid {
+		return nil, ErrNoPower
+	}
+	return s, nil
+}
+
+// Duration returns the duration of the series, one second per sample.
+func (s *Series) Duration() time.Duration {
+	return time.Duration(len(s.Watts)) * time.Second
+}
+
+// AvgPower returns the average power of the series.
+func (s *Series) AvgPower() float64 {
+	if len(s.Watts) == 0 {
+		return math.NaN()
+	}
+	sum := 0.0
+	for _, w := range s.Watts {
+		sum += w
+	}
+	return sum / float64(len(s.Watts))
+}
+
+// NormalizedPower returns the normalized power of the series: the fourth
+// root of the mean of the fourth powers of the 30 second rolling average of
+// the power. NaN is returned if the series is shorter than 30 seconds.
+func (s *Series) NormalizedPower() float64 {
+	if len(s.Watts) < npWindow {
+		return math.NaN()
+	}
+	var sum, sum4 float64
+	for i, w := range s.Watts {
+		sum += w
+		if i >= npWindow {
+			sum -= s.Watts[i-npWindow]
+		}
+		if i >= npWindow-1 {
+			avg := sum / npWindow
+			sum4 += avg * avg * avg * avg
+		}
+	}
+	return math.Pow(sum4/float64(len(s.Watts)-npWindow+1), 0.25)
+}
+
+// Stress holds the training stress of a series for a given functional
+// threshold power (FTP).
+type Stress struct {
+	FTP                 float64
+	NormalizedPower     float64
+	IntensityFactor     float64
+	TrainingStressScore float64
+}
+
+// Stress returns the intensity factor and training stress score of the
+// series for the given FTP in watts.
+func (s *Series) Stress(ftp float64) Stress {
+	np := s.NormalizedPower()
+	intensity := np / ftp
+	return Stress{
+		FTP:                 ftp,
+		NormalizedPower:     np,
+		IntensityFactor:     intensity,
+		TrainingStressScore: s.Duration().Seconds() * np * intensity / (ftp * 3600) * 100,
+	}
+}
+
+// UpdateSession sets the normalized power, intensity factor, training stress
+// score and threshold power of the session. Values that are NaN are left
+// unchanged.
+func (st Stress) UpdateSession(s *fit.SessionMsg) {
+	setScaled(&s.NormalizedPower, st.NormalizedPower, 1)
+	setScaled(&s.IntensityFactor, st.IntensityFactor, 1000)
+	setScaled(&s.TrainingStressScore, st.TrainingStressScore, 10)
+	setScaled(&s.ThresholdPower, st.FTP, 1)
+}
+
+func setScaled(field *uint16, v, scale float64) {
+	if raw := activity.Raw(v, scale, 0, 0xFFFF); raw != 0xFFFF {
+		*field = uint16(raw)
+	}
+}
+
+// FTP returns the functional threshold power of the activity, from the
+// threshold power of the first session that has one, or from the zones
+// target messages. It reports false if the activity has no threshold power.
+func FTP(a *fit.ActivityFile) (float64, bool) {
+	for _, s := range a.Sessions {
+		if s.ThresholdPower != 0xFFFF && s.ThresholdPower != 0 {
+			return float64(s.ThresholdPower), true
+		}
+	}
+	for _, zt := range a.ZoneTargets {
+		if zt.FunctionalThresholdPower != 0xFFFF && zt.FunctionalThresholdPower != 0 {
+			return float64(zt.FunctionalThresholdPower), true
+		}
+	}
+	return 0, false
+}
+
+// UpdateSessions computes the training stress of every session of the
+// activity and writes it back to the session. If ftp is zero, the FTP of the
+// activity is used. An error is returned if there is no FTP, or if the
+// activity has no power data. Sessions without power data are left
+// unchanged.
+func UpdateSessions(a *fit.ActivityFile, ftp float64) error {
+	if ftp == 0 {
+		var found bool
+		if ftp, found = FTP(a); !found {
+			return errors.New("no functional threshold power")
+		}
+	}
+	updated := false
+	for _, s := range a.Sessions {
+		series, err := NewSeries(sessionRecords(a.Records, s), a.Events)
+		if errors.Is(err, ErrNoPower) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		series.Stress(ftp).UpdateSession(s)
+		updated = true
+	}
+	if !updated {
+		return ErrNoPower
+	}
+	return nil
+}
+
+// sessionRecords returns the records within the session.
+func sessionRecords(recs []*fit.RecordMsg, s *fit.SessionMsg) []*fit.RecordMsg {
+	end := activity.SessionEnd(s)
+	var out []*fit.RecordMsg
+	for _, r := range recs {
+		if !r.Timestamp.Before(s.StartTime) && !r.Timestamp.After(end) {
+			out = append(out, r)
+		}
+	}
+	return out
+}
@@ -0,0 +1,182 @@
+package power_test
+
+import (
+	"bytes"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/power"
+)
+
+func TestEdge810(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "testdata", "dcrainmaker", "Edge810-Vector-2013-08-16-15-35-10.fit"))
+	if err != nil {
+		t.Fatalf("reading file failed: %v", err)
+	}
+	file, err := fit.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("decode: got error, want none; error is: %v", err)
+	}
+	activity, err := file.Activity()
+	if err != nil {
+		t.Fatalf("activity: got error, want none; error is: %v", err)
+	}
+	device := *activity.Sessions[0]
+
+	series, err := power.NewSeries(activity.Records, activity.Events)
+	if err != nil {
+		t.Fatalf("NewSeries: got error, want none; error is: %v", err)
+	}
+	if np := series.NormalizedPower(); math.Abs(np-float64(device.NormalizedPower)) > 1 {
+		t.Errorf("normalized power: got %v, device reported %d", np, device.NormalizedPower)
+	}
+
+	ftp, ok := power.FTP(activity)
+	if !ok || ftp != float64(device.ThresholdPower) {
+		t.Fatalf("FTP: got %v, %t, want %d", ftp, ok, device.ThresholdPower)
+	}
+	if err := power.UpdateSessions(activity, 0); err != nil {
+		t.Fatalf("UpdateSessions: got error, want none; error is: %v", err)
+	}
+	s := activity.Sessions[0]
+	if d := math.Abs(s.GetIntensityFactorScaled() - device.GetIntensityFactorScaled()); d > 0.01 {
+		t.Errorf("intensity factor: got %v, device reported %v", s.GetIntensityFactorScaled(), device.GetIntensityFactorScaled())
+	}
+	if d := math.Abs(s.GetTrainingStressScoreScaled() - device.GetTrainingStressScoreScaled()); d > 0.05*device.GetTrainingStressScoreScaled() {
+		t.Errorf("training stress score: got %v, device reported %v", s.GetTrainingStressScoreScaled(), device.GetTrainingStressScoreScaled())
+	}
+
+	curve := series.Curve(power.DefaultDurations)
+	if len(curve) != len(power.DefaultDurations) {
+		t.Fatalf("got %d curve points, want %d", len(curve), len(power.DefaultDurations))
+	}
+	if curve[0].Watts != float64(device.MaxPower) {
+		t.Errorf("1s power: got %v, want max power %d", curve[0].Watts, device.MaxPower)
+	}
+	for i := 1; i < len(curve); i++ {
+		if curve[i].Watts > curve[i-1].Watts {
+			t.Errorf("curve not decreasing: %v for %v after %v for %v",
+				curve[i].Watts, curve[i].Duration, curve[i-1].Watts, curve[i-1].Duration)
+		}
+	}
+}
+
+func TestSeries(t *testing.T) {
+	start := time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)
+	at := func(secs int) time.Time { return start.Add(time.Duration(secs) * time.Second) }
+
+	// One hour at 200 W with a record every other second, followed by a
+	// 5 minute pause and one minute at 400 W.
+	var recs []*fit.RecordMsg
+	add := func(secs int, watts uint16) {
+		r := fit.NewRecordMsg()
+		r.Timestamp = at(secs)
+		r.Power = watts
+		recs = append(recs, r)
+	}
+	for i := 0; i < 3600; i += 2 {
+		add(i, 200)
+	}
+	for i := 3900; i < 3960; i++ {
+		add(i, 400)
+	}
+	var events []*fit.EventMsg
+	for _, ev := range []struct {
+		secs int
+		typ  fit.EventType
+	}{{0, fit.EventTypeStart}, {3599, fit.EventTypeStop}, {3900, fit.EventTypeStart}, {3960, fit.EventTypeStopAll}} {
+		e := fit.NewEventMsg()
+		e.Timestamp = at(ev.secs)
+		e.Event = fit.EventTimer
+		e.EventType = ev.typ
+		events = append(events, e)
+	}
+
+	series, err := power.NewSeries(recs, events)
+	if err != nil {
+		t.Fatalf("NewSeries: got error, want none; error is: %v", err)
+	}
+	if got, want := series.Duration(), 3659*time.Second; got != want {
+		t.Errorf("duration: got %v, want %v", got, want)
+	}
+
+	stress := series.Stress(250)
+	if stress.NormalizedPower <= 200 || stress.NormalizedPower >= 400 {
+		t.Errorf("normalized power: got %v, want between 200 and 400", stress.NormalizedPower)
+	}
+	if want := stress.NormalizedPower / 250; stress.IntensityFactor != want {
+		t.Errorf("intensity factor: got %v, want %v", stress.IntensityFactor, want)
+	}
+
+	curve := series.Curve([]time.Duration{time.Minute, 2 * time.Minute, 2 * time.Hour})
+	if len(curve) != 2 {
+		t.Fatalf("got %d curve points, want 2", len(curve))
+	}
+	if curve[0].Watts != 400 || !curve[0].Start.Equal(at(3900)) {
+		t.Errorf("1m: got %v W from %v, want 400 W from %v", curve[0].Watts, curve[0].Start, at(3900))
+	}
+	if curve[1].Watts != 300 {
+		t.Errorf("2m: got %v W, want 300 W", curve[1].Watts)
+	}
+
+	zones := []*fit.PowerZoneMsg{{HighValue: 150}, {HighValue: 200}, {HighValue: 300}, {HighValue: 0xFFFF}}
+	got := series.TimeInZones(zones)
+	want := []time.Duration{0, 3599 * time.Second, 0, time.Minute}
+	if len(got) != len(want) {
+		t.Fatalf("got %d zones, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("zone %d: got %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestConstantPower(t *testing.T) {
+	start := time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)
+	var recs []*fit.RecordMsg
+	for i := 0; i < 7200; i++ {
+		r := fit.NewRecordMsg()
+		r.Timestamp = start.Add(time.Duration(i) * time.Second)
+		r.Power = 225
+		recs = append(recs, r)
+	}
+	series, err := power.NewSeries(recs, nil)
+	if err != nil {
+		t.Fatalf("NewSeries: got error, want none; error is: %v", err)
+	}
+	stress := series.Stress(300)
+	if math.Abs(stress.NormalizedPower-225) > 1e-9 {
+		t.Errorf("normalized power: got %v, want 225", stress.NormalizedPower)
+	}
+	if math.Abs(stress.IntensityFactor-0.75) > 1e-9 {
+		t.Errorf("intensity factor: got %v, want 0.75", stress.IntensityFactor)
+	}
+	// Two hours at an intensity factor of 0.75 is 2 * 100 * 0.75^2.
+	if math.Abs(stress.TrainingStressScore-112.5) > 1e-6 {
+		t.Errorf("training stress score: got %v, want 112.5", stress.TrainingStressScore)
+	}
+
+	s := fit.NewSessionMsg()
+	stress.UpdateSession(s)
+	if s.NormalizedPower != 225 || s.IntensityFactor != 750 || s.TrainingStressScore != 1125 || s.ThresholdPower != 300 {
+		t.Errorf("session: got normalized power %d, intensity factor %d, training stress score %d and threshold power %d",
+			s.NormalizedPower, s.IntensityFactor, s.TrainingStressScore, s.ThresholdPower)
+	}
+}
+
+func TestNoPower(t *testing.T) {
+	r := fit.NewRecordMsg()
+	r.Timestamp = time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)
+	if _, err := power.NewSeries([]*fit.RecordMsg{r}, nil); err != power.ErrNoPower {
+		t.Errorf("got error %v, want %v", err, power.ErrNoPower)
+	}
+	a := &fit.ActivityFile{Records: []*fit.RecordMsg{r}}
+	if err := power.UpdateSessions(a, 0); err == nil {
+		t.Errorf("UpdateSessions without FTP: got no error, want one")
+	}
+}
@@ -132,238 +132,256 @@ var (
 // field 255 (localMesgNumInvalid) will return nil.
 var _fields = [...][256]*field{
 	MesgNumFileId: {
-		0: {0, 0, types.Fit(0), 1},
-		1: {1, 1, types.Fit(4), 1},
-		2: {2, 2, types.Fit(4), 1},
-		3: {3, 3, types.Fit(12), 1},
-		4: {4, 4, types.Fit(70), 1},
-		5: {5, 5, types.Fit(4), 1},
-		8: {6, 8, types.Fit(7), 20},
+		0: {0, 0, types.Fit(0), 1, "type", 1, 0, ""},
+		1: {1, 1, types.Fit(4), 1, "manufacturer", 1, 0, ""},
+		2: {2, 2, types.Fit(4), 1, "product", 1, 0, ""},
+		3: {3, 3, types.Fit(12), 1, "serial_number", 1, 0, ""},
+		4: {4, 4, types.Fit(70), 1, "time_created", 1, 0, ""},
+		5: {5, 5, types.Fit(4), 1, "number", 1, 0, ""},
+		8: {6, 8, types.Fit(7), 20, "product_name", 1, 0, ""},
 	},
 
 	MesgNumFileCreator: {
-		0: {0, 0, types.Fit(4), 1},
-		1: {1, 1, types.Fit(2), 1},
+		0: {0, 0, types.Fit(4), 1, "software_version", 1, 0, ""},
+		1: {1, 1, types.Fit(2), 1, "hardware_version", 1, 0, ""},
 	},
 
 	MesgNumTimestampCorrelation: {},
 
 	MesgNumSoftware: {
-		254: {0, 254, types.Fit(4), 1},
-		3:   {1, 3, types.Fit(4), 1},
-		5:   {2, 5, types.Fit(7), 16},
+		254: {0, 254, types.Fit(4), 1, "message_index", 1, 0, ""},
+		3:   {1, 3, types.Fit(4), 1, "version", 100, 0, ""},
+		5:   {2, 5, types.Fit(7), 16, "part_number", 1, 0, ""},
 	},
 
 	MesgNumSlaveDevice: {
-		0: {0, 0, types.Fit(4), 1},
-		1: {1, 1, types.Fit(4), 1},
+		0: {0, 0, types.Fit(4), 1, "manufacturer", 1, 0, ""},
+		1: {1, 1, types.Fit(4), 1, "product", 1, 0, ""},
 	},
 
 	MesgNumCapabilities: {
-		0:  {0, 0, types.Fit(42), 4},
-		1:  {1, 1, types.Fit(42), 1},
-		21: {2, 21, types.Fit(12), 1},
-		23: {3, 23, types.Fit(12), 1},
+		0:  {0, 0, types.Fit(42), 4, "languages", 1, 0, ""},
+		1:  {1, 1, types.Fit(42), 1, "sports", 1, 0, ""},
+		21: {2, 21, types.Fit(12), 1, "workouts_supported", 1, 0, ""},
+		23: {3, 23, types.Fit(12), 1, "connectivity_supported", 1, 0, ""},
 	},
 
 	MesgNumFileCapabilities: {
-		254: {0, 254, types.Fit(4), 1},
-		0:   {1, 0, types.Fit(0), 1},
-		1:   {2, 1, types.Fit(10), 1},
-		2:   {3, 2, types.Fit(7), 16},
-		3:   {4, 3, types.Fit(4), 1},
-		4:   {5, 4, types.Fit(6), 1},
+		254: {0, 254, types.Fit(4), 1, "message_index", 1, 0, ""},
+		0:   {1, 0, types.Fit(0), 1, "type", 1, 0, ""},
+		1:   {2, 1, types.Fit(10), 1, "flags", 1, 0, ""},
+		2:   {3, 2, types.Fit(7), 16, "directory", 1, 0, ""},
+		3:   {4, 3, types.Fit(4), 1, "max_count", 1, 0, ""},
+		4:   {5, 4, types.Fit(6), 1, "max_size", 1, 0, "bytes"},
 	},
 
 	MesgNumMesgCapabilities: {
-		254: {0, 254, types.Fit(4), 1},
-		0:   {1, 0, types.Fit(0), 1},
-		1:   {2, 1, types.Fit(4), 1},
-		2:   {3, 2, types.Fit(0), 1},
-		3:   {4, 3, types.Fit(4), 1},
+		254: {0, 254, types.Fit(4), 1, "message_index", 1, 0, ""},
+		0:   {1, 0, types.Fit(0), 1, "file", 1, 0, ""},
+		1:   {2, 1, types.Fit(4), 1, "mesg_num", 1, 0, ""},
+		2:   {3, 2, types.Fit(0), 1, "count_type", 1, 0, ""},
+		3:   {4, 3, types.Fit(4), 1, "count", 1, 0, ""},
 	},
 
 	MesgNumFieldCapabilities: {
-		254: {0, 254, types.Fit(4), 1},
-		0:   {1, 0, types.Fit(0), 1},
-		1:   {2, 1, types.Fit(4), 1},
-		2:   {3, 2, types.Fit(2), 1},
-		3:   {4, 3, types.Fit(4), 1},
+		254: {0, 254, types.Fit(4), 1, "message_index", 1, 0, ""},
+		0:   {1, 0, types.Fit(0), 1, "file", 1, 0, ""},
+		1:   {2, 1, types.Fit(4), 1, "mesg_num", 1, 0, ""},
+		2:   {3, 2, types.Fit(2), 1, "field_num", 1, 0, ""},
+		3:   {4, 3, types.Fit(4), 1, "count", 1, 0, ""},
 	},
 
 	MesgNumDeviceSettings: {
-		0:   {0, 0, types.Fit(2), 1},
-		1:   {1, 1, types.Fit(6), 1},
-		2:   {2, 2, types.Fit(38), 2},
-		4:   {3, 4, types.Fit(32), 2},
-		5:   {4, 5, types.Fit(33), 2},
-		12:  {5, 12, types.Fit(0), 1},
-		36:  {6, 36, types.Fit(0), 1},
-		39:  {7, 39, types.Fit(70), 1},
-		40:  {8, 40, types.Fit(36), 1},
-		46:  {9, 46, types.Fit(0), 1},
-		47:  {10, 47, types.Fit(0), 1},
-		55:  {11, 55, types.Fit(0), 1},
-		56:  {12, 56, types.Fit(0), 1},
-		57:  {13, 57, types.Fit(36), 1},
-		58:  {14, 58, types.Fit(4), 1},
-		59:  {15, 59, types.Fit(4), 1},
-		174: {16, 174, types.Fit(0), 1},
+		0:   {0, 0, types.Fit(2), 1, "active_time_zone", 1, 0, ""},
+		1:   {1, 1, types.Fit(6), 1, "utc_offset", 1, 0, ""},
+		2:   {2, 2, types.Fit(38), 2, "time_offset", 1, 0, "s"},
+		4:   {3, 4, types.Fit(32), 2, "time_mode", 1, 0, ""},
+		5:   {4, 5, types.Fit(33), 2, "time_zone_offset", 4, 0, "hr"},
+		12:  {5, 12, types.Fit(0), 1, "backlight_mode", 1, 0, ""},
+		36:  {6, 36, types.Fit(0), 1, "activity_tracker_enabled", 1, 0, ""},
+		39:  {7, 39, types.Fit(70), 1, "clock_time", 1, 0, ""},
+		40:  {8, 40, types.Fit(36), 1, "pages_enabled", 1, 0, ""},
+		46:  {9, 46, types.Fit(0), 1, "move_alert_enabled", 1, 0, ""},
+		47:  {10, 47, types.Fit(0), 1, "date_mode", 1, 0, ""},
+		55:  {11, 55, types.Fit(0), 1, "display_orientation", 1, 0, ""},
+		56:  {12, 56, types.Fit(0), 1, "mounting_side", 1, 0, ""},
+		57:  {13, 57, types.Fit(36), 1, "default_page", 1, 0, ""},
+		58:  {14, 58, types.Fit(4), 1, "autosync_min_steps", 1, 0, "steps"},
+		59:  {15, 59, types.Fit(4), 1, "autosync_min_time", 1, 0, "minutes"},
+		174: {16, 174, types.Fit(0), 1, "tap_sensitivity", 1, 0, ""},
 	},
 
 	MesgNumUserProfile: {
-		254: {0, 254, types.Fit(4), 1},
-		0:   {1, 0, types.Fit(7), 16},
-		1:   {2, 1, types.Fit(0), 1},
-		2:   {3, 2, types.Fit(2), 1},
-		3:   {4, 3, types.Fit(2), 1},
-		4:   {5, 4, types.Fit(4), 1},
-		5:   {6, 5, types.Fit(0), 1},
-		6:   {7, 6, types.Fit(0), 1},
-		7:   {8, 7, types.Fit(0), 1},
-		8:   {9, 8, types.Fit(2), 1},
-		9:   {10, 9, types.Fit(2), 1},
-		10:  {11, 10, types.Fit(2), 1},
-		11:  {12, 11, types.Fit(2), 1},
-		12:  {13, 12, types.Fit(0), 1},
-		13:  {14, 13, types.Fit(0), 1},
-		14:  {15, 14, types.Fit(0), 1},
-		16:  {16, 16, types.Fit(0), 1},
-		17:  {17, 17, types.Fit(0), 1},
-		18:  {18, 18, types.Fit(0), 1},
-		21:  {19, 21, types.Fit(0), 1},
-		22:  {20, 22, types.Fit(4), 1},
-		23:  {21, 23, types.Fit(45), 6},
-		30:  {22, 30, types.Fit(0), 1},
-		31:  {23, 31, types.Fit(4), 1},
-		32:  {24, 32, types.Fit(4), 1},
+		254: {0, 254, types.Fit(4), 1, "message_index", 1, 0, ""},
+		0:   {1, 0, types.Fit(7), 16, "friendly_name", 1, 0, ""},
+		1:   {2, 1, types.Fit(0), 1, "gender", 1, 0, ""},
+		2:   {3, 2, types.Fit(2), 1, "age", 1, 0, "years"},
+		3:   {4, 3, types.Fit(2), 1, "height", 100, 0, "m"},
+		4:   {5, 4, types.Fit(4), 1, "weight", 10, 0, "kg"},
+		5:   {6, 5, types.Fit(0), 1, "language", 1, 0, ""},
+		6:   {7, 6, types.Fit(0), 1, "elev_setting", 1, 0, ""},
+		7:   {8, 7, types.Fit(0), 1, "weight_setting", 1, 0, ""},
+		8:   {9, 8, types.Fit(2), 1, "resting_heart_rate", 1, 0, "bpm"},
+		9:   {10, 9, types.Fit(2), 1, "default_max_running_heart_rate", 1, 0, "bpm"},
+		10:  {11, 10, types.Fit(2), 1, "default_max_biking_heart_rate", 1, 0, "bpm"},
+		11:  {12, 11, types.Fit(2), 1, "default_max_heart_rate", 1, 0, "bpm"},
+		12:  {13, 12, types.Fit(0), 1, "hr_setting", 1, 0, ""},
+		13:  {14, 13, types.Fit(0), 1, "speed_setting", 1, 0, ""},
+		14:  {15, 14, types.Fit(0), 1, "dist_setting", 1, 0, ""},
+		16:  {16, 16, types.Fit(0), 1, "power_setting", 1, 0, ""},
+		17:  {17, 17, types.Fit(0), 1, "activity_class", 1, 0, ""},
+		18:  {18, 18, types.Fit(0), 1, "position_setting", 1, 0, ""},
+		21:  {19, 21, types.Fit(0), 1, "temperature_setting", 1, 0, ""},
+		22:  {20, 22, types.Fit(4), 1, "local_id", 1, 0, ""},
+		23:  {21, 23, types.Fit(45), 6, "global_id", 1, 0, ""},
+		30:  {22, 30, types.Fit(0), 1, "height_setting", 1, 0, ""},
+		31:  {23, 31, types.Fit(4), 1, "user_running_step_length", 1000, 0, "m"},
+		32:  {24, 32, types.Fit(4), 1, "user_walking_step_length", 1000, 0, "m"},
 	},
 
 	MesgNumHrmProfile: {
-		254: {0, 254, types.Fit(4), 1},
-		0:   {1, 0, types.Fit(0), 1},
-		1:   {2, 1, types.Fit(11), 1},
-		2:   {3, 2, types.Fit(0), 1},
-		3:   {4, 3, types.Fit(10), 1},
+		254: {0, 254, types.Fit(4), 1, "message_index", 1, 0, ""},
+		0:   {1, 0, types.Fit(0), 1, "enabled", 1, 0, ""},
+		1:   {2, 1, types.Fit(11), 1, "hrm_ant_id", 1, 0, ""},
+		2:   {3, 2, types.Fit(0), 1, "log_hrv", 1, 0, ""},
+		3:   {4, 3, types.Fit(10), 1, "hrm_ant_id_trans_type", 1, 0, ""},
 	},
 
 	MesgNumSdmProfile: {
-		254: {0, 254, types.Fit(4), 1},
-		0:   {1, 0, types.Fit(0), 1},
-		1:   {2, 1, types.Fit(11), 1},
-		2:   {3, 2, types.Fit(4), 1},
-		3:   {4, 3, types.Fit(6), 1},
-		4:   {5, 4, types.Fit(0), 1},
-		5:   {6, 5, types.Fit(10), 1},
-		7:   {7, 7, types.Fit(2), 1},
+		254: {0, 254, types.Fit(4), 1, "message_index", 1, 0, ""},
+		0:   {1, 0, types.Fit(0), 1, "enabled", 1, 0, ""},
+		1:   {2, 1, types.Fit(11), 1, "sdm_ant_id", 1, 0, ""},
+		2:   {3, 2, types.Fit(4), 1, "sdm_cal_factor", 10, 0, "%"},
+		3:   {4, 3, types.Fit(6), 1, "odometer", 100, 0, "m"},
+		4:   {5, 4, types.Fit(0), 1, "speed_source", 1, 0, ""},
+		5:   {6, 5, types.Fit(10), 1, "sdm_ant_id_trans_type", 1, 0, ""},
+		7:   {7, 7, types.Fit(2), 1, "odometer_rollover", 1, 0, ""},
 	},
 
 	MesgNumBikeProfile: {
-		254: {0, 254, types.Fit(4), 1},
-		0:   {1, 0, types.Fit(7), 16},
-		1:   {2, 1, types.Fit(0), 1},
-		2:   {3, 2, types.Fit(0), 1},
-		3:   {4, 3, types.Fit(6), 1},
-		4:   {5, 4, types.Fit(11), 1},
-		5:   {6, 5, types.Fit(11), 1},
-		6:   {7, 6, types.Fit(11), 1},
-		7:   {8, 7, types.Fit(11), 1},
-		8:   {9, 8, types.Fit(4), 1},
-		9:   {10, 9, types.Fit(4), 1},
-		10:  {11, 10, types.Fit(4), 1},
-		11:  {12, 11, types.Fit(4), 1},
-		12:  {13, 12, types.Fit(0), 1},
-		13:  {14, 13, types.Fit(0), 1},
-		14:  {15, 14, types.Fit(2), 1},
-		15:  {16, 15, types.Fit(0), 1},
-		16:  {17, 16, types.Fit(0), 1},
-		17:  {18, 17, types.Fit(0), 1},
-		18:  {19, 18, types.Fit(0), 1},
-		19:  {20, 19, types.Fit(2), 1},
-		20:  {21, 20, types.Fit(0), 1},
-		21:  {22, 21, types.Fit(10), 1},
-		22:  {23, 22, types.Fit(10), 1},
-		23:  {24, 23, types.Fit(10), 1},
-		24:  {25, 24, types.Fit(10), 1},
-		37:  {26, 37, types.Fit(2), 1},
-		38:  {27, 38, types.Fit(10), 1},
-		39:  {28, 39, types.Fit(42), 1},
-		40:  {29, 40, types.Fit(10), 1},
-		41:  {30, 41, types.Fit(42), 1},
-		44:  {31, 44, types.Fit(0), 1},
+		254: {0, 254, types.Fit(4), 1, "message_index", 1, 0, ""},
+		0:   {1, 0, types.Fit(7), 16, "name", 1, 0, ""},
+		1:   {2, 1, types.Fit(0), 1, "sport", 1, 0, ""},
+		2:   {3, 2, types.Fit(0), 1, "sub_sport", 1, 0, ""},
+		3:   {4, 3, types.Fit(6), 1, "odometer", 100, 0, "m"},
+		4:   {5, 4, types.Fit(11), 1, "bike_spd_ant_id", 1, 0, ""},
+		5:   {6, 5, types.Fit(11), 1, "bike_cad_ant_id", 1, 0, ""},
+		6:   {7, 6, types.Fit(11), 1, "bike_spdcad_ant_id", 1, 0, ""},
+		7:   {8, 7, types.Fit(11), 1, "bike_power_ant_id", 1, 0, ""},
+		8:   {9, 8, types.Fit(4), 1, "custom_wheelsize", 1000, 0, "m"},
+		9:   {10, 9, types.Fit(4), 1, "auto_wheelsize", 1000, 0, "m"},
+		10:  {11, 10, types.Fit(4), 1, "bike_weight", 10, 0, "kg"},
+		11:  {12, 11, types.Fit(4), 1, "power_cal_factor", 10, 0, "%"},
+		12:  {13, 12, types.Fit(0), 1, "auto_wheel_cal", 1, 0, ""},
+		13:  {14, 13, types.Fit(0), 1, "auto_power_zero", 1, 0, ""},
+		14:  {15, 14, types.Fit(2), 1, "id", 1, 0, ""},
+		15:  {16, 15, types.Fit(0), 1, "spd_enabled", 1, 0, ""},
+		16:  {17, 16, types.Fit(0), 1, "cad_enabled", 1, 0, ""},
+		17:  {18, 17, types.Fit(0), 1, "spdcad_enabled", 1, 0, ""},
+		18:  {19, 18, types.Fit(0), 1, "power_enabled", 1, 0, ""},
+		19:  {20, 19, types.Fit(2), 1, "crank_length", 2, -110, "mm"},
+		20:  {21, 20, types.Fit(0), 1, "enabled", 1, 0, ""},
+		21:  {22, 21, types.Fit(10), 1, "bike_spd_ant_id_trans_type", 1, 0, ""},
+		22:  {23, 22, types.Fit(10), 1, "bike_cad_ant_id_trans_type", 1, 0, ""},
+		23:  {24, 23, types.Fit(10), 1, "bike_spdcad_ant_id_trans_type", 1, 0, ""},
+		24:  {25, 24, types.Fit(10), 1, "bike_power_ant_id_trans_type", 1, 0, ""},
+		37:  {26, 37, types.Fit(2), 1, "odometer_rollover", 1, 0, ""},
+		38:  {27, 38, types.Fit(10), 1, "front_gear_num", 1, 0, ""},
+		39:  {28, 39, types.Fit(42), 1, "front_gear", 1, 0, ""},
+		40:  {29, 40, types.Fit(10), 1, "rear_gear_num", 1, 0, ""},
+		41:  {30, 41, types.Fit(42), 1, "rear_gear", 1, 0, ""},
+		44:  {31, 44, types.Fit(0), 1, "shimano_di2_enabled", 1, 0, ""},
 	},
 
 	MesgNumConnectivity: {
-		0:  {0, 0, types.Fit(0), 1},
-		1:  {1, 1, types.Fit(0), 1},
-		2:  {2, 2, types.Fit(0), 1},
-		3:  {3, 3, types.Fit(7), 24},
-		4:  {4, 4, types.Fit(0), 1},
-		5:  {5, 5, types.Fit(0), 1},
-		6:  {6, 6, types.Fit(0), 1},
-		7:  {7, 7, types.Fit(0), 1},
-		8:  {8, 8, types.Fit(0), 1},
-		9:  {9, 9, types.Fit(0), 1},
-		10: {10, 10, types.Fit(0), 1},
-		11: {11, 11, types.Fit(0), 1},
-		12: {12, 12, types.Fit(0), 1},
+		0:  {0, 0, types.Fit(0), 1, "bluetooth_enabled", 1, 0, ""},
+		1:  {1, 1, types.Fit(0), 1, "bluetooth_le_enabled", 1, 0, ""},
+		2:  {2, 2, types.Fit(0), 1, "ant_enabled", 1, 0, ""},
+		3:  {3, 3, types.Fit(7), 24, "name", 1, 0, ""},
+		4:  {4, 4, types.Fit(0), 1, "live_tracking_enabled", 1, 0, ""},
+		5:  {5, 5, types.Fit(0), 1, "weather_conditions_enabled", 1, 0, ""},
+		6:  {6, 6, types.Fit(0), 1, "weather_alerts_enabled", 1, 0, ""},
+		7:  {7, 7, types.Fit(0), 1, "auto_activity_upload_enabled", 1, 0, ""},
+		8:  {8, 8, types.Fit(0), 1, "course_download_enabled", 1, 0, ""},
+		9:  {9, 9, types.Fit(0), 1, "workout_download_enabled", 1, 0, ""},
+		10: {10, 10, types.Fit(0), 1, "gps_ephemeris_download_enabled", 1, 0, ""},
+		11: {11, 11, types.Fit(0), 1, "incident_detection_enabled", 1, 0, ""},
+		12: {12, 12, types.Fit(0), 1, "grouptrack_enabled", 1, 0, ""},
 	},
 
 	MesgNumWatchfaceSettings: {},
 
 	MesgNumOhrSettings: {},
 
-	MesgNumTimeInZone: {},
+	MesgNumTimeInZone: {
+		253: {0, 253, types.Fit(70), 1, "timestamp", 1, 0, "s"},
+		0:   {1, 0, types.Fit(4), 1, "reference_mesg", 1, 0, ""},
+		1:   {2, 1, types.Fit(4), 1, "reference_index", 1, 0, ""},
+		2:   {3, 2, types.Fit(38), 1, "time_in_hr_zone", 1000, 0, "s"},
+		3:   {4, 3, types.Fit(38), 1, "time_in_speed_zone", 1000, 0, "s"},
+		4:   {5, 4, types.Fit(38), 1, "time_in_cadence_zone", 1000, 0, "s"},
+		5:   {6, 5, types.Fit(38), 1, "time_in_power_zone", 1000, 0, "s"},
+		6:   {7, 6, types.Fit(34), 1, "hr_zone_high_boundary", 1, 0, "bpm"},
+		7:   {8, 7, types.Fit(36), 1, "speed_zone_high_boundary", 1000, 0, "m/s"},
+		8:   {9, 8, types.Fit(34), 1, "cadence_zone_high_bondary", 1, 0, "rpm"},
+		9:   {10, 9, types.Fit(36), 1, "power_zone_high_boundary", 1, 0, "watts"},
+		10:  {11, 10, types.Fit(0), 1, "hr_calc_type", 1, 0, ""},
+		11:  {12, 11, types.Fit(2), 1, "max_heart_rate", 1, 0, ""},
+		12:  {13, 12, types.Fit(2), 1, "resting_heart_rate", 1, 0, ""},
+		13:  {14, 13, types.Fit(2), 1, "threshold_heart_rate", 1, 0, ""},
+		14:  {15, 14, types.Fit(0), 1, "pwr_calc_type", 1, 0, ""},
+		15:  {16, 15, types.Fit(4), 1, "functional_threshold_power", 1, 0, ""},
+	},
 
 	MesgNumZonesTarget: {
-		1: {0, 1, types.Fit(2), 1},
-		2: {1, 2, types.Fit(2), 1},
-		3: {2, 3, types.Fit(4), 1},
-		5: {3, 5, types.Fit(0), 1},
-		7: {4, 7, types.Fit(0), 1},
+		1: {0, 1, types.Fit(2), 1, "max_heart_rate", 1, 0, ""},
+		2: {1, 2, types.Fit(2), 1, "threshold_heart_rate", 1, 0, ""},
+		3: {2, 3, types.Fit(4), 1, "functional_threshold_power", 1, 0, ""},
+		5: {3, 5, types.Fit(0), 1, "hr_calc_type", 1, 0, ""},
+		7: {4, 7, types.Fit(0), 1, "pwr_calc_type", 1, 0, ""},
 	},
 
 	MesgNumSport: {
-		0: {0, 0, types.Fit(0), 1},
-		1: {1, 1, types.Fit(0), 1},
-		3: {2, 3, types.Fit(7), 16},
+		0: {0, 0, types.Fit(0), 1, "sport", 1, 0, ""},
+		1: {1, 1, types.Fit(0), 1, "sub_sport", 1, 0, ""},
+		3: {2, 3, types.Fit(7), 16, "name", 1, 0, ""},
 	},
 
 	MesgNumHrZone: {
-		254: {0, 254, types.Fit(4), 1},
-		1:   {1, 1, types.Fit(2), 1},
-		2:   {2, 2, types.Fit(7), 16},
+		254: {0, 254, types.Fit(4), 1, "message_index", 1, 0, ""},
+		1:   {1, 1, types.Fit(2), 1, "high_bpm", 1, 0, "bpm"},
+		2:   {2, 2, types.Fit(7), 16, "name", 1, 0, ""},
 	},
 
 	MesgNumSpeedZone: {
-		254: {0, 254, types.Fit(4), 1},
-		0:   {1, 0, types.Fit(4), 1},
-		1:   {2, 1, types.Fit(7), 16},
+		254: {0, 254, types.Fit(4), 1, "message_index", 1, 0, ""},
+		0:   {1, 0, types.Fit(4), 1, "high_value", 1000, 0, "m/s"},
+		1:   {2, 1, types.Fit(7), 16, "name", 1, 0, ""},
 	},
 
 	MesgNumCadenceZone: {
-		254: {0, 254, types.Fit(4), 1},
-		0:   {1, 0, types.Fit(2), 1},
-		1:   {2, 1, types.Fit(7), 16},
+		254: {0, 254, types.Fit(4), 1, "message_index", 1, 0, ""},
+		0:   {1, 0, types.Fit(2), 1, "high_value", 1, 0, "rpm"},
+		1:   {2, 1, types.Fit(7), 16, "name", 1, 0, ""},
 	},
 
 	MesgNumPowerZone: {
-		254: {0, 254, types.Fit(4), 1},
-		1:   {1, 1, types.Fit(4), 1},
-		2:   {2, 2, types.Fit(7), 16},
+		254: {0, 254, types.Fit(4), 1, "message_index", 1, 0, ""},
+		1:   {1, 1, types.Fit(4), 1, "high_value", 1, 0, "watts"},
+		2:   {2, 2, types.Fit(7), 16, "name", 1, 0, ""},
 	},
 
 	MesgNumMetZone: {
-		254: {0, 254, types.Fit(4), 1},
-		1:   {1, 1, types.Fit(2), 1},
-		2:   {2, 2, types.Fit(4), 1},
-		3:   {3, 3, types.Fit(2), 1},
+		254: {0, 254, types.Fit(4), 1, "message_index", 1, 0, ""},
+		1:   {1, 1, types.Fit(2), 1, "high_bpm", 1, 0, ""},
+		2:   {2, 2, types.Fit(4), 1, "calories", 10, 0, "kcal / min"},
+		3:   {3, 3, types.Fit(2), 1, "fat_calories", 10, 0, "kcal / min"},
 	},
 
 	MesgNumDiveSettings: {
-		0:  {0, 0, types.Fit(7), 16},
-		19: {1, 19, types.Fit(0), 1},
-		20: {2, 20, types.Fit(2), 1},
+		0:  {0, 0, types.Fit(7), 16, "name", 1, 0, ""},
+		19: {1, 19, types.Fit(0), 1, "heart_rate_source_type", 1, 0, ""},
+		20: {2, 20, types.Fit(2), 1, "heart_rate_source", 1, 0, ""},
 	},
 
 	MesgNumDiveAlarm: {},
@@ -373,370 +391,370 @@ var _fields = [...][256]*field{
 	MesgNumDiveGas: {},
 
 	MesgNumGoal: {
-		254: {0, 254, types.Fit(4), 1},
-		0:   {1, 0, types.Fit(0), 1},
-		1:   {2, 1, types.Fit(0), 1},
-		2:   {3, 2, types.Fit(70), 1},
-		3:   {4, 3, types.Fit(70), 1},
-		4:   {5, 4, types.Fit(0), 1},
-		5:   {6, 5, types.Fit(6), 1},
-		6:   {7, 6, types.Fit(0), 1},
-		7:   {8, 7, types.Fit(6), 1},
-		8:   {9, 8, types.Fit(0), 1},
-		9:   {10, 9, types.Fit(4), 1},
-		10:  {11, 10, types.Fit(0), 1},
-		11:  {12, 11, types.Fit(0), 1},
+		254: {0, 254, types.Fit(4), 1, "message_index", 1, 0, ""},
+		0:   {1, 0, types.Fit(0), 1, "sport", 1, 0, ""},
+		1:   {2, 1, types.Fit(0), 1, "sub_sport", 1, 0, ""},
+		2:   {3, 2, types.Fit(70), 1, "start_date", 1, 0, ""},
+		3:   {4, 3, types.Fit(70), 1, "end_date", 1, 0, ""},
+		4:   {5, 4, types.Fit(0), 1, "type", 1, 0, ""},
+		5:   {6, 5, types.Fit(6), 1, "value", 1, 0, ""},
+		6:   {7, 6, types.Fit(0), 1, "repeat", 1, 0, ""},
+		7:   {8, 7, types.Fit(6), 1, "target_value", 1, 0, ""},
+		8:   {9, 8, types.Fit(0), 1, "recurrence", 1, 0, ""},
+		9:   {10, 9, types.Fit(4), 1, "recurrence_value", 1, 0, ""},
+		10:  {11, 10, types.Fit(0), 1, "enabled", 1, 0, ""},
+		11:  {12, 11, types.Fit(0), 1, "source", 1, 0, ""},
 	},
 
 	MesgNumActivity: {
-		253: {0, 253, types.Fit(70), 1},
-		0:   {1, 0, types.Fit(6), 1},
-		1:   {2, 1, types.Fit(4), 1},
-		2:   {3, 2, types.Fit(0), 1},
-		3:   {4, 3, types.Fit(0), 1},
-		4:   {5, 4, types.Fit(0), 1},
-		5:   {6, 5, types.Fit(134), 1},
-		6:   {7, 6, types.Fit(2), 1},
+		253: {0, 253, types.Fit(70), 1, "timestamp", 1, 0, ""},
+		0:   {1, 0, types.Fit(6), 1, "total_timer_time", 1000, 0, "s"},
+		1:   {2, 1, types.Fit(4), 1, "num_sessions", 1, 0, ""},
+		2:   {3, 2, types.Fit(0), 1, "type", 1, 0, ""},
+		3:   {4, 3, types.Fit(0), 1, "event", 1, 0, ""},
+		4:   {5, 4, types.Fit(0), 1, "event_type", 1, 0, ""},
+		5:   {6, 5, types.Fit(134), 1, "local_timestamp", 1, 0, ""},
+		6:   {7, 6, types.Fit(2), 1, "event_group", 1, 0, ""},
 	},
 
 	MesgNumSession: {
-		254: {0, 254, types.Fit(4), 1},
-		253: {1, 253, types.Fit(70), 1},
-		0:   {2, 0, types.Fit(0), 1},
-		1:   {3, 1, types.Fit(0), 1},
-		2:   {4, 2, types.Fit(70), 1},
-		3:   {5, 3, types.Fit(197), 1},
-		4:   {6, 4, types.Fit(261), 1},
-		5:   {7, 5, types.Fit(0), 1},
-		6:   {8, 6, types.Fit(0), 1},
-		7:   {9, 7, types.Fit(6), 1},
-		8:   {10, 8, types.Fit(6), 1},
-		9:   {11, 9, types.Fit(6), 1},
-		10:  {12, 10, types.Fit(6), 1},
-		11:  {13, 11, types.Fit(4), 1},
-		13:  {14, 13, types.Fit(4), 1},
-		14:  {15, 14, types.Fit(4), 1},
-		15:  {16, 15, types.Fit(4), 1},
-		16:  {17, 16, types.Fit(2), 1},
-		17:  {18, 17, types.Fit(2), 1},
-		18:  {19, 18, types.Fit(2), 1},
-		19:  {20, 19, types.Fit(2), 1},
-		20:  {21, 20, types.Fit(4), 1},
-		21:  {22, 21, types.Fit(4), 1},
-		22:  {23, 22, types.Fit(4), 1},
-		23:  {24, 23, types.Fit(4), 1},
-		24:  {25, 24, types.Fit(2), 1},
-		25:  {26, 25, types.Fit(4), 1},
-		26:  {27, 26, types.Fit(4), 1},
-		27:  {28, 27, types.Fit(2), 1},
-		28:  {29, 28, types.Fit(0), 1},
-		29:  {30, 29, types.Fit(197), 1},
-		30:  {31, 30, types.Fit(261), 1},
-		31:  {32, 31, types.Fit(197), 1},
-		32:  {33, 32, types.Fit(261), 1},
-		33:  {34, 33, types.Fit(4), 1},
-		34:  {35, 34, types.Fit(4), 1},
-		35:  {36, 35, types.Fit(4), 1},
-		36:  {37, 36, types.Fit(4), 1},
-		37:  {38, 37, types.Fit(4), 1},
-		38:  {39, 38, types.Fit(197), 1},
-		39:  {40, 39, types.Fit(261), 1},
-		41:  {41, 41, types.Fit(6), 1},
-		42:  {42, 42, types.Fit(4), 1},
-		43:  {43, 43, types.Fit(0), 1},
-		44:  {44, 44, types.Fit(4), 1},
-		45:  {45, 45, types.Fit(4), 1},
-		46:  {46, 46, types.Fit(0), 1},
-		47:  {47, 47, types.Fit(4), 1},
-		48:  {48, 48, types.Fit(6), 1},
-		49:  {49, 49, types.Fit(4), 1},
-		50:  {50, 50, types.Fit(4), 1},
-		51:  {51, 51, types.Fit(2), 1},
-		52:  {52, 52, types.Fit(3), 1},
-		53:  {53, 53, types.Fit(3), 1},
-		54:  {54, 54, types.Fit(3), 1},
-		55:  {55, 55, types.Fit(3), 1},
-		56:  {56, 56, types.Fit(3), 1},
-		57:  {57, 57, types.Fit(1), 1},
-		58:  {58, 58, types.Fit(1), 1},
-		59:  {59, 59, types.Fit(6), 1},
-		60:  {60, 60, types.Fit(3), 1},
-		61:  {61, 61, types.Fit(3), 1},
-		62:  {62, 62, types.Fit(3), 1},
-		63:  {63, 63, types.Fit(3), 1},
-		64:  {64, 64, types.Fit(2), 1},
-		65:  {65, 65, types.Fit(38), 1},
-		66:  {66, 66, types.Fit(38), 1},
-		67:  {67, 67, types.Fit(38), 1},
-		68:  {68, 68, types.Fit(38), 1},
-		69:  {69, 69, types.Fit(6), 1},
-		70:  {70, 70, types.Fit(4), 1},
-		71:  {71, 71, types.Fit(4), 1},
-		82:  {72, 82, types.Fit(4), 1},
-		83:  {73, 83, types.Fit(4), 1},
-		84:  {74, 84, types.Fit(7), 1},
-		85:  {75, 85, types.Fit(36), 1},
-		86:  {76, 86, types.Fit(36), 1},
-		87:  {77, 87, types.Fit(4), 1},
-		88:  {78, 88, types.Fit(4), 1},
-		89:  {79, 89, types.Fit(4), 1},
-		90:  {80, 90, types.Fit(4), 1},
-		91:  {81, 91, types.Fit(4), 1},
-		92:  {82, 92, types.Fit(2), 1},
-		93:  {83, 93, types.Fit(2), 1},
-		94:  {84, 94, types.Fit(2), 1},
-		110: {85, 110, types.Fit(7), 16},
-		111: {86, 111, types.Fit(2), 1},
-		124: {87, 124, types.Fit(6), 1},
-		125: {88, 125, types.Fit(6), 1},
-		126: {89, 126, types.Fit(6), 1},
-		127: {90, 127, types.Fit(6), 1},
-		128: {91, 128, types.Fit(6), 1},
-		137: {92, 137, types.Fit(2), 1},
-		139: {93, 139, types.Fit(4), 1},
-		150: {94, 150, types.Fit(1), 1},
+		254: {0, 254, types.Fit(4), 1, "message_index", 1, 0, ""},
+		253: {1, 253, types.Fit(70), 1, "timestamp", 1, 0, "s"},
+		0:   {2, 0, types.Fit(0), 1, "event", 1, 0, ""},
+		1:   {3, 1, types.Fit(0), 1, "event_type", 1, 0, ""},
+		2:   {4, 2, types.Fit(70), 1, "start_time", 1, 0, ""},
+		3:   {5, 3, types.Fit(197), 1, "start_position_lat", 1, 0, "semicircles"},
+		4:   {6, 4, types.Fit(261), 1, "start_position_long", 1, 0, "semicircles"},
+		5:   {7, 5, types.Fit(0), 1, "sport", 1, 0, ""},
+		6:   {8, 6, types.Fit(0), 1, "sub_sport", 1, 0, ""},
+		7:   {9, 7, types.Fit(6), 1, "total_elapsed_time", 1000, 0, "s"},
+		8:   {10, 8, types.Fit(6), 1, "total_timer_time", 1000, 0, "s"},
+		9:   {11, 9, types.Fit(6), 1, "total_distance", 100, 0, "m"},
+		10:  {12, 10, types.Fit(6), 1, "total_cycles", 1, 0, "cycles"},
+		11:  {13, 11, types.Fit(4), 1, "total_calories", 1, 0, "kcal"},
+		13:  {14, 13, types.Fit(4), 1, "total_fat_calories", 1, 0, "kcal"},
+		14:  {15, 14, types.Fit(4), 1, "avg_speed", 1000, 0, "m/s"},
+		15:  {16, 15, types.Fit(4), 1, "max_speed", 1000, 0, "m/s"},
+		16:  {17, 16, types.Fit(2), 1, "avg_heart_rate", 1, 0, "bpm"},
+		17:  {18, 17, types.Fit(2), 1, "max_heart_rate", 1, 0, "bpm"},
+		18:  {19, 18, types.Fit(2), 1, "avg_cadence", 1, 0, "rpm"},
+		19:  {20, 19, types.Fit(2), 1, "max_cadence", 1, 0, "rpm"},
+		20:  {21, 20, types.Fit(4), 1, "avg_power", 1, 0, "watts"},
+		21:  {22, 21, types.Fit(4), 1, "max_power", 1, 0, "watts"},
+		22:  {23, 22, types.Fit(4), 1, "total_ascent", 1, 0, "m"},
+		23:  {24, 23, types.Fit(4), 1, "total_descent", 1, 0, "m"},
+		24:  {25, 24, types.Fit(2), 1, "total_training_effect", 10, 0, ""},
+		25:  {26, 25, types.Fit(4), 1, "first_lap_index", 1, 0, ""},
+		26:  {27, 26, types.Fit(4), 1, "num_laps", 1, 0, ""},
+		27:  {28, 27, types.Fit(2), 1, "event_group", 1, 0, ""},
+		28:  {29, 28, types.Fit(0), 1, "trigger", 1, 0, ""},
+		29:  {30, 29, types.Fit(197), 1, "nec_lat", 1, 0, "semicircles"},
+		30:  {31, 30, types.Fit(261), 1, "nec_long", 1, 0, "semicircles"},
+		31:  {32, 31, types.Fit(197), 1, "swc_lat", 1, 0, "semicircles"},
+		32:  {33, 32, types.Fit(261), 1, "swc_long", 1, 0, "semicircles"},
+		33:  {34, 33, types.Fit(4), 1, "num_lengths", 1, 0, "lengths"},
+		34:  {35, 34, types.Fit(4), 1, "normalized_power", 1, 0, "watts"},
+		35:  {36, 35, types.Fit(4), 1, "training_stress_score", 10, 0, "tss"},
+		36:  {37, 36, types.Fit(4), 1, "intensity_factor", 1000, 0, "if"},
+		37:  {38, 37, types.Fit(4), 1, "left_right_balance", 1, 0, ""},
+		38:  {39, 38, types.Fit(197), 1, "end_position_lat", 1, 0, ""},
+		39:  {40, 39, types.Fit(261), 1, "end_position_long", 1, 0, ""},
+		41:  {41, 41, types.Fit(6), 1, "avg_stroke_count", 10, 0, "strokes/lap"},
+		42:  {42, 42, types.Fit(4), 1, "avg_stroke_distance", 100, 0, "m"},
+		43:  {43, 43, types.Fit(0), 1, "swim_stroke", 1, 0, "swim_stroke"},
+		44:  {44, 44, types.Fit(4), 1, "pool_length", 100, 0, "m"},
+		45:  {45, 45, types.Fit(4), 1, "threshold_power", 1, 0, "watts"},
+		46:  {46, 46, types.Fit(0), 1, "pool_length_unit", 1, 0, ""},
+		47:  {47, 47, types.Fit(4), 1, "num_active_lengths", 1, 0, "lengths"},
+		48:  {48, 48, types.Fit(6), 1, "total_work", 1, 0, "J"},
+		49:  {49, 49, types.Fit(4), 1, "avg_altitude", 5, 500, "m"},
+		50:  {50, 50, types.Fit(4), 1, "max_altitude", 5, 500, "m"},
+		51:  {51, 51, types.Fit(2), 1, "gps_accuracy", 1, 0, "m"},
+		52:  {52, 52, types.Fit(3), 1, "avg_grade", 100, 0, "%"},
+		53:  {53, 53, types.Fit(3), 1, "avg_pos_grade", 100, 0, "%"},
+		54:  {54, 54, types.Fit(3), 1, "avg_neg_grade", 100, 0, "%"},
+		55:  {55, 55, types.Fit(3), 1, "max_pos_grade", 100, 0, "%"},
+		56:  {56, 56, types.Fit(3), 1, "max_neg_grade", 100, 0, "%"},
+		57:  {57, 57, types.Fit(1), 1, "avg_temperature", 1, 0, "C"},
+		58:  {58, 58, types.Fit(1), 1, "max_temperature", 1, 0, "C"},
+		59:  {59, 59, types.Fit(6), 1, "total_moving_time", 1000, 0, "s"},
+		60:  {60, 60, types.Fit(3), 1, "avg_pos_vertical_speed", 1000, 0, "m/s"},
+		61:  {61, 61, types.Fit(3), 1, "avg_neg_vertical_speed", 1000, 0, "m/s"},
+		62:  {62, 62, types.Fit(3), 1, "max_pos_vertical_speed", 1000, 0, "m/s"},
+		63:  {63, 63, types.Fit(3), 1, "max_neg_vertical_speed", 1000, 0, "m/s"},
+		64:  {64, 64, types.Fit(2), 1, "min_heart_rate", 1, 0, "bpm"},
+		65:  {65, 65, types.Fit(38), 1, "time_in_hr_zone", 1000, 0, "s"},
+		66:  {66, 66, types.Fit(38), 1, "time_in_speed_zone", 1000, 0, "s"},
+		67:  {67, 67, types.Fit(38), 1, "time_in_cadence_zone", 1000, 0, "s"},
+		68:  {68, 68, types.Fit(38), 1, "time_in_power_zone", 1000, 0, "s"},
+		69:  {69, 69, types.Fit(6), 1, "avg_lap_time", 1000, 0, "s"},
+		70:  {70, 70, types.Fit(4), 1, "best_lap_index", 1, 0, ""},
+		71:  {71, 71, types.Fit(4), 1, "min_altitude", 5, 500, "m"},
+		82:  {72, 82, types.Fit(4), 1, "player_score", 1, 0, ""},
+		83:  {73, 83, types.Fit(4), 1, "opponent_score", 1, 0, ""},
+		84:  {74, 84, types.Fit(7), 1, "opponent_name", 1, 0, ""},
+		85:  {75, 85, types.Fit(36), 1, "stroke_count", 1, 0, "counts"},
+		86:  {76, 86, types.Fit(36), 1, "zone_count", 1, 0, "counts"},
+		87:  {77, 87, types.Fit(4), 1, "max_ball_speed", 100, 0, "m/s"},
+		88:  {78, 88, types.Fit(4), 1, "avg_ball_speed", 100, 0, "m/s"},
+		89:  {79, 89, types.Fit(4), 1, "avg_vertical_oscillation", 10, 0, "mm"},
+		90:  {80, 90, types.Fit(4), 1, "avg_stance_time_percent", 100, 0, "percent"},
+		91:  {81, 91, types.Fit(4), 1, "avg_stance_time", 10, 0, "ms"},
+		92:  {82, 92, types.Fit(2), 1, "avg_fractional_cadence", 128, 0, "rpm"},
+		93:  {83, 93, types.Fit(2), 1, "max_fractional_cadence", 128, 0, "rpm"},
+		94:  {84, 94, types.Fit(2), 1, "total_fractional_cycles", 128, 0, "cycles"},
+		110: {85, 110, types.Fit(7), 16, "sport_profile_name", 1, 0, ""},
+		111: {86, 111, types.Fit(2), 1, "sport_index", 1, 0, ""},
+		124: {87, 124, types.Fit(6), 1, "enhanced_avg_speed", 1000, 0, "m/s"},
+		125: {88, 125, types.Fit(6), 1, "enhanced_max_speed", 1000, 0, "m/s"},
+		126: {89, 126, types.Fit(6), 1, "enhanced_avg_altitude", 5, 500, "m"},
+		127: {90, 127, types.Fit(6), 1, "enhanced_min_altitude", 5, 500, "m"},
+		128: {91, 128, types.Fit(6), 1, "enhanced_max_altitude", 5, 500, "m"},
+		137: {92, 137, types.Fit(2), 1, "total_anaerobic_training_effect", 10, 0, ""},
+		139: {93, 139, types.Fit(4), 1, "avg_vam", 1000, 0, "m/s"},
+		150: {94, 150, types.Fit(1), 1, "min_temperature", 1, 0, ""},
 	},
 
 	MesgNumLap: {
-		254: {0, 254, types.Fit(4), 1},
-		253: {1, 253, types.Fit(70), 1},
-		0:   {2, 0, types.Fit(0), 1},
-		1:   {3, 1, types.Fit(0), 1},
-		2:   {4, 2, types.Fit(70), 1},
-		3:   {5, 3, types.Fit(197), 1},
-		4:   {6, 4, types.Fit(261), 1},
-		5:   {7, 5, types.Fit(197), 1},
-		6:   {8, 6, types.Fit(261), 1},
-		7:   {9, 7, types.Fit(6), 1},
-		8:   {10, 8, types.Fit(6), 1},
-		9:   {11, 9, types.Fit(6), 1},
-		10:  {12, 10, types.Fit(6), 1},
-		11:  {13, 11, types.Fit(4), 1},
-		12:  {14, 12, types.Fit(4), 1},
-		13:  {15, 13, types.Fit(4), 1},
-		14:  {16, 14, types.Fit(4), 1},
-		15:  {17, 15, types.Fit(2), 1},
-		16:  {18, 16, types.Fit(2), 1},
-		17:  {19, 17, types.Fit(2), 1},
-		18:  {20, 18, types.Fit(2), 1},
-		19:  {21, 19, types.Fit(4), 1},
-		20:  {22, 20, types.Fit(4), 1},
-		21:  {23, 21, types.Fit(4), 1},
-		22:  {24, 22, types.Fit(4), 1},
-		23:  {25, 23, types.Fit(0), 1},
-		24:  {26, 24, types.Fit(0), 1},
-		25:  {27, 25, types.Fit(0), 1},
-		26:  {28, 26, types.Fit(2), 1},
-		32:  {29, 32, types.Fit(4), 1},
-		33:  {30, 33, types.Fit(4), 1},
-		34:  {31, 34, types.Fit(4), 1},
-		35:  {32, 35, types.Fit(4), 1},
-		37:  {33, 37, types.Fit(4), 1},
-		38:  {34, 38, types.Fit(0), 1},
-		39:  {35, 39, types.Fit(0), 1},
-		40:  {36, 40, types.Fit(4), 1},
-		41:  {37, 41, types.Fit(6), 1},
-		42:  {38, 42, types.Fit(4), 1},
-		43:  {39, 43, types.Fit(4), 1},
-		44:  {40, 44, types.Fit(2), 1},
-		45:  {41, 45, types.Fit(3), 1},
-		46:  {42, 46, types.Fit(3), 1},
-		47:  {43, 47, types.Fit(3), 1},
-		48:  {44, 48, types.Fit(3), 1},
-		49:  {45, 49, types.Fit(3), 1},
-		50:  {46, 50, types.Fit(1), 1},
-		51:  {47, 51, types.Fit(1), 1},
-		52:  {48, 52, types.Fit(6), 1},
-		53:  {49, 53, types.Fit(3), 1},
-		54:  {50, 54, types.Fit(3), 1},
-		55:  {51, 55, types.Fit(3), 1},
-		56:  {52, 56, types.Fit(3), 1},
-		57:  {53, 57, types.Fit(38), 1},
-		58:  {54, 58, types.Fit(38), 1},
-		59:  {55, 59, types.Fit(38), 1},
-		60:  {56, 60, types.Fit(38), 1},
-		61:  {57, 61, types.Fit(4), 1},
-		62:  {58, 62, types.Fit(4), 1},
-		63:  {59, 63, types.Fit(2), 1},
-		71:  {60, 71, types.Fit(4), 1},
-		74:  {61, 74, types.Fit(4), 1},
-		75:  {62, 75, types.Fit(36), 1},
-		76:  {63, 76, types.Fit(36), 1},
-		77:  {64, 77, types.Fit(4), 1},
-		78:  {65, 78, types.Fit(4), 1},
-		79:  {66, 79, types.Fit(4), 1},
-		80:  {67, 80, types.Fit(2), 1},
-		81:  {68, 81, types.Fit(2), 1},
-		82:  {69, 82, types.Fit(2), 1},
-		83:  {70, 83, types.Fit(4), 1},
-		84:  {71, 84, types.Fit(36), 1},
-		85:  {72, 85, types.Fit(36), 1},
-		86:  {73, 86, types.Fit(36), 1},
-		87:  {74, 87, types.Fit(36), 1},
-		88:  {75, 88, types.Fit(36), 1},
-		89:  {76, 89, types.Fit(36), 1},
-		110: {77, 110, types.Fit(6), 1},
-		111: {78, 111, types.Fit(6), 1},
-		112: {79, 112, types.Fit(6), 1},
-		113: {80, 113, types.Fit(6), 1},
-		114: {81, 114, types.Fit(6), 1},
-		121: {82, 121, types.Fit(4), 1},
-		124: {83, 124, types.Fit(1), 1},
+		254: {0, 254, types.Fit(4), 1, "message_index", 1, 0, ""},
+		253: {1, 253, types.Fit(70), 1, "timestamp", 1, 0, "s"},
+		0:   {2, 0, types.Fit(0), 1, "event", 1, 0, ""},
+		1:   {3, 1, types.Fit(0), 1, "event_type", 1, 0, ""},
+		2:   {4, 2, types.Fit(70), 1, "start_time", 1, 0, ""},
+		3:   {5, 3, types.Fit(197), 1, "start_position_lat", 1, 0, "semicircles"},
+		4:   {6, 4, types.Fit(261), 1, "start_position_long", 1, 0, "semicircles"},
+		5:   {7, 5, types.Fit(197), 1, "end_position_lat", 1, 0, "semicircles"},
+		6:   {8, 6, types.Fit(261), 1, "end_position_long", 1, 0, "semicircles"},
+		7:   {9, 7, types.Fit(6), 1, "total_elapsed_time", 1000, 0, "s"},
+		8:   {10, 8, types.Fit(6), 1, "total_timer_time", 1000, 0, "s"},
+		9:   {11, 9, types.Fit(6), 1, "total_distance", 100, 0, "m"},
+		10:  {12, 10, types.Fit(6), 1, "total_cycles", 1, 0, "cycles"},
+		11:  {13, 11, types.Fit(4), 1, "total_calories", 1, 0, "kcal"},
+		12:  {14, 12, types.Fit(4), 1, "total_fat_calories", 1, 0, "kcal"},
+		13:  {15, 13, types.Fit(4), 1, "avg_speed", 1000, 0, "m/s"},
+		14:  {16, 14, types.Fit(4), 1, "max_speed", 1000, 0, "m/s"},
+		15:  {17, 15, types.Fit(2), 1, "avg_heart_rate", 1, 0, "bpm"},
+		16:  {18, 16, types.Fit(2), 1, "max_heart_rate", 1, 0, "bpm"},
+		17:  {19, 17, types.Fit(2), 1, "avg_cadence", 1, 0, "rpm"},
+		18:  {20, 18, types.Fit(2), 1, "max_cadence", 1, 0, "rpm"},
+		19:  {21, 19, types.Fit(4), 1, "avg_power", 1, 0, "watts"},
+		20:  {22, 20, types.Fit(4), 1, "max_power", 1, 0, "watts"},
+		21:  {23, 21, types.Fit(4), 1, "total_ascent", 1, 0, "m"},
+		22:  {24, 22, types.Fit(4), 1, "total_descent", 1, 0, "m"},
+		23:  {25, 23, types.Fit(0), 1, "intensity", 1, 0, ""},
+		24:  {26, 24, types.Fit(0), 1, "lap_trigger", 1, 0, ""},
+		25:  {27, 25, types.Fit(0), 1, "sport", 1, 0, ""},
+		26:  {28, 26, types.Fit(2), 1, "event_group", 1, 0, ""},
+		32:  {29, 32, types.Fit(4), 1, "num_lengths", 1, 0, "lengths"},
+		33:  {30, 33, types.Fit(4), 1, "normalized_power", 1, 0, "watts"},
+		34:  {31, 34, types.Fit(4), 1, "left_right_balance", 1, 0, ""},
+		35:  {32, 35, types.Fit(4), 1, "first_length_index", 1, 0, ""},
+		37:  {33, 37, types.Fit(4), 1, "avg_stroke_distance", 100, 0, "m"},
+		38:  {34, 38, types.Fit(0), 1, "swim_stroke", 1, 0, ""},
+		39:  {35, 39, types.Fit(0), 1, "sub_sport", 1, 0, ""},
+		40:  {36, 40, types.Fit(4), 1, "num_active_lengths", 1, 0, "lengths"},
+		41:  {37, 41, types.Fit(6), 1, "total_work", 1, 0, "J"},
+		42:  {38, 42, types.Fit(4), 1, "avg_altitude", 5, 500, "m"},
+		43:  {39, 43, types.Fit(4), 1, "max_altitude", 5, 500, "m"},
+		44:  {40, 44, types.Fit(2), 1, "gps_accuracy", 1, 0, "m"},
+		45:  {41, 45, types.Fit(3), 1, "avg_grade", 100, 0, "%"},
+		46:  {42, 46, types.Fit(3), 1, "avg_pos_grade", 100, 0, "%"},
+		47:  {43, 47, types.Fit(3), 1, "avg_neg_grade", 100, 0, "%"},
+		48:  {44, 48, types.Fit(3), 1, "max_pos_grade", 100, 0, "%"},
+		49:  {45, 49, types.Fit(3), 1, "max_neg_grade", 100, 0, "%"},
+		50:  {46, 50, types.Fit(1), 1, "avg_temperature", 1, 0, "C"},
+		51:  {47, 51, types.Fit(1), 1, "max_temperature", 1, 0, "C"},
+		52:  {48, 52, types.Fit(6), 1, "total_moving_time", 1000, 0, "s"},
+		53:  {49, 53, types.Fit(3), 1, "avg_pos_vertical_speed", 1000, 0, "m/s"},
+		54:  {50, 54, types.Fit(3), 1, "avg_neg_vertical_speed", 1000, 0, "m/s"},
+		55:  {51, 55, types.Fit(3), 1, "max_pos_vertical_speed", 1000, 0, "m/s"},
+		56:  {52, 56, types.Fit(3), 1, "max_neg_vertical_speed", 1000, 0, "m/s"},
+		57:  {53, 57, types.Fit(38), 1, "time_in_hr_zone", 1000, 0, "s"},
+		58:  {54, 58, types.Fit(38), 1, "time_in_speed_zone", 1000, 0, "s"},
+		59:  {55, 59, types.Fit(38), 1, "time_in_cadence_zone", 1000, 0, "s"},
+		60:  {56, 60, types.Fit(38), 1, "time_in_power_zone", 1000, 0, "s"},
+		61:  {57, 61, types.Fit(4), 1, "repetition_num", 1, 0, ""},
+		62:  {58, 62, types.Fit(4), 1, "min_altitude", 5, 500, "m"},
+		63:  {59, 63, types.Fit(2), 1, "min_heart_rate", 1, 0, "bpm"},
+		71:  {60, 71, types.Fit(4), 1, "wkt_step_index", 1, 0, ""},
+		74:  {61, 74, types.Fit(4), 1, "opponent_score", 1, 0, ""},
+		75:  {62, 75, types.Fit(36), 1, "stroke_count", 1, 0, "counts"},
+		76:  {63, 76, types.Fit(36), 1, "zone_count", 1, 0, "counts"},
+		77:  {64, 77, types.Fit(4), 1, "avg_vertical_oscillation", 10, 0, "mm"},
+		78:  {65, 78, types.Fit(4), 1, "avg_stance_time_percent", 100, 0, "percent"},
+		79:  {66, 79, types.Fit(4), 1, "avg_stance_time", 10, 0, "ms"},
+		80:  {67, 80, types.Fit(2), 1, "avg_fractional_cadence", 128, 0, "rpm"},
+		81:  {68, 81, types.Fit(2), 1, "max_fractional_cadence", 128, 0, "rpm"},
+		82:  {69, 82, types.Fit(2), 1, "total_fractional_cycles", 128, 0, "cycles"},
+		83:  {70, 83, types.Fit(4), 1, "player_score", 1, 0, ""},
+		84:  {71, 84, types.Fit(36), 1, "avg_total_hemoglobin_conc", 100, 0, "g/dL"},
+		85:  {72, 85, types.Fit(36), 1, "min_total_hemoglobin_conc", 100, 0, "g/dL"},
+		86:  {73, 86, types.Fit(36), 1, "max_total_hemoglobin_conc", 100, 0, "g/dL"},
+		87:  {74, 87, types.Fit(36), 1, "avg_saturated_hemoglobin_percent", 10, 0, "%"},
+		88:  {75, 88, types.Fit(36), 1, "min_saturated_hemoglobin_percent", 10, 0, "%"},
+		89:  {76, 89, types.Fit(36), 1, "max_saturated_hemoglobin_percent", 10, 0, "%"},
+		110: {77, 110, types.Fit(6), 1, "enhanced_avg_speed", 1000, 0, "m/s"},
+		111: {78, 111, types.Fit(6), 1, "enhanced_max_speed", 1000, 0, "m/s"},
+		112: {79, 112, types.Fit(6), 1, "enhanced_avg_altitude", 5, 500, "m"},
+		113: {80, 113, types.Fit(6), 1, "enhanced_min_altitude", 5, 500, "m"},
+		114: {81, 114, types.Fit(6), 1, "enhanced_max_altitude", 5, 500, "m"},
+		121: {82, 121, types.Fit(4), 1, "avg_vam", 1000, 0, "m/s"},
+		124: {83, 124, types.Fit(1), 1, "min_temperature", 1, 0, ""},
 	},
 
 	MesgNumLength: {
-		254: {0, 254, types.Fit(4), 1},
-		253: {1, 253, types.Fit(70), 1},
-		0:   {2, 0, types.Fit(0), 1},
-		1:   {3, 1, types.Fit(0), 1},
-		2:   {4, 2, types.Fit(70), 1},
-		3:   {5, 3, types.Fit(6), 1},
-		4:   {6, 4, types.Fit(6), 1},
-		5:   {7, 5, types.Fit(4), 1},
-		6:   {8, 6, types.Fit(4), 1},
-		7:   {9, 7, types.Fit(0), 1},
-		9:   {10, 9, types.Fit(2), 1},
-		10:  {11, 10, types.Fit(2), 1},
-		11:  {12, 11, types.Fit(4), 1},
-		12:  {13, 12, types.Fit(0), 1},
-		18:  {14, 18, types.Fit(4), 1},
-		19:  {15, 19, types.Fit(4), 1},
-		20:  {16, 20, types.Fit(36), 1},
-		21:  {17, 21, types.Fit(36), 1},
+		254: {0, 254, types.Fit(4), 1, "message_index", 1, 0, ""},
+		253: {1, 253, types.Fit(70), 1, "timestamp", 1, 0, ""},
+		0:   {2, 0, types.Fit(0), 1, "event", 1, 0, ""},
+		1:   {3, 1, types.Fit(0), 1, "event_type", 1, 0, ""},
+		2:   {4, 2, types.Fit(70), 1, "start_time", 1, 0, ""},
+		3:   {5, 3, types.Fit(6), 1, "total_elapsed_time", 1000, 0, "s"},
+		4:   {6, 4, types.Fit(6), 1, "total_timer_time", 1000, 0, "s"},
+		5:   {7, 5, types.Fit(4), 1, "total_strokes", 1, 0, "strokes"},
+		6:   {8, 6, types.Fit(4), 1, "avg_speed", 1000, 0, "m/s"},
+		7:   {9, 7, types.Fit(0), 1, "swim_stroke", 1, 0, "swim_stroke"},
+		9:   {10, 9, types.Fit(2), 1, "avg_swimming_cadence", 1, 0, "strokes/min"},
+		10:  {11, 10, types.Fit(2), 1, "event_group", 1, 0, ""},
+		11:  {12, 11, types.Fit(4), 1, "total_calories", 1, 0, "kcal"},
+		12:  {13, 12, types.Fit(0), 1, "length_type", 1, 0, ""},
+		18:  {14, 18, types.Fit(4), 1, "player_score", 1, 0, ""},
+		19:  {15, 19, types.Fit(4), 1, "opponent_score", 1, 0, ""},
+		20:  {16, 20, types.Fit(36), 1, "stroke_count", 1, 0, "counts"},
+		21:  {17, 21, types.Fit(36), 1, "zone_count", 1, 0, "counts"},
 	},
 
 	MesgNumRecord: {
-		253: {0, 253, types.Fit(70), 1},
-		0:   {1, 0, types.Fit(197), 1},
-		1:   {2, 1, types.Fit(261), 1},
-		2:   {3, 2, types.Fit(4), 1},
-		3:   {4, 3, types.Fit(2), 1},
-		4:   {5, 4, types.Fit(2), 1},
-		5:   {6, 5, types.Fit(6), 1},
-		6:   {7, 6, types.Fit(4), 1},
-		7:   {8, 7, types.Fit(4), 1},
-		8:   {9, 8, types.Fit(45), 3},
-		9:   {10, 9, types.Fit(3), 1},
-		10:  {11, 10, types.Fit(2), 1},
-		11:  {12, 11, types.Fit(5), 1},
-		12:  {13, 12, types.Fit(2), 1},
-		13:  {14, 13, types.Fit(1), 1},
-		17:  {15, 17, types.Fit(34), 5},
-		18:  {16, 18, types.Fit(2), 1},
-		19:  {17, 19, types.Fit(6), 1},
-		28:  {18, 28, types.Fit(4), 1},
-		29:  {19, 29, types.Fit(6), 1},
-		30:  {20, 30, types.Fit(2), 1},
-		31:  {21, 31, types.Fit(2), 1},
-		32:  {22, 32, types.Fit(3), 1},
-		33:  {23, 33, types.Fit(4), 1},
-		39:  {24, 39, types.Fit(4), 1},
-		40:  {25, 40, types.Fit(4), 1},
-		41:  {26, 41, types.Fit(4), 1},
-		42:  {27, 42, types.Fit(0), 1},
-		43:  {28, 43, types.Fit(2), 1},
-		44:  {29, 44, types.Fit(2), 1},
-		45:  {30, 45, types.Fit(2), 1},
-		46:  {31, 46, types.Fit(2), 1},
-		47:  {32, 47, types.Fit(2), 1},
-		48:  {33, 48, types.Fit(2), 1},
-		49:  {34, 49, types.Fit(0), 1},
-		50:  {35, 50, types.Fit(2), 1},
-		51:  {36, 51, types.Fit(4), 1},
-		52:  {37, 52, types.Fit(4), 1},
-		53:  {38, 53, types.Fit(2), 1},
-		54:  {39, 54, types.Fit(4), 1},
-		55:  {40, 55, types.Fit(4), 1},
-		56:  {41, 56, types.Fit(4), 1},
-		57:  {42, 57, types.Fit(4), 1},
-		58:  {43, 58, types.Fit(4), 1},
-		59:  {44, 59, types.Fit(4), 1},
-		62:  {45, 62, types.Fit(2), 1},
-		73:  {46, 73, types.Fit(6), 1},
-		78:  {47, 78, types.Fit(6), 1},
+		253: {0, 253, types.Fit(70), 1, "timestamp", 1, 0, "s"},
+		0:   {1, 0, types.Fit(197), 1, "position_lat", 1, 0, "semicircles"},
+		1:   {2, 1, types.Fit(261), 1, "position_long", 1, 0, "semicircles"},
+		2:   {3, 2, types.Fit(4), 1, "altitude", 5, 500, "m"},
+		3:   {4, 3, types.Fit(2), 1, "heart_rate", 1, 0, "bpm"},
+		4:   {5, 4, types.Fit(2), 1, "cadence", 1, 0, "rpm"},
+		5:   {6, 5, types.Fit(6), 1, "distance", 100, 0, "m"},
+		6:   {7, 6, types.Fit(4), 1, "speed", 1000, 0, "m/s"},
+		7:   {8, 7, types.Fit(4), 1, "power", 1, 0, "watts"},
+		8:   {9, 8, types.Fit(45), 3, "compressed_speed_distance", 1, 0, ""},
+		9:   {10, 9, types.Fit(3), 1, "grade", 100, 0, "%"},
+		10:  {11, 10, types.Fit(2), 1, "resistance", 1, 0, ""},
+		11:  {12, 11, types.Fit(5), 1, "time_from_course", 1000, 0, "s"},
+		12:  {13, 12, types.Fit(2), 1, "cycle_length", 100, 0, "m"},
+		13:  {14, 13, types.Fit(1), 1, "temperature", 1, 0, "C"},
+		17:  {15, 17, types.Fit(34), 5, "speed_1s", 16, 0, "m/s"},
+		18:  {16, 18, types.Fit(2), 1, "cycles", 1, 0, "cycles"},
+		19:  {17, 19, types.Fit(6), 1, "total_cycles", 1, 0, "cycles"},
+		28:  {18, 28, types.Fit(4), 1, "compressed_accumulated_power", 1, 0, "watts"},
+		29:  {19, 29, types.Fit(6), 1, "accumulated_power", 1, 0, "watts"},
+		30:  {20, 30, types.Fit(2), 1, "left_right_balance", 1, 0, ""},
+		31:  {21, 31, types.Fit(2), 1, "gps_accuracy", 1, 0, "m"},
+		32:  {22, 32, types.Fit(3), 1, "vertical_speed", 1000, 0, "m/s"},
+		33:  {23, 33, types.Fit(4), 1, "calories", 1, 0, "kcal"},
+		39:  {24, 39, types.Fit(4), 1, "vertical_oscillation", 10, 0, "mm"},
+		40:  {25, 40, types.Fit(4), 1, "stance_time_percent", 100, 0, "percent"},
+		41:  {26, 41, types.Fit(4), 1, "stance_time", 10, 0, "ms"},
+		42:  {27, 42, types.Fit(0), 1, "activity_type", 1, 0, ""},
+		43:  {28, 43, types.Fit(2), 1, "left_torque_effectiveness", 2, 0, "percent"},
+		44:  {29, 44, types.Fit(2), 1, "right_torque_effectiveness", 2, 0, "percent"},
+		45:  {30, 45, types.Fit(2), 1, "left_pedal_smoothness", 2, 0, "percent"},
+		46:  {31, 46, types.Fit(2), 1, "right_pedal_smoothness", 2, 0, "percent"},
+		47:  {32, 47, types.Fit(2), 1, "combined_pedal_smoothness", 2, 0, "percent"},
+		48:  {33, 48, types.Fit(2), 1, "time128", 128, 0, "s"},
+		49:  {34, 49, types.Fit(0), 1, "stroke_type", 1, 0, ""},
+		50:  {35, 50, types.Fit(2), 1, "zone", 1, 0, ""},
+		51:  {36, 51, types.Fit(4), 1, "ball_speed", 100, 0, "m/s"},
+		52:  {37, 52, types.Fit(4), 1, "cadence256", 256, 0, "rpm"},
+		53:  {38, 53, types.Fit(2), 1, "fractional_cadence", 128, 0, "rpm"},
+		54:  {39, 54, types.Fit(4), 1, "total_hemoglobin_conc", 100, 0, "g/dL"},
+		55:  {40, 55, types.Fit(4), 1, "total_hemoglobin_conc_min", 100, 0, "g/dL"},
+		56:  {41, 56, types.Fit(4), 1, "total_hemoglobin_conc_max", 100, 0, "g/dL"},
+		57:  {42, 57, types.Fit(4), 1, "saturated_hemoglobin_percent", 10, 0, "%"},
+		58:  {43, 58, types.Fit(4), 1, "saturated_hemoglobin_percent_min", 10, 0, "%"},
+		59:  {44, 59, types.Fit(4), 1, "saturated_hemoglobin_percent_max", 10, 0, "%"},
+		62:  {45, 62, types.Fit(2), 1, "device_index", 1, 0, ""},
+		73:  {46, 73, types.Fit(6), 1, "enhanced_speed", 1000, 0, "m/s"},
+		78:  {47, 78, types.Fit(6), 1, "enhanced_altitude", 5, 500, "m"},
 	},
 
 	MesgNumEvent: {
-		253: {0, 253, types.Fit(70), 1},
-		0:   {1, 0, types.Fit(0), 1},
-		1:   {2, 1, types.Fit(0), 1},
-		2:   {3, 2, types.Fit(4), 1},
-		3:   {4, 3, types.Fit(6), 1},
-		4:   {5, 4, types.Fit(2), 1},
-		7:   {6, 7, types.Fit(4), 1},
-		8:   {7, 8, types.Fit(4), 1},
-		9:   {8, 9, types.Fit(10), 1},
-		10:  {9, 10, types.Fit(10), 1},
-		11:  {10, 11, types.Fit(10), 1},
-		12:  {11, 12, types.Fit(10), 1},
-		21:  {12, 21, types.Fit(0), 1},
-		22:  {13, 22, types.Fit(2), 1},
+		253: {0, 253, types.Fit(70), 1, "timestamp", 1, 0, "s"},
+		0:   {1, 0, types.Fit(0), 1, "event", 1, 0, ""},
+		1:   {2, 1, types.Fit(0), 1, "event_type", 1, 0, ""},
+		2:   {3, 2, types.Fit(4), 1, "data16", 1, 0, ""},
+		3:   {4, 3, types.Fit(6), 1, "data", 1, 0, ""},
+		4:   {5, 4, types.Fit(2), 1, "event_group", 1, 0, ""},
+		7:   {6, 7, types.Fit(4), 1, "score", 1, 0, ""},
+		8:   {7, 8, types.Fit(4), 1, "opponent_score", 1, 0, ""},
+		9:   {8, 9, types.Fit(10), 1, "front_gear_num", 1, 0, ""},
+		10:  {9, 10, types.Fit(10), 1, "front_gear", 1, 0, ""},
+		11:  {10, 11, types.Fit(10), 1, "rear_gear_num", 1, 0, ""},
+		12:  {11, 12, types.Fit(10), 1, "rear_gear", 1, 0, ""},
+		21:  {12, 21, types.Fit(0), 1, "radar_threat_level_max", 1, 0, ""},
+		22:  {13, 22, types.Fit(2), 1, "radar_threat_count", 1, 0, ""},
 	},
 
 	MesgNumDeviceInfo: {
-		253: {0, 253, types.Fit(70), 1},
-		0:   {1, 0, types.Fit(2), 1},
-		1:   {2, 1, types.Fit(2), 1},
-		2:   {3, 2, types.Fit(4), 1},
-		3:   {4, 3, types.Fit(12), 1},
-		4:   {5, 4, types.Fit(4), 1},
-		5:   {6, 5, types.Fit(4), 1},
-		6:   {7, 6, types.Fit(2), 1},
-		7:   {8, 7, types.Fit(6), 1},
-		10:  {9, 10, types.Fit(4), 1},
-		11:  {10, 11, types.Fit(2), 1},
-		18:  {11, 18, types.Fit(0), 1},
-		19:  {12, 19, types.Fit(7), 1},
-		20:  {13, 20, types.Fit(10), 1},
-		21:  {14, 21, types.Fit(11), 1},
-		22:  {15, 22, types.Fit(0), 1},
-		25:  {16, 25, types.Fit(0), 1},
-		27:  {17, 27, types.Fit(7), 20},
+		253: {0, 253, types.Fit(70), 1, "timestamp", 1, 0, "s"},
+		0:   {1, 0, types.Fit(2), 1, "device_index", 1, 0, ""},
+		1:   {2, 1, types.Fit(2), 1, "device_type", 1, 0, ""},
+		2:   {3, 2, types.Fit(4), 1, "manufacturer", 1, 0, ""},
+		3:   {4, 3, types.Fit(12), 1, "serial_number", 1, 0, ""},
+		4:   {5, 4, types.Fit(4), 1, "product", 1, 0, ""},
+		5:   {6, 5, types.Fit(4), 1, "software_version", 100, 0, ""},
+		6:   {7, 6, types.Fit(2), 1, "hardware_version", 1, 0, ""},
+		7:   {8, 7, types.Fit(6), 1, "cum_operating_time", 1, 0, "s"},
+		10:  {9, 10, types.Fit(4), 1, "battery_voltage", 256, 0, "V"},
+		11:  {10, 11, types.Fit(2), 1, "battery_status", 1, 0, ""},
+		18:  {11, 18, types.Fit(0), 1, "sensor_position", 1, 0, ""},
+		19:  {12, 19, types.Fit(7), 1, "descriptor", 1, 0, ""},
+		20:  {13, 20, types.Fit(10), 1, "ant_transmission_type", 1, 0, ""},
+		21:  {14, 21, types.Fit(11), 1, "ant_device_number", 1, 0, ""},
+		22:  {15, 22, types.Fit(0), 1, "ant_network", 1, 0, ""},
+		25:  {16, 25, types.Fit(0), 1, "source_type", 1, 0, ""},
+		27:  {17, 27, types.Fit(7), 20, "product_name", 1, 0, ""},
 	},
 
 	MesgNumDeviceAuxBatteryInfo: {
-		253: {0, 253, types.Fit(70), 1},
-		0:   {1, 0, types.Fit(2), 1},
-		1:   {2, 1, types.Fit(4), 1},
-		2:   {3, 2, types.Fit(2), 1},
-		3:   {4, 3, types.Fit(2), 1},
+		253: {0, 253, types.Fit(70), 1, "timestamp", 1, 0, ""},
+		0:   {1, 0, types.Fit(2), 1, "device_index", 1, 0, ""},
+		1:   {2, 1, types.Fit(4), 1, "battery_voltage", 256, 0, "V"},
+		2:   {3, 2, types.Fit(2), 1, "battery_status", 1, 0, ""},
+		3:   {4, 3, types.Fit(2), 1, "battery_identifier", 1, 0, ""},
 	},
 
 	MesgNumTrainingFile: {
-		253: {0, 253, types.Fit(70), 1},
-		0:   {1, 0, types.Fit(0), 1},
-		1:   {2, 1, types.Fit(4), 1},
-		2:   {3, 2, types.Fit(4), 1},
-		3:   {4, 3, types.Fit(12), 1},
-		4:   {5, 4, types.Fit(70), 1},
+		253: {0, 253, types.Fit(70), 1, "timestamp", 1, 0, ""},
+		0:   {1, 0, types.Fit(0), 1, "type", 1, 0, ""},
+		1:   {2, 1, types.Fit(4), 1, "manufacturer", 1, 0, ""},
+		2:   {3, 2, types.Fit(4), 1, "product", 1, 0, ""},
+		3:   {4, 3, types.Fit(12), 1, "serial_number", 1, 0, ""},
+		4:   {5, 4, types.Fit(70), 1, "time_created", 1, 0, ""},
 	},
 
 	MesgNumWeatherConditions: {
-		253: {0, 253, types.Fit(70), 1},
-		0:   {1, 0, types.Fit(0), 1},
-		1:   {2, 1, types.Fit(1), 1},
-		2:   {3, 2, types.Fit(0), 1},
-		3:   {4, 3, types.Fit(4), 1},
-		4:   {5, 4, types.Fit(4), 1},
-		5:   {6, 5, types.Fit(2), 1},
-		6:   {7, 6, types.Fit(1), 1},
-		7:   {8, 7, types.Fit(2), 1},
-		8:   {9, 8, types.Fit(7), 64},
-		9:   {10, 9, types.Fit(70), 1},
-		10:  {11, 10, types.Fit(197), 1},
-		11:  {12, 11, types.Fit(261), 1},
-		12:  {13, 12, types.Fit(0), 1},
-		13:  {14, 13, types.Fit(1), 1},
-		14:  {15, 14, types.Fit(1), 1},
+		253: {0, 253, types.Fit(70), 1, "timestamp", 1, 0, ""},
+		0:   {1, 0, types.Fit(0), 1, "weather_report", 1, 0, ""},
+		1:   {2, 1, types.Fit(1), 1, "temperature", 1, 0, "C"},
+		2:   {3, 2, types.Fit(0), 1, "condition", 1, 0, ""},
+		3:   {4, 3, types.Fit(4), 1, "wind_direction", 1, 0, "degrees"},
+		4:   {5, 4, types.Fit(4), 1, "wind_speed", 1000, 0, "m/s"},
+		5:   {6, 5, types.Fit(2), 1, "precipitation_probability", 1, 0, ""},
+		6:   {7, 6, types.Fit(1), 1, "temperature_feels_like", 1, 0, "C"},
+		7:   {8, 7, types.Fit(2), 1, "relative_humidity", 1, 0, ""},
+		8:   {9, 8, types.Fit(7), 64, "location", 1, 0, ""},
+		9:   {10, 9, types.Fit(70), 1, "observed_at_time", 1, 0, ""},
+		10:  {11, 10, types.Fit(197), 1, "observed_location_lat", 1, 0, "semicircles"},
+		11:  {12, 11, types.Fit(261), 1, "observed_location_long", 1, 0, "semicircles"},
+		12:  {13, 12, types.Fit(0), 1, "day_of_week", 1, 0, ""},
+		13:  {14, 13, types.Fit(1), 1, "high_temperature", 1, 0, "C"},
+		14:  {15, 14, types.Fit(1), 1, "low_temperature", 1, 0, "C"},
 	},
 
 	MesgNumWeatherAlert: {
-		253: {0, 253, types.Fit(70), 1},
-		0:   {1, 0, types.Fit(7), 12},
-		1:   {2, 1, types.Fit(70), 1},
-		2:   {3, 2, types.Fit(70), 1},
-		3:   {4, 3, types.Fit(0), 1},
-		4:   {5, 4, types.Fit(0), 1},
+		253: {0, 253, types.Fit(70), 1, "timestamp", 1, 0, ""},
+		0:   {1, 0, types.Fit(7), 12, "report_id", 1, 0, ""},
+		1:   {2, 1, types.Fit(70), 1, "issue_time", 1, 0, ""},
+		2:   {3, 2, types.Fit(70), 1, "expire_time", 1, 0, ""},
+		3:   {4, 3, types.Fit(0), 1, "severity", 1, 0, ""},
+		4:   {5, 4, types.Fit(0), 1, "type", 1, 0, ""},
 	},
 
 	MesgNumGpsMetadata: {},
@@ -760,44 +778,44 @@ var _fields = [...][256]*field{
 	MesgNumObdiiData: {},
 
 	MesgNumNmeaSentence: {
-		253: {0, 253, types.Fit(70), 1},
-		0:   {1, 0, types.Fit(4), 1},
-		1:   {2, 1, types.Fit(7), 83},
+		253: {0, 253, types.Fit(70), 1, "timestamp", 1, 0, "s"},
+		0:   {1, 0, types.Fit(4), 1, "timestamp_ms", 1, 0, "ms"},
+		1:   {2, 1, types.Fit(7), 83, "sentence", 1, 0, ""},
 	},
 
 	MesgNumAviationAttitude: {
-		253: {0, 253, types.Fit(70), 1},
-		0:   {1, 0, types.Fit(4), 1},
-		1:   {2, 1, types.Fit(38), 1},
-		2:   {3, 2, types.Fit(35), 1},
-		3:   {4, 3, types.Fit(35), 1},
-		4:   {5, 4, types.Fit(35), 1},
-		5:   {6, 5, types.Fit(35), 1},
-		6:   {7, 6, types.Fit(35), 1},
-		7:   {8, 7, types.Fit(32), 1},
-		8:   {9, 8, types.Fit(34), 1},
-		9:   {10, 9, types.Fit(36), 1},
-		10:  {11, 10, types.Fit(36), 1},
+		253: {0, 253, types.Fit(70), 1, "timestamp", 1, 0, "s"},
+		0:   {1, 0, types.Fit(4), 1, "timestamp_ms", 1, 0, "ms"},
+		1:   {2, 1, types.Fit(38), 1, "system_time", 1, 0, "ms"},
+		2:   {3, 2, types.Fit(35), 1, "pitch", 10430.379999999999, 0, "radians"},
+		3:   {4, 3, types.Fit(35), 1, "roll", 10430.379999999999, 0, "radians"},
+		4:   {5, 4, types.Fit(35), 1, "accel_lateral", 100, 0, "m/s^2"},
+		5:   {6, 5, types.Fit(35), 1, "accel_normal", 100, 0, "m/s^2"},
+		6:   {7, 6, types.Fit(35), 1, "turn_rate", 1024, 0, "radians/second"},
+		7:   {8, 7, types.Fit(32), 1, "stage", 1, 0, ""},
+		8:   {9, 8, types.Fit(34), 1, "attitude_stage_complete", 1, 0, "%"},
+		9:   {10, 9, types.Fit(36), 1, "track", 10430.379999999999, 0, "radians"},
+		10:  {11, 10, types.Fit(36), 1, "validity", 1, 0, ""},
 	},
 
 	MesgNumVideo: {},
 
 	MesgNumVideoTitle: {
-		254: {0, 254, types.Fit(4), 1},
-		0:   {1, 0, types.Fit(4), 1},
-		1:   {2, 1, types.Fit(7), 80},
+		254: {0, 254, types.Fit(4), 1, "message_index", 1, 0, ""},
+		0:   {1, 0, types.Fit(4), 1, "message_count", 1, 0, ""},
+		1:   {2, 1, types.Fit(7), 80, "text", 1, 0, ""},
 	},
 
 	MesgNumVideoDescription: {
-		254: {0, 254, types.Fit(4), 1},
-		0:   {1, 0, types.Fit(4), 1},
-		1:   {2, 1, types.Fit(7), 250},
+		254: {0, 254, types.Fit(4), 1, "message_index", 1, 0, ""},
+		0:   {1, 0, types.Fit(4), 1, "message_count", 1, 0, ""},
+		1:   {2, 1, types.Fit(7), 250, "text", 1, 0, ""},
 	},
 
 	MesgNumVideoClip: {},
 
 	MesgNumSet: {
-		9: {0, 9, types.Fit(4), 1},
+		9: {0, 9, types.Fit(4), 1, "weight_display_unit", 1, 0, ""},
 	},
 
 	MesgNumJump: {},
@@ -807,299 +825,299 @@ var _fields = [...][256]*field{
 	MesgNumClimbPro: {},
 
 	MesgNumFieldDescription: {
-		0:  {0, 0, types.Fit(2), 1},
-		1:  {1, 1, types.Fit(2), 1},
-		2:  {2, 2, types.Fit(2), 1},
-		3:  {3, 3, types.Fit(39), 64},
-		6:  {4, 6, types.Fit(2), 1},
-		7:  {5, 7, types.Fit(1), 1},
-		8:  {6, 8, types.Fit(39), 16},
-		13: {7, 13, types.Fit(4), 1},
-		14: {8, 14, types.Fit(4), 1},
-		15: {9, 15, types.Fit(2), 1},
+		0:  {0, 0, types.Fit(2), 1, "developer_data_index", 1, 0, ""},
+		1:  {1, 1, types.Fit(2), 1, "field_definition_number", 1, 0, ""},
+		2:  {2, 2, types.Fit(2), 1, "fit_base_type_id", 1, 0, ""},
+		3:  {3, 3, types.Fit(39), 64, "field_name", 1, 0, ""},
+		6:  {4, 6, types.Fit(2), 1, "scale", 1, 0, ""},
+		7:  {5, 7, types.Fit(1), 1, "offset", 1, 0, ""},
+		8:  {6, 8, types.Fit(39), 16, "units", 1, 0, ""},
+		13: {7, 13, types.Fit(4), 1, "fit_base_unit_id", 1, 0, ""},
+		14: {8, 14, types.Fit(4), 1, "native_mesg_num", 1, 0, ""},
+		15: {9, 15, types.Fit(2), 1, "native_field_num", 1, 0, ""},
 	},
 
 	MesgNumDeveloperDataId: {
-		0: {0, 0, types.Fit(45), 16},
-		1: {1, 1, types.Fit(45), 16},
-		2: {2, 2, types.Fit(4), 1},
-		3: {3, 3, types.Fit(2), 1},
-		4: {4, 4, types.Fit(6), 1},
+		0: {0, 0, types.Fit(45), 16, "developer_id", 1, 0, ""},
+		1: {1, 1, types.Fit(45), 16, "application_id", 1, 0, ""},
+		2: {2, 2, types.Fit(4), 1, "manufacturer_id", 1, 0, ""},
+		3: {3, 3, types.Fit(2), 1, "developer_data_index", 1, 0, ""},
+		4: {4, 4, types.Fit(6), 1, "application_version", 1, 0, ""},
 	},
 
 	MesgNumCourse: {
-		4: {0, 4, types.Fit(0), 1},
-		5: {1, 5, types.Fit(7), 16},
-		6: {2, 6, types.Fit(12), 1},
-		7: {3, 7, types.Fit(0), 1},
+		4: {0, 4, types.Fit(0), 1, "sport", 1, 0, ""},
+		5: {1, 5, types.Fit(7), 16, "name", 1, 0, ""},
+		6: {2, 6, types.Fit(12), 1, "capabilities", 1, 0, ""},
+		7: {3, 7, types.Fit(0), 1, "sub_sport", 1, 0, ""},
 	},
 
 	MesgNumCoursePoint: {
-		254: {0, 254, types.Fit(4), 1},
-		1:   {1, 1, types.Fit(70), 1},
-		2:   {2, 2, types.Fit(197), 1},
-		3:   {3, 3, types.Fit(261), 1},
-		4:   {4, 4, types.Fit(6), 1},
-		5:   {5, 5, types.Fit(0), 1},
-		6:   {6, 6, types.Fit(7), 16},
-		8:   {7, 8, types.Fit(0), 1},
+		254: {0, 254, types.Fit(4), 1, "message_index", 1, 0, ""},
+		1:   {1, 1, types.Fit(70), 1, "timestamp", 1, 0, ""},
+		2:   {2, 2, types.Fit(197), 1, "position_lat", 1, 0, "semicircles"},
+		3:   {3, 3, types.Fit(261), 1, "position_long", 1, 0, "semicircles"},
+		4:   {4, 4, types.Fit(6), 1, "distance", 100, 0, "m"},
+		5:   {5, 5, types.Fit(0), 1, "type", 1, 0, ""},
+		6:   {6, 6, types.Fit(7), 16, "name", 1, 0, ""},
+		8:   {7, 8, types.Fit(0), 1, "favorite", 1, 0, ""},
 	},
 
 	MesgNumSegmentId: {
-		0: {0, 0, types.Fit(7), 1},
-		1: {1, 1, types.Fit(7), 1},
-		2: {2, 2, types.Fit(0), 1},
-		3: {3, 3, types.Fit(0), 1},
-		4: {4, 4, types.Fit(6), 1},
-		5: {5, 5, types.Fit(6), 1},
-		6: {6, 6, types.Fit(2), 1},
-		7: {7, 7, types.Fit(0), 1},
-		8: {8, 8, types.Fit(0), 1},
+		0: {0, 0, types.Fit(7), 1, "name", 1, 0, ""},
+		1: {1, 1, types.Fit(7), 1, "uuid", 1, 0, ""},
+		2: {2, 2, types.Fit(0), 1, "sport", 1, 0, ""},
+		3: {3, 3, types.Fit(0), 1, "enabled", 1, 0, ""},
+		4: {4, 4, types.Fit(6), 1, "user_profile_primary_key", 1, 0, ""},
+		5: {5, 5, types.Fit(6), 1, "device_id", 1, 0, ""},
+		6: {6, 6, types.Fit(2), 1, "default_race_leader", 1, 0, ""},
+		7: {7, 7, types.Fit(0), 1, "delete_status", 1, 0, ""},
+		8: {8, 8, types.Fit(0), 1, "selection_type", 1, 0, ""},
 	},
 
 	MesgNumSegmentLeaderboardEntry: {
-		254: {0, 254, types.Fit(4), 1},
-		0:   {1, 0, types.Fit(7), 1},
-		1:   {2, 1, types.Fit(0), 1},
-		2:   {3, 2, types.Fit(6), 1},
-		3:   {4, 3, types.Fit(6), 1},
-		4:   {5, 4, types.Fit(6), 1},
+		254: {0, 254, types.Fit(4), 1, "message_index", 1, 0, ""},
+		0:   {1, 0, types.Fit(7), 1, "name", 1, 0, ""},
+		1:   {2, 1, types.Fit(0), 1, "type", 1, 0, ""},
+		2:   {3, 2, types.Fit(6), 1, "group_primary_key", 1, 0, ""},
+		3:   {4, 3, types.Fit(6), 1, "activity_id", 1, 0, ""},
+		4:   {5, 4, types.Fit(6), 1, "segment_time", 1000, 0, "s"},
 	},
 
 	MesgNumSegmentPoint: {
-		254: {0, 254, types.Fit(4), 1},
-		1:   {1, 1, types.Fit(197), 1},
-		2:   {2, 2, types.Fit(261), 1},
-		3:   {3, 3, types.Fit(6), 1},
-		4:   {4, 4, types.Fit(4), 1},
-		5:   {5, 5, types.Fit(38), 1},
-		6:   {6, 6, types.Fit(6), 1},
+		254: {0, 254, types.Fit(4), 1, "message_index", 1, 0, ""},
+		1:   {1, 1, types.Fit(197), 1, "position_lat", 1, 0, "semicircles"},
+		2:   {2, 2, types.Fit(261), 1, "position_long", 1, 0, "semicircles"},
+		3:   {3, 3, types.Fit(6), 1, "distance", 100, 0, "m"},
+		4:   {4, 4, types.Fit(4), 1, "altitude", 5, 500, "m"},
+		5:   {5, 5, types.Fit(38), 1, "leader_time", 1000, 0, "s"},
+		6:   {6, 6, types.Fit(6), 1, "enhanced_altitude", 5, 500, "m"},
 	},
 
 	MesgNumSegmentLap: {
-		254: {0, 254, types.Fit(4), 1},
-		253: {1, 253, types.Fit(70), 1},
-		0:   {2, 0, types.Fit(0), 1},
-		1:   {3, 1, types.Fit(0), 1},
-		2:   {4, 2, types.Fit(70), 1},
-		3:   {5, 3, types.Fit(197), 1},
-		4:   {6, 4, types.Fit(261), 1},
-		5:   {7, 5, types.Fit(197), 1},
-		6:   {8, 6, types.Fit(261), 1},
-		7:   {9, 7, types.Fit(6), 1},
-		8:   {10, 8, types.Fit(6), 1},
-		9:   {11, 9, types.Fit(6), 1},
-		10:  {12, 10, types.Fit(6), 1},
-		11:  {13, 11, types.Fit(4), 1},
-		12:  {14, 12, types.Fit(4), 1},
-		13:  {15, 13, types.Fit(4), 1},
-		14:  {16, 14, types.Fit(4), 1},
-		15:  {17, 15, types.Fit(2), 1},
-		16:  {18, 16, types.Fit(2), 1},
-		17:  {19, 17, types.Fit(2), 1},
-		18:  {20, 18, types.Fit(2), 1},
-		19:  {21, 19, types.Fit(4), 1},
-		20:  {22, 20, types.Fit(4), 1},
-		21:  {23, 21, types.Fit(4), 1},
-		22:  {24, 22, types.Fit(4), 1},
-		23:  {25, 23, types.Fit(0), 1},
-		24:  {26, 24, types.Fit(2), 1},
-		25:  {27, 25, types.Fit(197), 1},
-		26:  {28, 26, types.Fit(261), 1},
-		27:  {29, 27, types.Fit(197), 1},
-		28:  {30, 28, types.Fit(261), 1},
-		29:  {31, 29, types.Fit(7), 16},
-		30:  {32, 30, types.Fit(4), 1},
-		31:  {33, 31, types.Fit(4), 1},
-		32:  {34, 32, types.Fit(0), 1},
-		33:  {35, 33, types.Fit(6), 1},
-		34:  {36, 34, types.Fit(4), 1},
-		35:  {37, 35, types.Fit(4), 1},
-		36:  {38, 36, types.Fit(2), 1},
-		37:  {39, 37, types.Fit(3), 1},
-		38:  {40, 38, types.Fit(3), 1},
-		39:  {41, 39, types.Fit(3), 1},
-		40:  {42, 40, types.Fit(3), 1},
-		41:  {43, 41, types.Fit(3), 1},
-		42:  {44, 42, types.Fit(1), 1},
-		43:  {45, 43, types.Fit(1), 1},
-		44:  {46, 44, types.Fit(6), 1},
-		45:  {47, 45, types.Fit(3), 1},
-		46:  {48, 46, types.Fit(3), 1},
-		47:  {49, 47, types.Fit(3), 1},
-		48:  {50, 48, types.Fit(3), 1},
-		49:  {51, 49, types.Fit(38), 1},
-		50:  {52, 50, types.Fit(38), 1},
-		51:  {53, 51, types.Fit(38), 1},
-		52:  {54, 52, types.Fit(38), 1},
-		53:  {55, 53, types.Fit(4), 1},
-		54:  {56, 54, types.Fit(4), 1},
-		55:  {57, 55, types.Fit(2), 1},
-		56:  {58, 56, types.Fit(6), 1},
-		57:  {59, 57, types.Fit(4), 1},
-		58:  {60, 58, types.Fit(0), 1},
-		59:  {61, 59, types.Fit(2), 1},
-		60:  {62, 60, types.Fit(2), 1},
-		61:  {63, 61, types.Fit(2), 1},
-		62:  {64, 62, types.Fit(2), 1},
-		63:  {65, 63, types.Fit(2), 1},
-		64:  {66, 64, types.Fit(0), 1},
-		65:  {67, 65, types.Fit(7), 33},
-		66:  {68, 66, types.Fit(2), 1},
-		67:  {69, 67, types.Fit(2), 1},
-		68:  {70, 68, types.Fit(2), 1},
-		69:  {71, 69, types.Fit(4), 1},
-		70:  {72, 70, types.Fit(4), 1},
-		91:  {73, 91, types.Fit(6), 1},
-		92:  {74, 92, types.Fit(6), 1},
-		93:  {75, 93, types.Fit(6), 1},
+		254: {0, 254, types.Fit(4), 1, "message_index", 1, 0, ""},
+		253: {1, 253, types.Fit(70), 1, "timestamp", 1, 0, "s"},
+		0:   {2, 0, types.Fit(0), 1, "event", 1, 0, ""},
+		1:   {3, 1, types.Fit(0), 1, "event_type", 1, 0, ""},
+		2:   {4, 2, types.Fit(70), 1, "start_time", 1, 0, ""},
+		3:   {5, 3, types.Fit(197), 1, "start_position_lat", 1, 0, "semicircles"},
+		4:   {6, 4, types.Fit(261), 1, "start_position_long", 1, 0, "semicircles"},
+		5:   {7, 5, types.Fit(197), 1, "end_position_lat", 1, 0, "semicircles"},
+		6:   {8, 6, types.Fit(261), 1, "end_position_long", 1, 0, "semicircles"},
+		7:   {9, 7, types.Fit(6), 1, "total_elapsed_time", 1000, 0, "s"},
+		8:   {10, 8, types.Fit(6), 1, "total_timer_time", 1000, 0, "s"},
+		9:   {11, 9, types.Fit(6), 1, "total_distance", 100, 0, "m"},
+		10:  {12, 10, types.Fit(6), 1, "total_cycles", 1, 0, "cycles"},
+		11:  {13, 11, types.Fit(4), 1, "total_calories", 1, 0, "kcal"},
+		12:  {14, 12, types.Fit(4), 1, "total_fat_calories", 1, 0, "kcal"},
+		13:  {15, 13, types.Fit(4), 1, "avg_speed", 1000, 0, "m/s"},
+		14:  {16, 14, types.Fit(4), 1, "max_speed", 1000, 0, "m/s"},
+		15:  {17, 15, types.Fit(2), 1, "avg_heart_rate", 1, 0, "bpm"},
+		16:  {18, 16, types.Fit(2), 1, "max_heart_rate", 1, 0, "bpm"},
+		17:  {19, 17, types.Fit(2), 1, "avg_cadence", 1, 0, "rpm"},
+		18:  {20, 18, types.Fit(2), 1, "max_cadence", 1, 0, "rpm"},
+		19:  {21, 19, types.Fit(4), 1, "avg_power", 1, 0, "watts"},
+		20:  {22, 20, types.Fit(4), 1, "max_power", 1, 0, "watts"},
+		21:  {23, 21, types.Fit(4), 1, "total_ascent", 1, 0, "m"},
+		22:  {24, 22, types.Fit(4), 1, "total_descent", 1, 0, "m"},
+		23:  {25, 23, types.Fit(0), 1, "sport", 1, 0, ""},
+		24:  {26, 24, types.Fit(2), 1, "event_group", 1, 0, ""},
+		25:  {27, 25, types.Fit(197), 1, "nec_lat", 1, 0, "semicircles"},
+		26:  {28, 26, types.Fit(261), 1, "nec_long", 1, 0, "semicircles"},
+		27:  {29, 27, types.Fit(197), 1, "swc_lat", 1, 0, "semicircles"},
+		28:  {30, 28, types.Fit(261), 1, "swc_long", 1, 0, "semicircles"},
+		29:  {31, 29, types.Fit(7), 16, "name", 1, 0, ""},
+		30:  {32, 30, types.Fit(4), 1, "normalized_power", 1, 0, "watts"},
+		31:  {33, 31, types.Fit(4), 1, "left_right_balance", 1, 0, ""},
+		32:  {34, 32, types.Fit(0), 1, "sub_sport", 1, 0, ""},
+		33:  {35, 33, types.Fit(6), 1, "total_work", 1, 0, "J"},
+		34:  {36, 34, types.Fit(4), 1, "avg_altitude", 5, 500, "m"},
+		35:  {37, 35, types.Fit(4), 1, "max_altitude", 5, 500, "m"},
+		36:  {38, 36, types.Fit(2), 1, "gps_accuracy", 1, 0, "m"},
+		37:  {39, 37, types.Fit(3), 1, "avg_grade", 100, 0, "%"},
+		38:  {40, 38, types.Fit(3), 1, "avg_pos_grade", 100, 0, "%"},
+		39:  {41, 39, types.Fit(3), 1, "avg_neg_grade", 100, 0, "%"},
+		40:  {42, 40, types.Fit(3), 1, "max_pos_grade", 100, 0, "%"},
+		41:  {43, 41, types.Fit(3), 1, "max_neg_grade", 100, 0, "%"},
+		42:  {44, 42, types.Fit(1), 1, "avg_temperature", 1, 0, "C"},
+		43:  {45, 43, types.Fit(1), 1, "max_temperature", 1, 0, "C"},
+		44:  {46, 44, types.Fit(6), 1, "total_moving_time", 1000, 0, "s"},
+		45:  {47, 45, types.Fit(3), 1, "avg_pos_vertical_speed", 1000, 0, "m/s"},
+		46:  {48, 46, types.Fit(3), 1, "avg_neg_vertical_speed", 1000, 0, "m/s"},
+		47:  {49, 47, types.Fit(3), 1, "max_pos_vertical_speed", 1000, 0, "m/s"},
+		48:  {50, 48, types.Fit(3), 1, "max_neg_vertical_speed", 1000, 0, "m/s"},
+		49:  {51, 49, types.Fit(38), 1, "time_in_hr_zone", 1000, 0, "s"},
+		50:  {52, 50, types.Fit(38), 1, "time_in_speed_zone", 1000, 0, "s"},
+		51:  {53, 51, types.Fit(38), 1, "time_in_cadence_zone", 1000, 0, "s"},
+		52:  {54, 52, types.Fit(38), 1, "time_in_power_zone", 1000, 0, "s"},
+		53:  {55, 53, types.Fit(4), 1, "repetition_num", 1, 0, ""},
+		54:  {56, 54, types.Fit(4), 1, "min_altitude", 5, 500, "m"},
+		55:  {57, 55, types.Fit(2), 1, "min_heart_rate", 1, 0, "bpm"},
+		56:  {58, 56, types.Fit(6), 1, "active_time", 1000, 0, "s"},
+		57:  {59, 57, types.Fit(4), 1, "wkt_step_index", 1, 0, ""},
+		58:  {60, 58, types.Fit(0), 1, "sport_event", 1, 0, ""},
+		59:  {61, 59, types.Fit(2), 1, "avg_left_torque_effectiveness", 2, 0, "percent"},
+		60:  {62, 60, types.Fit(2), 1, "avg_right_torque_effectiveness", 2, 0, "percent"},
+		61:  {63, 61, types.Fit(2), 1, "avg_left_pedal_smoothness", 2, 0, "percent"},
+		62:  {64, 62, types.Fit(2), 1, "avg_right_pedal_smoothness", 2, 0, "percent"},
+		63:  {65, 63, types.Fit(2), 1, "avg_combined_pedal_smoothness", 2, 0, "percent"},
+		64:  {66, 64, types.Fit(0), 1, "status", 1, 0, ""},
+		65:  {67, 65, types.Fit(7), 33, "uuid", 1, 0, ""},
+		66:  {68, 66, types.Fit(2), 1, "avg_fractional_cadence", 128, 0, "rpm"},
+		67:  {69, 67, types.Fit(2), 1, "max_fractional_cadence", 128, 0, "rpm"},
+		68:  {70, 68, types.Fit(2), 1, "total_fractional_cycles", 128, 0, "cycles"},
+		69:  {71, 69, types.Fit(4), 1, "front_gear_shift_count", 1, 0, ""},
+		70:  {72, 70, types.Fit(4), 1, "rear_gear_shift_count", 1, 0, ""},
+		91:  {73, 91, types.Fit(6), 1, "enhanced_avg_altitude", 5, 500, "m"},
+		92:  {74, 92, types.Fit(6), 1, "enhanced_max_altitude", 5, 500, "m"},
+		93:  {75, 93, types.Fit(6), 1, "enhanced_min_altitude", 5, 500, "m"},
 	},
 
 	MesgNumSegmentFile: {
-		254: {0, 254, types.Fit(4), 1},
-		1:   {1, 1, types.Fit(7), 1},
-		3:   {2, 3, types.Fit(0), 1},
-		4:   {3, 4, types.Fit(6), 1},
-		7:   {4, 7, types.Fit(32), 1},
-		8:   {5, 8, types.Fit(38), 1},
-		9:   {6, 9, types.Fit(38), 1},
+		254: {0, 254, types.Fit(4), 1, "message_index", 1, 0, ""},
+		1:   {1, 1, types.Fit(7), 1, "file_uuid", 1, 0, ""},
+		3:   {2, 3, types.Fit(0), 1, "enabled", 1, 0, ""},
+		4:   {3, 4, types.Fit(6), 1, "user_profile_primary_key", 1, 0, ""},
+		7:   {4, 7, types.Fit(32), 1, "leader_type", 1, 0, ""},
+		8:   {5, 8, types.Fit(38), 1, "leader_group_primary_key", 1, 0, ""},
+		9:   {6, 9, types.Fit(38), 1, "leader_activity_id", 1, 0, ""},
 	},
 
 	MesgNumWorkout: {
-		254: {0, 254, types.Fit(4), 1},
-		4:   {1, 4, types.Fit(0), 1},
-		5:   {2, 5, types.Fit(12), 1},
-		6:   {3, 6, types.Fit(4), 1},
-		8:   {4, 8, types.Fit(7), 16},
-		11:  {5, 11, types.Fit(0), 1},
-		14:  {6, 14, types.Fit(4), 1},
-		15:  {7, 15, types.Fit(0), 1},
+		254: {0, 254, types.Fit(4), 1, "message_index", 1, 0, ""},
+		4:   {1, 4, types.Fit(0), 1, "sport", 1, 0, ""},
+		5:   {2, 5, types.Fit(12), 1, "capabilities", 1, 0, ""},
+		6:   {3, 6, types.Fit(4), 1, "num_valid_steps", 1, 0, ""},
+		8:   {4, 8, types.Fit(7), 16, "wkt_name", 1, 0, ""},
+		11:  {5, 11, types.Fit(0), 1, "sub_sport", 1, 0, ""},
+		14:  {6, 14, types.Fit(4), 1, "pool_length", 100, 0, "m"},
+		15:  {7, 15, types.Fit(0), 1, "pool_length_unit", 1, 0, ""},
 	},
 
 	MesgNumWorkoutSession: {
-		254: {0, 254, types.Fit(4), 1},
-		0:   {1, 0, types.Fit(0), 1},
-		1:   {2, 1, types.Fit(0), 1},
-		2:   {3, 2, types.Fit(4), 1},
-		3:   {4, 3, types.Fit(4), 1},
-		4:   {5, 4, types.Fit(4), 1},
-		5:   {6, 5, types.Fit(0), 1},
+		254: {0, 254, types.Fit(4), 1, "message_index", 1, 0, ""},
+		0:   {1, 0, types.Fit(0), 1, "sport", 1, 0, ""},
+		1:   {2, 1, types.Fit(0), 1, "sub_sport", 1, 0, ""},
+		2:   {3, 2, types.Fit(4), 1, "num_valid_steps", 1, 0, ""},
+		3:   {4, 3, types.Fit(4), 1, "first_step_index", 1, 0, ""},
+		4:   {5, 4, types.Fit(4), 1, "pool_length", 100, 0, "m"},
+		5:   {6, 5, types.Fit(0), 1, "pool_length_unit", 1, 0, ""},
 	},
 
 	MesgNumWorkoutStep: {
-		254: {0, 254, types.Fit(4), 1},
-		0:   {1, 0, types.Fit(7), 16},
-		1:   {2, 1, types.Fit(0), 1},
-		2:   {3, 2, types.Fit(6), 1},
-		3:   {4, 3, types.Fit(0), 1},
-		4:   {5, 4, types.Fit(6), 1},
-		5:   {6, 5, types.Fit(6), 1},
-		6:   {7, 6, types.Fit(6), 1},
-		7:   {8, 7, types.Fit(0), 1},
-		8:   {9, 8, types.Fit(7), 50},
-		9:   {10, 9, types.Fit(0), 1},
-		10:  {11, 10, types.Fit(4), 1},
-		19:  {12, 19, types.Fit(0), 1},
-		20:  {13, 20, types.Fit(6), 1},
-		21:  {14, 21, types.Fit(6), 1},
-		22:  {15, 22, types.Fit(6), 1},
+		254: {0, 254, types.Fit(4), 1, "message_index", 1, 0, ""},
+		0:   {1, 0, types.Fit(7), 16, "wkt_step_name", 1, 0, ""},
+		1:   {2, 1, types.Fit(0), 1, "duration_type", 1, 0, ""},
+		2:   {3, 2, types.Fit(6), 1, "duration_value", 1, 0, ""},
+		3:   {4, 3, types.Fit(0), 1, "target_type", 1, 0, ""},
+		4:   {5, 4, types.Fit(6), 1, "target_value", 1, 0, ""},
+		5:   {6, 5, types.Fit(6), 1, "custom_target_value_low", 1, 0, ""},
+		6:   {7, 6, types.Fit(6), 1, "custom_target_value_high", 1, 0, ""},
+		7:   {8, 7, types.Fit(0), 1, "intensity", 1, 0, ""},
+		8:   {9, 8, types.Fit(7), 50, "notes", 1, 0, ""},
+		9:   {10, 9, types.Fit(0), 1, "equipment", 1, 0, ""},
+		10:  {11, 10, types.Fit(4), 1, "exercise_category", 1, 0, ""},
+		19:  {12, 19, types.Fit(0), 1, "secondary_target_type", 1, 0, ""},
+		20:  {13, 20, types.Fit(6), 1, "secondary_target_value", 1, 0, ""},
+		21:  {14, 21, types.Fit(6), 1, "secondary_custom_target_value_low", 1, 0, ""},
+		22:  {15, 22, types.Fit(6), 1, "secondary_custom_target_value_high", 1, 0, ""},
 	},
 
 	MesgNumExerciseTitle: {
-		254: {0, 254, types.Fit(4), 1},
-		0:   {1, 0, types.Fit(4), 1},
-		1:   {2, 1, types.Fit(4), 1},
-		2:   {3, 2, types.Fit(39), 200},
+		254: {0, 254, types.Fit(4), 1, "message_index", 1, 0, ""},
+		0:   {1, 0, types.Fit(4), 1, "exercise_category", 1, 0, ""},
+		1:   {2, 1, types.Fit(4), 1, "exercise_name", 1, 0, ""},
+		2:   {3, 2, types.Fit(39), 200, "wkt_step_name", 1, 0, ""},
 	},
 
 	MesgNumSchedule: {
-		0: {0, 0, types.Fit(4), 1},
-		1: {1, 1, types.Fit(4), 1},
-		2: {2, 2, types.Fit(12), 1},
-		3: {3, 3, types.Fit(70), 1},
-		4: {4, 4, types.Fit(0), 1},
-		5: {5, 5, types.Fit(0), 1},
-		6: {6, 6, types.Fit(134), 1},
+		0: {0, 0, types.Fit(4), 1, "manufacturer", 1, 0, ""},
+		1: {1, 1, types.Fit(4), 1, "product", 1, 0, ""},
+		2: {2, 2, types.Fit(12), 1, "serial_number", 1, 0, ""},
+		3: {3, 3, types.Fit(70), 1, "time_created", 1, 0, ""},
+		4: {4, 4, types.Fit(0), 1, "completed", 1, 0, ""},
+		5: {5, 5, types.Fit(0), 1, "type", 1, 0, ""},
+		6: {6, 6, types.Fit(134), 1, "scheduled_time", 1, 0, ""},
 	},
 
 	MesgNumTotals: {
-		254: {0, 254, types.Fit(4), 1},
-		253: {1, 253, types.Fit(70), 1},
-		0:   {2, 0, types.Fit(6), 1},
-		1:   {3, 1, types.Fit(6), 1},
-		2:   {4, 2, types.Fit(6), 1},
-		3:   {5, 3, types.Fit(0), 1},
-		4:   {6, 4, types.Fit(6), 1},
-		5:   {7, 5, types.Fit(4), 1},
-		6:   {8, 6, types.Fit(6), 1},
+		254: {0, 254, types.Fit(4), 1, "message_index", 1, 0, ""},
+		253: {1, 253, types.Fit(70), 1, "timestamp", 1, 0, "s"},
+		0:   {2, 0, types.Fit(6), 1, "timer_time", 1, 0, "s"},
+		1:   {3, 1, types.Fit(6), 1, "distance", 1, 0, "m"},
+		2:   {4, 2, types.Fit(6), 1, "calories", 1, 0, "kcal"},
+		3:   {5, 3, types.Fit(0), 1, "sport", 1, 0, ""},
+		4:   {6, 4, types.Fit(6), 1, "elapsed_time", 1, 0, "s"},
+		5:   {7, 5, types.Fit(4), 1, "sessions", 1, 0, ""},
+		6:   {8, 6, types.Fit(6), 1, "active_time", 1, 0, "s"},
 	},
 
 	MesgNumWeightScale: {
-		253: {0, 253, types.Fit(70), 1},
-		0:   {1, 0, types.Fit(4), 1},
-		1:   {2, 1, types.Fit(4), 1},
-		2:   {3, 2, types.Fit(4), 1},
-		3:   {4, 3, types.Fit(4), 1},
-		4:   {5, 4, types.Fit(4), 1},
-		5:   {6, 5, types.Fit(4), 1},
-		7:   {7, 7, types.Fit(4), 1},
-		8:   {8, 8, types.Fit(2), 1},
-		9:   {9, 9, types.Fit(4), 1},
-		10:  {10, 10, types.Fit(2), 1},
-		11:  {11, 11, types.Fit(2), 1},
-		12:  {12, 12, types.Fit(4), 1},
-		13:  {13, 13, types.Fit(4), 1},
+		253: {0, 253, types.Fit(70), 1, "timestamp", 1, 0, "s"},
+		0:   {1, 0, types.Fit(4), 1, "weight", 100, 0, "kg"},
+		1:   {2, 1, types.Fit(4), 1, "percent_fat", 100, 0, "%"},
+		2:   {3, 2, types.Fit(4), 1, "percent_hydration", 100, 0, "%"},
+		3:   {4, 3, types.Fit(4), 1, "visceral_fat_mass", 100, 0, "kg"},
+		4:   {5, 4, types.Fit(4), 1, "bone_mass", 100, 0, "kg"},
+		5:   {6, 5, types.Fit(4), 1, "muscle_mass", 100, 0, "kg"},
+		7:   {7, 7, types.Fit(4), 1, "basal_met", 4, 0, "kcal/day"},
+		8:   {8, 8, types.Fit(2), 1, "physique_rating", 1, 0, ""},
+		9:   {9, 9, types.Fit(4), 1, "active_met", 4, 0, "kcal/day"},
+		10:  {10, 10, types.Fit(2), 1, "metabolic_age", 1, 0, "years"},
+		11:  {11, 11, types.Fit(2), 1, "visceral_fat_rating", 1, 0, ""},
+		12:  {12, 12, types.Fit(4), 1, "user_profile_index", 1, 0, ""},
+		13:  {13, 13, types.Fit(4), 1, "bmi", 10, 0, "kg/m^2"},
 	},
 
 	MesgNumBloodPressure: {
-		253: {0, 253, types.Fit(70), 1},
-		0:   {1, 0, types.Fit(4), 1},
-		1:   {2, 1, types.Fit(4), 1},
-		2:   {3, 2, types.Fit(4), 1},
-		3:   {4, 3, types.Fit(4), 1},
-		4:   {5, 4, types.Fit(4), 1},
-		5:   {6, 5, types.Fit(4), 1},
-		6:   {7, 6, types.Fit(2), 1},
-		7:   {8, 7, types.Fit(0), 1},
-		8:   {9, 8, types.Fit(0), 1},
-		9:   {10, 9, types.Fit(4), 1},
+		253: {0, 253, types.Fit(70), 1, "timestamp", 1, 0, "s"},
+		0:   {1, 0, types.Fit(4), 1, "systolic_pressure", 1, 0, "mmHg"},
+		1:   {2, 1, types.Fit(4), 1, "diastolic_pressure", 1, 0, "mmHg"},
+		2:   {3, 2, types.Fit(4), 1, "mean_arterial_pressure", 1, 0, "mmHg"},
+		3:   {4, 3, types.Fit(4), 1, "map_3_sample_mean", 1, 0, "mmHg"},
+		4:   {5, 4, types.Fit(4), 1, "map_morning_values", 1, 0, "mmHg"},
+		5:   {6, 5, types.Fit(4), 1, "map_evening_values", 1, 0, "mmHg"},
+		6:   {7, 6, types.Fit(2), 1, "heart_rate", 1, 0, "bpm"},
+		7:   {8, 7, types.Fit(0), 1, "heart_rate_type", 1, 0, ""},
+		8:   {9, 8, types.Fit(0), 1, "status", 1, 0, ""},
+		9:   {10, 9, types.Fit(4), 1, "user_profile_index", 1, 0, ""},
 	},
 
 	MesgNumMonitoringInfo: {
-		253: {0, 253, types.Fit(70), 1},
-		0:   {1, 0, types.Fit(134), 1},
+		253: {0, 253, types.Fit(70), 1, "timestamp", 1, 0, "s"},
+		0:   {1, 0, types.Fit(134), 1, "local_timestamp", 1, 0, "s"},
 	},
 
 	MesgNumMonitoring: {
-		253: {0, 253, types.Fit(70), 1},
-		0:   {1, 0, types.Fit(2), 1},
-		1:   {2, 1, types.Fit(4), 1},
-		2:   {3, 2, types.Fit(6), 1},
-		3:   {4, 3, types.Fit(6), 1},
-		4:   {5, 4, types.Fit(6), 1},
-		5:   {6, 5, types.Fit(0), 1},
-		6:   {7, 6, types.Fit(0), 1},
-		8:   {8, 8, types.Fit(4), 1},
-		9:   {9, 9, types.Fit(4), 1},
-		10:  {10, 10, types.Fit(4), 1},
-		11:  {11, 11, types.Fit(134), 1},
+		253: {0, 253, types.Fit(70), 1, "timestamp", 1, 0, "s"},
+		0:   {1, 0, types.Fit(2), 1, "device_index", 1, 0, ""},
+		1:   {2, 1, types.Fit(4), 1, "calories", 1, 0, "kcal"},
+		2:   {3, 2, types.Fit(6), 1, "distance", 100, 0, "m"},
+		3:   {4, 3, types.Fit(6), 1, "cycles", 2, 0, "cycles"},
+		4:   {5, 4, types.Fit(6), 1, "active_time", 1000, 0, "s"},
+		5:   {6, 5, types.Fit(0), 1, "activity_type", 1, 0, ""},
+		6:   {7, 6, types.Fit(0), 1, "activity_subtype", 1, 0, ""},
+		8:   {8, 8, types.Fit(4), 1, "distance_16", 1, 0, "100 * m"},
+		9:   {9, 9, types.Fit(4), 1, "cycles_16", 1, 0, "2 * cycles (steps)"},
+		10:  {10, 10, types.Fit(4), 1, "active_time_16", 1, 0, "s"},
+		11:  {11, 11, types.Fit(134), 1, "local_timestamp", 1, 0, ""},
 	},
 
 	MesgNumMonitoringHrData: {
-		253: {0, 253, types.Fit(70), 1},
-		0:   {1, 0, types.Fit(2), 1},
-		1:   {2, 1, types.Fit(2), 1},
+		253: {0, 253, types.Fit(70), 1, "timestamp", 1, 0, ""},
+		0:   {1, 0, types.Fit(2), 1, "resting_heart_rate", 1, 0, ""},
+		1:   {2, 1, types.Fit(2), 1, "current_day_resting_heart_rate", 1, 0, ""},
 	},
 
 	MesgNumSpo2Data: {},
 
 	MesgNumHr: {
-		253: {0, 253, types.Fit(70), 1},
-		0:   {1, 0, types.Fit(4), 1},
-		1:   {2, 1, types.Fit(2), 1},
-		6:   {3, 6, types.Fit(34), 1},
-		9:   {4, 9, types.Fit(38), 1},
-		10:  {5, 10, types.Fit(45), 1},
+		253: {0, 253, types.Fit(70), 1, "timestamp", 1, 0, ""},
+		0:   {1, 0, types.Fit(4), 1, "fractional_timestamp", 32768, 0, "s"},
+		1:   {2, 1, types.Fit(2), 1, "time256", 256, 0, "s"},
+		6:   {3, 6, types.Fit(34), 1, "filtered_bpm", 1, 0, "bpm"},
+		9:   {4, 9, types.Fit(38), 1, "event_timestamp", 1024, 0, "s"},
+		10:  {5, 10, types.Fit(45), 1, "event_timestamp_12", 1, 0, ""},
 	},
 
 	MesgNumStressLevel: {},
@@ -1113,60 +1131,64 @@ var _fields = [...][256]*field{
 	MesgNumAntChannelId: {},
 
 	MesgNumAntRx: {
-		253: {0, 253, types.Fit(70), 1},
-		0:   {1, 0, types.Fit(4), 1},
-		1:   {2, 1, types.Fit(13), 1},
-		2:   {3, 2, types.Fit(45), 9},
-		3:   {4, 3, types.Fit(2), 1},
-		4:   {5, 4, types.Fit(45), 8},
+		253: {0, 253, types.Fit(70), 1, "timestamp", 1, 0, "s"},
+		0:   {1, 0, types.Fit(4), 1, "fractional_timestamp", 32768, 0, "s"},
+		1:   {2, 1, types.Fit(13), 1, "mesg_id", 1, 0, ""},
+		2:   {3, 2, types.Fit(45), 9, "mesg_data", 1, 0, ""},
+		3:   {4, 3, types.Fit(2), 1, "channel_number", 1, 0, ""},
+		4:   {5, 4, types.Fit(45), 8, "data", 1, 0, ""},
 	},
 
 	MesgNumAntTx: {
-		253: {0, 253, types.Fit(70), 1},
-		0:   {1, 0, types.Fit(4), 1},
-		1:   {2, 1, types.Fit(13), 1},
-		2:   {3, 2, types.Fit(45), 9},
-		3:   {4, 3, types.Fit(2), 1},
-		4:   {5, 4, types.Fit(45), 8},
+		253: {0, 253, types.Fit(70), 1, "timestamp", 1, 0, "s"},
+		0:   {1, 0, types.Fit(4), 1, "fractional_timestamp", 32768, 0, "s"},
+		1:   {2, 1, types.Fit(13), 1, "mesg_id", 1, 0, ""},
+		2:   {3, 2, types.Fit(45), 9, "mesg_data", 1, 0, ""},
+		3:   {4, 3, types.Fit(2), 1, "channel_number", 1, 0, ""},
+		4:   {5, 4, types.Fit(45), 8, "data", 1, 0, ""},
 	},
 
 	MesgNumExdScreenConfiguration: {
-		0: {0, 0, types.Fit(2), 1},
-		1: {1, 1, types.Fit(2), 1},
-		2: {2, 2, types.Fit(0), 1},
-		3: {3, 3, types.Fit(0), 1},
+		0: {0, 0, types.Fit(2), 1, "screen_index", 1, 0, ""},
+		1: {1, 1, types.Fit(2), 1, "field_count", 1, 0, ""},
+		2: {2, 2, types.Fit(0), 1, "layout", 1, 0, ""},
+		3: {3, 3, types.Fit(0), 1, "screen_enabled", 1, 0, ""},
 	},
 
 	MesgNumExdDataFieldConfiguration: {
-		0: {0, 0, types.Fit(2), 1},
-		1: {1, 1, types.Fit(13), 1},
-		2: {2, 2, types.Fit(2), 1},
-		3: {3, 3, types.Fit(2), 1},
-		4: {4, 4, types.Fit(0), 1},
-		5: {5, 5, types.Fit(39), 1},
+		0: {0, 0, types.Fit(2), 1, "screen_index", 1, 0, ""},
+		1: {1, 1, types.Fit(13), 1, "concept_field", 1, 0, ""},
+		2: {2, 2, types.Fit(2), 1, "field_id", 1, 0, ""},
+		3: {3, 3, types.Fit(2), 1, "concept_count", 1, 0, ""},
+		4: {4, 4, types.Fit(0), 1, "display_type", 1, 0, ""},
+		5: {5, 5, types.Fit(39), 1, "title", 1, 0, ""},
 	},
 
 	MesgNumExdDataConceptConfiguration: {
-		0:  {0, 0, types.Fit(2), 1},
-		1:  {1, 1, types.Fit(13), 1},
-		2:  {2, 2, types.Fit(2), 1},
-		3:  {3, 3, types.Fit(2), 1},
-		4:  {4, 4, types.Fit(2), 1},
-		5:  {5, 5, types.Fit(2), 1},
-		6:  {6, 6, types.Fit(2), 1},
-		8:  {7, 8, types.Fit(0), 1},
-		9:  {8, 9, types.Fit(0), 1},
-		10: {9, 10, types.Fit(0), 1},
-		11: {10, 11, types.Fit(0), 1},
+		0:  {0, 0, types.Fit(2), 1, "screen_index", 1, 0, ""},
+		1:  {1, 1, types.Fit(13), 1, "concept_field", 1, 0, ""},
+		2:  {2, 2, types.Fit(2), 1, "field_id", 1, 0, ""},
+		3:  {3, 3, types.Fit(2), 1, "concept_index", 1, 0, ""},
+		4:  {4, 4, types.Fit(2), 1, "data_page", 1, 0, ""},
+		5:  {5, 5, types.Fit(2), 1, "concept_key", 1, 0, ""},
+		6:  {6, 6, types.Fit(2), 1, "scaling", 1, 0, ""},
+		8:  {7, 8, types.Fit(0), 1, "data_units", 1, 0, ""},
+		9:  {8, 9, types.Fit(0), 1, "qualifier", 1, 0, ""},
+		10: {9, 10, types.Fit(0), 1, "descriptor", 1, 0, ""},
+		11: {10, 11, types.Fit(0), 1, "is_signed", 1, 0, ""},
 	},
 
 	MesgNumDiveSummary: {},
 
 	MesgNumHrv: {
-		0: {0, 0, types.Fit(36), 1},
+		0: {0, 0, types.Fit(36), 1, "time", 1000, 0, "s"},
 	},
 
-	MesgNumBeatIntervals: {},
+	MesgNumBeatIntervals: {
+		253: {0, 253, types.Fit(70), 1, "timestamp", 1, 0, ""},
+		0:   {1, 0, types.Fit(4), 1, "timestamp_ms", 1, 0, "ms"},
+		1:   {2, 1, types.Fit(36), 1, "time", 1, 0, "ms"},
+	},
 
 	MesgNumHrvStatusSummary: {},
 
@@ -1192,6 +1214,4322 @@ func getField(gmn MesgNum, fdn byte) (*field, bool) {
 	return f, true
 }
 
+var _subfields = map[MesgNum][]subfield{
+	MesgNumFileId: {
+		{2, "garmin_product", 1, 0, ""},
+	},
+	MesgNumSlaveDevice: {
+		{1, "garmin_product", 1, 0, ""},
+	},
+	MesgNumMesgCapabilities: {
+		{3, "num_per_file", 1, 0, ""},
+		{3, "max_per_file", 1, 0, ""},
+		{3, "max_per_file_type", 1, 0, ""},
+	},
+	MesgNumDiveSettings: {
+		{20, "heart_rate_antplus_device_type", 1, 0, ""},
+		{20, "heart_rate_local_device_type", 1, 0, ""},
+	},
+	MesgNumSession: {
+		{10, "total_strides", 1, 0, "strides"},
+		{10, "total_strokes", 1, 0, "strokes"},
+		{18, "avg_running_cadence", 1, 0, "strides/min"},
+		{19, "max_running_cadence", 1, 0, "strides/min"},
+	},
+	MesgNumLap: {
+		{10, "total_strides", 1, 0, "strides"},
+		{10, "total_strokes", 1, 0, "strokes"},
+		{17, "avg_running_cadence", 1, 0, "strides/min"},
+		{18, "max_running_cadence", 1, 0, "strides/min"},
+	},
+	MesgNumEvent: {
+		{3, "timer_trigger", 1, 0, ""},
+		{3, "course_point_index", 1, 0, ""},
+		{3, "battery_level", 1000, 0, "V"},
+		{3, "virtual_partner_speed", 1000, 0, "m/s"},
+		{3, "hr_high_alert", 1, 0, "bpm"},
+		{3, "hr_low_alert", 1, 0, "bpm"},
+		{3, "speed_high_alert", 1000, 0, "m/s"},
+		{3, "speed_low_alert", 1000, 0, "m/s"},
+		{3, "cad_high_alert", 1, 0, "rpm"},
+		{3, "cad_low_alert", 1, 0, "rpm"},
+		{3, "power_high_alert", 1, 0, "watts"},
+		{3, "power_low_alert", 1, 0, "watts"},
+		{3, "time_duration_alert", 1000, 0, "s"},
+		{3, "distance_duration_alert", 100, 0, "m"},
+		{3, "calorie_duration_alert", 1, 0, "calories"},
+		{3, "fitness_equipment_state", 1, 0, ""},
+		{3, "sport_point", 1, 0, ""},
+		{3, "gear_change_data", 1, 0, ""},
+	},
+	MesgNumDeviceInfo: {
+		{1, "antplus_device_type", 1, 0, ""},
+		{1, "ant_device_type", 1, 0, ""},
+		{1, "local_device_type", 1, 0, ""},
+		{4, "garmin_product", 1, 0, ""},
+	},
+	MesgNumTrainingFile: {
+		{2, "garmin_product", 1, 0, ""},
+	},
+	MesgNumSegmentLap: {
+		{10, "total_strokes", 1, 0, "strokes"},
+	},
+	MesgNumWorkoutStep: {
+		{2, "duration_time", 1000, 0, "s"},
+		{2, "duration_distance", 100, 0, "m"},
+		{2, "duration_hr", 1, 0, "% or bpm"},
+		{2, "duration_calories", 1, 0, "calories"},
+		{2, "duration_step", 1, 0, ""},
+		{2, "duration_power", 1, 0, "% or watts"},
+		{2, "duration_reps", 1, 0, ""},
+		{4, "target_speed_zone", 1, 0, ""},
+		{4, "target_hr_zone", 1, 0, ""},
+		{4, "target_cadence_zone", 1, 0, ""},
+		{4, "target_power_zone", 1, 0, ""},
+		{4, "repeat_steps", 1, 0, ""},
+		{4, "repeat_time", 1000, 0, "s"},
+		{4, "repeat_distance", 100, 0, "m"},
+		{4, "repeat_calories", 1, 0, "calories"},
+		{4, "repeat_hr", 1, 0, "% or bpm"},
+		{4, "repeat_power", 1, 0, "% or watts"},
+		{4, "target_stroke_type", 1, 0, ""},
+		{5, "custom_target_speed_low", 1000, 0, "m/s"},
+		{5, "custom_target_heart_rate_low", 1, 0, "% or bpm"},
+		{5, "custom_target_cadence_low", 1, 0, "rpm"},
+		{5, "custom_target_power_low", 1, 0, "% or watts"},
+		{6, "custom_target_speed_high", 1000, 0, "m/s"},
+		{6, "custom_target_heart_rate_high", 1, 0, "% or bpm"},
+		{6, "custom_target_cadence_high", 1, 0, "rpm"},
+		{6, "custom_target_power_high", 1, 0, "% or watts"},
+		{20, "secondary_target_speed_zone", 1, 0, ""},
+		{20, "secondary_target_hr_zone", 1, 0, ""},
+		{20, "secondary_target_cadence_zone", 1, 0, ""},
+		{20, "secondary_target_power_zone", 1, 0, ""},
+		{20, "secondary_target_stroke_type", 1, 0, ""},
+		{21, "secondary_custom_target_speed_low", 1000, 0, "m/s"},
+		{21, "secondary_custom_target_heart_rate_low", 1, 0, "% or bpm"},
+		{21, "secondary_custom_target_cadence_low", 1, 0, "rpm"},
+		{21, "secondary_custom_target_power_low", 1, 0, "% or watts"},
+		{22, "secondary_custom_target_speed_high", 1000, 0, "m/s"},
+		{22, "secondary_custom_target_heart_rate_high", 1, 0, "% or bpm"},
+		{22, "secondary_custom_target_cadence_high", 1, 0, "rpm"},
+		{22, "secondary_custom_target_power_high", 1, 0, "% or watts"},
+	},
+	MesgNumSchedule: {
+		{1, "garmin_product", 1, 0, ""},
+	},
+	MesgNumMonitoring: {
+		{3, "strokes", 2, 0, "strokes"},
+	},
+}
+
+var _typeValues = map[reflect.Type][]typeValue{
+	reflect.TypeOf(ActivityClass(0)): {
+		{"Level", 0x7F},
+		{"LevelMax", 100},
+		{"Athlete", 0x80},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(ActivityLevel(0)): {
+		{"Low", 0},
+		{"Medium", 1},
+		{"High", 2},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(ActivityMode(0)): {
+		{"Manual", 0},
+		{"AutoMultiSport", 1},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(ActivitySubtype(0)): {
+		{"Generic", 0},
+		{"Treadmill", 1},
+		{"Street", 2},
+		{"Trail", 3},
+		{"Track", 4},
+		{"Spin", 5},
+		{"IndoorCycling", 6},
+		{"Road", 7},
+		{"Mountain", 8},
+		{"Downhill", 9},
+		{"Recumbent", 10},
+		{"Cyclocross", 11},
+		{"HandCycling", 12},
+		{"TrackCycling", 13},
+		{"IndoorRowing", 14},
+		{"Elliptical", 15},
+		{"StairClimbing", 16},
+		{"LapSwimming", 17},
+		{"OpenWater", 18},
+		{"All", 254},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(ActivityType(0)): {
+		{"Generic", 0},
+		{"Running", 1},
+		{"Cycling", 2},
+		{"Transition", 3},
+		{"FitnessEquipment", 4},
+		{"Swimming", 5},
+		{"Walking", 6},
+		{"Sedentary", 8},
+		{"All", 254},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(AnalogWatchfaceLayout(0)): {
+		{"Minimal", 0},
+		{"Traditional", 1},
+		{"Modern", 2},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(AntChannelId(0)): {
+		{"AntExtendedDeviceNumberUpperNibble", 0xF0000000},
+		{"AntTransmissionTypeLowerNibble", 0x0F000000},
+		{"AntDeviceType", 0x00FF0000},
+		{"AntDeviceNumber", 0x0000FFFF},
+		{"Invalid", 0x00000000},
+	},
+	reflect.TypeOf(AntNetwork(0)): {
+		{"Public", 0},
+		{"Antplus", 1},
+		{"Antfs", 2},
+		{"Private", 3},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(AntplusDeviceType(0)): {
+		{"Antfs", 1},
+		{"BikePower", 11},
+		{"EnvironmentSensorLegacy", 12},
+		{"MultiSportSpeedDistance", 15},
+		{"Control", 16},
+		{"FitnessEquipment", 17},
+		{"BloodPressure", 18},
+		{"GeocacheNode", 19},
+		{"LightElectricVehicle", 20},
+		{"EnvSensor", 25},
+		{"Racquet", 26},
+		{"ControlHub", 27},
+		{"MuscleOxygen", 31},
+		{"Shifting", 34},
+		{"BikeLightMain", 35},
+		{"BikeLightShared", 36},
+		{"Exd", 38},
+		{"BikeRadar", 40},
+		{"BikeAero", 46},
+		{"WeightScale", 119},
+		{"HeartRate", 120},
+		{"BikeSpeedCadence", 121},
+		{"BikeCadence", 122},
+		{"BikeSpeed", 123},
+		{"StrideSpeedDistance", 124},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(AttitudeStage(0)): {
+		{"Failed", 0},
+		{"Aligning", 1},
+		{"Degraded", 2},
+		{"Valid", 3},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(AttitudeValidity(0)): {
+		{"TrackAngleHeadingValid", 0x0001},
+		{"PitchValid", 0x0002},
+		{"RollValid", 0x0004},
+		{"LateralBodyAccelValid", 0x0008},
+		{"NormalBodyAccelValid", 0x0010},
+		{"TurnRateValid", 0x0020},
+		{"HwFail", 0x0040},
+		{"MagInvalid", 0x0080},
+		{"NoGps", 0x0100},
+		{"GpsInvalid", 0x0200},
+		{"SolutionCoasting", 0x0400},
+		{"TrueTrackAngle", 0x0800},
+		{"MagneticHeading", 0x1000},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(AutoActivityDetect(0)): {
+		{"None", 0x00000000},
+		{"Running", 0x00000001},
+		{"Cycling", 0x00000002},
+		{"Swimming", 0x00000004},
+		{"Walking", 0x00000008},
+		{"Elliptical", 0x00000020},
+		{"Sedentary", 0x00000400},
+		{"Invalid", 0xFFFFFFFF},
+	},
+	reflect.TypeOf(AutoSyncFrequency(0)): {
+		{"Never", 0},
+		{"Occasionally", 1},
+		{"Frequent", 2},
+		{"OnceADay", 3},
+		{"Remote", 4},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(AutolapTrigger(0)): {
+		{"Time", 0},
+		{"Distance", 1},
+		{"PositionStart", 2},
+		{"PositionLap", 3},
+		{"PositionWaypoint", 4},
+		{"PositionMarked", 5},
+		{"Off", 6},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(Autoscroll(0)): {
+		{"None", 0},
+		{"Slow", 1},
+		{"Medium", 2},
+		{"Fast", 3},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(BacklightMode(0)): {
+		{"Off", 0},
+		{"Manual", 1},
+		{"KeyAndMessages", 2},
+		{"AutoBrightness", 3},
+		{"SmartNotifications", 4},
+		{"KeyAndMessagesNight", 5},
+		{"KeyAndMessagesAndSmartNotifications", 6},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(BacklightTimeout(0)): {
+		{"Infinite", 0},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(BatteryStatus(0)): {
+		{"New", 1},
+		{"Good", 2},
+		{"Ok", 3},
+		{"Low", 4},
+		{"Critical", 5},
+		{"Charging", 6},
+		{"Unknown", 7},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(BenchPressExerciseName(0)): {
+		{"AlternatingDumbbellChestPressOnSwissBall", 0},
+		{"BarbellBenchPress", 1},
+		{"BarbellBoardBenchPress", 2},
+		{"BarbellFloorPress", 3},
+		{"CloseGripBarbellBenchPress", 4},
+		{"DeclineDumbbellBenchPress", 5},
+		{"DumbbellBenchPress", 6},
+		{"DumbbellFloorPress", 7},
+		{"InclineBarbellBenchPress", 8},
+		{"InclineDumbbellBenchPress", 9},
+		{"InclineSmithMachineBenchPress", 10},
+		{"IsometricBarbellBenchPress", 11},
+		{"KettlebellChestPress", 12},
+		{"NeutralGripDumbbellBenchPress", 13},
+		{"NeutralGripDumbbellInclineBenchPress", 14},
+		{"OneArmFloorPress", 15},
+		{"WeightedOneArmFloorPress", 16},
+		{"PartialLockout", 17},
+		{"ReverseGripBarbellBenchPress", 18},
+		{"ReverseGripInclineBenchPress", 19},
+		{"SingleArmCableChestPress", 20},
+		{"SingleArmDumbbellBenchPress", 21},
+		{"SmithMachineBenchPress", 22},
+		{"SwissBallDumbbellChestPress", 23},
+		{"TripleStopBarbellBenchPress", 24},
+		{"WideGripBarbellBenchPress", 25},
+		{"AlternatingDumbbellChestPress", 26},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(BikeLightBeamAngleMode(0)): {
+		{"Manual", 0},
+		{"Auto", 1},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(BikeLightNetworkConfigType(0)): {
+		{"Auto", 0},
+		{"Individual", 4},
+		{"HighVisibility", 5},
+		{"Trail", 6},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(BleDeviceType(0)): {
+		{"ConnectedGps", 0},
+		{"HeartRate", 1},
+		{"BikePower", 2},
+		{"BikeSpeedCadence", 3},
+		{"BikeSpeed", 4},
+		{"BikeCadence", 5},
+		{"Footpod", 6},
+		{"BikeTrainer", 7},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(BodyLocation(0)): {
+		{"LeftLeg", 0},
+		{"LeftCalf", 1},
+		{"LeftShin", 2},
+		{"LeftHamstring", 3},
+		{"LeftQuad", 4},
+		{"LeftGlute", 5},
+		{"RightLeg", 6},
+		{"RightCalf", 7},
+		{"RightShin", 8},
+		{"RightHamstring", 9},
+		{"RightQuad", 10},
+		{"RightGlute", 11},
+		{"TorsoBack", 12},
+		{"LeftLowerBack", 13},
+		{"LeftUpperBack", 14},
+		{"RightLowerBack", 15},
+		{"RightUpperBack", 16},
+		{"TorsoFront", 17},
+		{"LeftAbdomen", 18},
+		{"LeftChest", 19},
+		{"RightAbdomen", 20},
+		{"RightChest", 21},
+		{"LeftArm", 22},
+		{"LeftShoulder", 23},
+		{"LeftBicep", 24},
+		{"LeftTricep", 25},
+		{"LeftBrachioradialis", 26},
+		{"LeftForearmExtensors", 27},
+		{"RightArm", 28},
+		{"RightShoulder", 29},
+		{"RightBicep", 30},
+		{"RightTricep", 31},
+		{"RightBrachioradialis", 32},
+		{"RightForearmExtensors", 33},
+		{"Neck", 34},
+		{"Throat", 35},
+		{"WaistMidBack", 36},
+		{"WaistFront", 37},
+		{"WaistLeft", 38},
+		{"WaistRight", 39},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(BpStatus(0)): {
+		{"NoError", 0},
+		{"ErrorIncompleteData", 1},
+		{"ErrorNoMeasurement", 2},
+		{"ErrorDataOutOfRange", 3},
+		{"ErrorIrregularHeartRate", 4},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(CalfRaiseExerciseName(0)): {
+		{"3WayCalfRaise", 0},
+		{"3WayWeightedCalfRaise", 1},
+		{"3WaySingleLegCalfRaise", 2},
+		{"3WayWeightedSingleLegCalfRaise", 3},
+		{"DonkeyCalfRaise", 4},
+		{"WeightedDonkeyCalfRaise", 5},
+		{"SeatedCalfRaise", 6},
+		{"WeightedSeatedCalfRaise", 7},
+		{"SeatedDumbbellToeRaise", 8},
+		{"SingleLegBentKneeCalfRaise", 9},
+		{"WeightedSingleLegBentKneeCalfRaise", 10},
+		{"SingleLegDeclinePushUp", 11},
+		{"SingleLegDonkeyCalfRaise", 12},
+		{"WeightedSingleLegDonkeyCalfRaise", 13},
+		{"SingleLegHipRaiseWithKneeHold", 14},
+		{"SingleLegStandingCalfRaise", 15},
+		{"SingleLegStandingDumbbellCalfRaise", 16},
+		{"StandingBarbellCalfRaise", 17},
+		{"StandingCalfRaise", 18},
+		{"WeightedStandingCalfRaise", 19},
+		{"StandingDumbbellCalfRaise", 20},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(CameraEventType(0)): {
+		{"VideoStart", 0},
+		{"VideoSplit", 1},
+		{"VideoEnd", 2},
+		{"PhotoTaken", 3},
+		{"VideoSecondStreamStart", 4},
+		{"VideoSecondStreamSplit", 5},
+		{"VideoSecondStreamEnd", 6},
+		{"VideoSplitStart", 7},
+		{"VideoSecondStreamSplitStart", 8},
+		{"VideoPause", 11},
+		{"VideoSecondStreamPause", 12},
+		{"VideoResume", 13},
+		{"VideoSecondStreamResume", 14},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(CameraOrientationType(0)): {
+		{"CameraOrientation0", 0},
+		{"CameraOrientation90", 1},
+		{"CameraOrientation180", 2},
+		{"CameraOrientation270", 3},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(CardioExerciseName(0)): {
+		{"BobAndWeaveCircle", 0},
+		{"WeightedBobAndWeaveCircle", 1},
+		{"CardioCoreCrawl", 2},
+		{"WeightedCardioCoreCrawl", 3},
+		{"DoubleUnder", 4},
+		{"WeightedDoubleUnder", 5},
+		{"JumpRope", 6},
+		{"WeightedJumpRope", 7},
+		{"JumpRopeCrossover", 8},
+		{"WeightedJumpRopeCrossover", 9},
+		{"JumpRopeJog", 10},
+		{"WeightedJumpRopeJog", 11},
+		{"JumpingJacks", 12},
+		{"WeightedJumpingJacks", 13},
+		{"SkiMoguls", 14},
+		{"WeightedSkiMoguls", 15},
+		{"SplitJacks", 16},
+		{"WeightedSplitJacks", 17},
+		{"SquatJacks", 18},
+		{"WeightedSquatJacks", 19},
+		{"TripleUnder", 20},
+		{"WeightedTripleUnder", 21},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(CarryExerciseName(0)): {
+		{"BarHolds", 0},
+		{"FarmersWalk", 1},
+		{"FarmersWalkOnToes", 2},
+		{"HexDumbbellHold", 3},
+		{"OverheadCarry", 4},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(CcrSetpointSwitchMode(0)): {
+		{"Manual", 0},
+		{"Automatic", 1},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(Checksum(0)): {
+		{"Clear", 0},
+		{"Ok", 1},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(ChopExerciseName(0)): {
+		{"CablePullThrough", 0},
+		{"CableRotationalLift", 1},
+		{"CableWoodchop", 2},
+		{"CrossChopToKnee", 3},
+		{"WeightedCrossChopToKnee", 4},
+		{"DumbbellChop", 5},
+		{"HalfKneelingRotation", 6},
+		{"WeightedHalfKneelingRotation", 7},
+		{"HalfKneelingRotationalChop", 8},
+		{"HalfKneelingRotationalReverseChop", 9},
+		{"HalfKneelingStabilityChop", 10},
+		{"HalfKneelingStabilityReverseChop", 11},
+		{"KneelingRotationalChop", 12},
+		{"KneelingRotationalReverseChop", 13},
+		{"KneelingStabilityChop", 14},
+		{"KneelingWoodchopper", 15},
+		{"MedicineBallWoodChops", 16},
+		{"PowerSquatChops", 17},
+		{"WeightedPowerSquatChops", 18},
+		{"StandingRotationalChop", 19},
+		{"StandingSplitRotationalChop", 20},
+		{"StandingSplitRotationalReverseChop", 21},
+		{"StandingStabilityReverseChop", 22},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(ClimbProEvent(0)): {
+		{"Approach", 0},
+		{"Start", 1},
+		{"Complete", 2},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(CommTimeoutType(0)): {
+		{"WildcardPairingTimeout", 0},
+		{"PairingTimeout", 1},
+		{"ConnectionLost", 2},
+		{"ConnectionTimeout", 3},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(ConnectivityCapabilities(0)): {
+		{"Bluetooth", 0x00000001},
+		{"BluetoothLe", 0x00000002},
+		{"Ant", 0x00000004},
+		{"ActivityUpload", 0x00000008},
+		{"CourseDownload", 0x00000010},
+		{"WorkoutDownload", 0x00000020},
+		{"LiveTrack", 0x00000040},
+		{"WeatherConditions", 0x00000080},
+		{"WeatherAlerts", 0x00000100},
+		{"GpsEphemerisDownload", 0x00000200},
+		{"ExplicitArchive", 0x00000400},
+		{"SetupIncomplete", 0x00000800},
+		{"ContinueSyncAfterSoftwareUpdate", 0x00001000},
+		{"ConnectIqAppDownload", 0x00002000},
+		{"GolfCourseDownload", 0x00004000},
+		{"DeviceInitiatesSync", 0x00008000},
+		{"ConnectIqWatchAppDownload", 0x00010000},
+		{"ConnectIqWidgetDownload", 0x00020000},
+		{"ConnectIqWatchFaceDownload", 0x00040000},
+		{"ConnectIqDataFieldDownload", 0x00080000},
+		{"ConnectIqAppManagment", 0x00100000},
+		{"SwingSensor", 0x00200000},
+		{"SwingSensorRemote", 0x00400000},
+		{"IncidentDetection", 0x00800000},
+		{"AudioPrompts", 0x01000000},
+		{"WifiVerification", 0x02000000},
+		{"TrueUp", 0x04000000},
+		{"FindMyWatch", 0x08000000},
+		{"RemoteManualSync", 0x10000000},
+		{"LiveTrackAutoStart", 0x20000000},
+		{"LiveTrackMessaging", 0x40000000},
+		{"InstantInput", 0x80000000},
+		{"Invalid", 0x00000000},
+	},
+	reflect.TypeOf(CoreExerciseName(0)): {
+		{"AbsJabs", 0},
+		{"WeightedAbsJabs", 1},
+		{"AlternatingPlateReach", 2},
+		{"BarbellRollout", 3},
+		{"WeightedBarbellRollout", 4},
+		{"BodyBarObliqueTwist", 5},
+		{"CableCorePress", 6},
+		{"CableSideBend", 7},
+		{"SideBend", 8},
+		{"WeightedSideBend", 9},
+		{"CrescentCircle", 10},
+		{"WeightedCrescentCircle", 11},
+		{"CyclingRussianTwist", 12},
+		{"WeightedCyclingRussianTwist", 13},
+		{"ElevatedFeetRussianTwist", 14},
+		{"WeightedElevatedFeetRussianTwist", 15},
+		{"HalfTurkishGetUp", 16},
+		{"KettlebellWindmill", 17},
+		{"KneelingAbWheel", 18},
+		{"WeightedKneelingAbWheel", 19},
+		{"ModifiedFrontLever", 20},
+		{"OpenKneeTucks", 21},
+		{"WeightedOpenKneeTucks", 22},
+		{"SideAbsLegLift", 23},
+		{"WeightedSideAbsLegLift", 24},
+		{"SwissBallJackknife", 25},
+		{"WeightedSwissBallJackknife", 26},
+		{"SwissBallPike", 27},
+		{"WeightedSwissBallPike", 28},
+		{"SwissBallRollout", 29},
+		{"WeightedSwissBallRollout", 30},
+		{"TriangleHipPress", 31},
+		{"WeightedTriangleHipPress", 32},
+		{"TrxSuspendedJackknife", 33},
+		{"WeightedTrxSuspendedJackknife", 34},
+		{"UBoat", 35},
+		{"WeightedUBoat", 36},
+		{"WindmillSwitches", 37},
+		{"WeightedWindmillSwitches", 38},
+		{"AlternatingSlideOut", 39},
+		{"WeightedAlternatingSlideOut", 40},
+		{"GhdBackExtensions", 41},
+		{"WeightedGhdBackExtensions", 42},
+		{"OverheadWalk", 43},
+		{"Inchworm", 44},
+		{"WeightedModifiedFrontLever", 45},
+		{"RussianTwist", 46},
+		{"AbdominalLegRotations", 47},
+		{"ArmAndLegExtensionOnKnees", 48},
+		{"Bicycle", 49},
+		{"BicepCurlWithLegExtension", 50},
+		{"CatCow", 51},
+		{"Corkscrew", 52},
+		{"CrissCross", 53},
+		{"CrissCrossWithBall", 54},
+		{"DoubleLegStretch", 55},
+		{"KneeFolds", 56},
+		{"LowerLift", 57},
+		{"NeckPull", 58},
+		{"PelvicClocks", 59},
+		{"RollOver", 60},
+		{"RollUp", 61},
+		{"Rolling", 62},
+		{"Rowing1", 63},
+		{"Rowing2", 64},
+		{"Scissors", 65},
+		{"SingleLegCircles", 66},
+		{"SingleLegStretch", 67},
+		{"SnakeTwist1And2", 68},
+		{"Swan", 69},
+		{"Swimming", 70},
+		{"Teaser", 71},
+		{"TheHundred", 72},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(CourseCapabilities(0)): {
+		{"Processed", 0x00000001},
+		{"Valid", 0x00000002},
+		{"Time", 0x00000004},
+		{"Distance", 0x00000008},
+		{"Position", 0x00000010},
+		{"HeartRate", 0x00000020},
+		{"Power", 0x00000040},
+		{"Cadence", 0x00000080},
+		{"Training", 0x00000100},
+		{"Navigation", 0x00000200},
+		{"Bikeway", 0x00000400},
+		{"Aviation", 0x00001000},
+		{"Invalid", 0x00000000},
+	},
+	reflect.TypeOf(CoursePoint(0)): {
+		{"Generic", 0},
+		{"Summit", 1},
+		{"Valley", 2},
+		{"Water", 3},
+		{"Food", 4},
+		{"Danger", 5},
+		{"Left", 6},
+		{"Right", 7},
+		{"Straight", 8},
+		{"FirstAid", 9},
+		{"FourthCategory", 10},
+		{"ThirdCategory", 11},
+		{"SecondCategory", 12},
+		{"FirstCategory", 13},
+		{"HorsCategory", 14},
+		{"Sprint", 15},
+		{"LeftFork", 16},
+		{"RightFork", 17},
+		{"MiddleFork", 18},
+		{"SlightLeft", 19},
+		{"SharpLeft", 20},
+		{"SlightRight", 21},
+		{"SharpRight", 22},
+		{"UTurn", 23},
+		{"SegmentStart", 24},
+		{"SegmentEnd", 25},
+		{"Campsite", 27},
+		{"AidStation", 28},
+		{"RestArea", 29},
+		{"GeneralDistance", 30},
+		{"Service", 31},
+		{"EnergyGel", 32},
+		{"SportsDrink", 33},
+		{"MileMarker", 34},
+		{"Checkpoint", 35},
+		{"Shelter", 36},
+		{"MeetingSpot", 37},
+		{"Overlook", 38},
+		{"Toilet", 39},
+		{"Shower", 40},
+		{"Gear", 41},
+		{"SharpCurve", 42},
+		{"SteepIncline", 43},
+		{"Tunnel", 44},
+		{"Bridge", 45},
+		{"Obstacle", 46},
+		{"Crossing", 47},
+		{"Store", 48},
+		{"Transition", 49},
+		{"Navaid", 50},
+		{"Transport", 51},
+		{"Alert", 52},
+		{"Info", 53},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(CrunchExerciseName(0)): {
+		{"BicycleCrunch", 0},
+		{"CableCrunch", 1},
+		{"CircularArmCrunch", 2},
+		{"CrossedArmsCrunch", 3},
+		{"WeightedCrossedArmsCrunch", 4},
+		{"CrossLegReverseCrunch", 5},
+		{"WeightedCrossLegReverseCrunch", 6},
+		{"CrunchChop", 7},
+		{"WeightedCrunchChop", 8},
+		{"DoubleCrunch", 9},
+		{"WeightedDoubleCrunch", 10},
+		{"ElbowToKneeCrunch", 11},
+		{"WeightedElbowToKneeCrunch", 12},
+		{"FlutterKicks", 13},
+		{"WeightedFlutterKicks", 14},
+		{"FoamRollerReverseCrunchOnBench", 15},
+		{"WeightedFoamRollerReverseCrunchOnBench", 16},
+		{"FoamRollerReverseCrunchWithDumbbell", 17},
+		{"FoamRollerReverseCrunchWithMedicineBall", 18},
+		{"FrogPress", 19},
+		{"HangingKneeRaiseObliqueCrunch", 20},
+		{"WeightedHangingKneeRaiseObliqueCrunch", 21},
+		{"HipCrossover", 22},
+		{"WeightedHipCrossover", 23},
+		{"HollowRock", 24},
+		{"WeightedHollowRock", 25},
+		{"InclineReverseCrunch", 26},
+		{"WeightedInclineReverseCrunch", 27},
+		{"KneelingCableCrunch", 28},
+		{"KneelingCrossCrunch", 29},
+		{"WeightedKneelingCrossCrunch", 30},
+		{"KneelingObliqueCableCrunch", 31},
+		{"KneesToElbow", 32},
+		{"LegExtensions", 33},
+		{"WeightedLegExtensions", 34},
+		{"LegLevers", 35},
+		{"McgillCurlUp", 36},
+		{"WeightedMcgillCurlUp", 37},
+		{"ModifiedPilatesRollUpWithBall", 38},
+		{"WeightedModifiedPilatesRollUpWithBall", 39},
+		{"PilatesCrunch", 40},
+		{"WeightedPilatesCrunch", 41},
+		{"PilatesRollUpWithBall", 42},
+		{"WeightedPilatesRollUpWithBall", 43},
+		{"RaisedLegsCrunch", 44},
+		{"WeightedRaisedLegsCrunch", 45},
+		{"ReverseCrunch", 46},
+		{"WeightedReverseCrunch", 47},
+		{"ReverseCrunchOnABench", 48},
+		{"WeightedReverseCrunchOnABench", 49},
+		{"ReverseCurlAndLift", 50},
+		{"WeightedReverseCurlAndLift", 51},
+		{"RotationalLift", 52},
+		{"WeightedRotationalLift", 53},
+		{"SeatedAlternatingReverseCrunch", 54},
+		{"WeightedSeatedAlternatingReverseCrunch", 55},
+		{"SeatedLegU", 56},
+		{"WeightedSeatedLegU", 57},
+		{"SideToSideCrunchAndWeave", 58},
+		{"WeightedSideToSideCrunchAndWeave", 59},
+		{"SingleLegReverseCrunch", 60},
+		{"WeightedSingleLegReverseCrunch", 61},
+		{"SkaterCrunchCross", 62},
+		{"WeightedSkaterCrunchCross", 63},
+		{"StandingCableCrunch", 64},
+		{"StandingSideCrunch", 65},
+		{"StepClimb", 66},
+		{"WeightedStepClimb", 67},
+		{"SwissBallCrunch", 68},
+		{"SwissBallReverseCrunch", 69},
+		{"WeightedSwissBallReverseCrunch", 70},
+		{"SwissBallRussianTwist", 71},
+		{"WeightedSwissBallRussianTwist", 72},
+		{"SwissBallSideCrunch", 73},
+		{"WeightedSwissBallSideCrunch", 74},
+		{"ThoracicCrunchesOnFoamRoller", 75},
+		{"WeightedThoracicCrunchesOnFoamRoller", 76},
+		{"TricepsCrunch", 77},
+		{"WeightedBicycleCrunch", 78},
+		{"WeightedCrunch", 79},
+		{"WeightedSwissBallCrunch", 80},
+		{"ToesToBar", 81},
+		{"WeightedToesToBar", 82},
+		{"Crunch", 83},
+		{"StraightLegCrunchWithBall", 84},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(CurlExerciseName(0)): {
+		{"AlternatingDumbbellBicepsCurl", 0},
+		{"AlternatingDumbbellBicepsCurlOnSwissBall", 1},
+		{"AlternatingInclineDumbbellBicepsCurl", 2},
+		{"BarbellBicepsCurl", 3},
+		{"BarbellReverseWristCurl", 4},
+		{"BarbellWristCurl", 5},
+		{"BehindTheBackBarbellReverseWristCurl", 6},
+		{"BehindTheBackOneArmCableCurl", 7},
+		{"CableBicepsCurl", 8},
+		{"CableHammerCurl", 9},
+		{"CheatingBarbellBicepsCurl", 10},
+		{"CloseGripEzBarBicepsCurl", 11},
+		{"CrossBodyDumbbellHammerCurl", 12},
+		{"DeadHangBicepsCurl", 13},
+		{"DeclineHammerCurl", 14},
+		{"DumbbellBicepsCurlWithStaticHold", 15},
+		{"DumbbellHammerCurl", 16},
+		{"DumbbellReverseWristCurl", 17},
+		{"DumbbellWristCurl", 18},
+		{"EzBarPreacherCurl", 19},
+		{"ForwardBendBicepsCurl", 20},
+		{"HammerCurlToPress", 21},
+		{"InclineDumbbellBicepsCurl", 22},
+		{"InclineOffsetThumbDumbbellCurl", 23},
+		{"KettlebellBicepsCurl", 24},
+		{"LyingConcentrationCableCurl", 25},
+		{"OneArmPreacherCurl", 26},
+		{"PlatePinchCurl", 27},
+		{"PreacherCurlWithCable", 28},
+		{"ReverseEzBarCurl", 29},
+		{"ReverseGripWristCurl", 30},
+		{"ReverseGripBarbellBicepsCurl", 31},
+		{"SeatedAlternatingDumbbellBicepsCurl", 32},
+		{"SeatedDumbbellBicepsCurl", 33},
+		{"SeatedReverseDumbbellCurl", 34},
+		{"SplitStanceOffsetPinkyDumbbellCurl", 35},
+		{"StandingAlternatingDumbbellCurls", 36},
+		{"StandingDumbbellBicepsCurl", 37},
+		{"StandingEzBarBicepsCurl", 38},
+		{"StaticCurl", 39},
+		{"SwissBallDumbbellOverheadTricepsExtension", 40},
+		{"SwissBallEzBarPreacherCurl", 41},
+		{"TwistingStandingDumbbellBicepsCurl", 42},
+		{"WideGripEzBarBicepsCurl", 43},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(DateMode(0)): {
+		{"DayMonth", 0},
+		{"MonthDay", 1},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(DayOfWeek(0)): {
+		{"Sunday", 0},
+		{"Monday", 1},
+		{"Tuesday", 2},
+		{"Wednesday", 3},
+		{"Thursday", 4},
+		{"Friday", 5},
+		{"Saturday", 6},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(DeadliftExerciseName(0)): {
+		{"BarbellDeadlift", 0},
+		{"BarbellStraightLegDeadlift", 1},
+		{"DumbbellDeadlift", 2},
+		{"DumbbellSingleLegDeadliftToRow", 3},
+		{"DumbbellStraightLegDeadlift", 4},
+		{"KettlebellFloorToShelf", 5},
+		{"OneArmOneLegDeadlift", 6},
+		{"RackPull", 7},
+		{"RotationalDumbbellStraightLegDeadlift", 8},
+		{"SingleArmDeadlift", 9},
+		{"SingleLegBarbellDeadlift", 10},
+		{"SingleLegBarbellStraightLegDeadlift", 11},
+		{"SingleLegDeadliftWithBarbell", 12},
+		{"SingleLegRdlCircuit", 13},
+		{"SingleLegRomanianDeadliftWithDumbbell", 14},
+		{"SumoDeadlift", 15},
+		{"SumoDeadliftHighPull", 16},
+		{"TrapBarDeadlift", 17},
+		{"WideGripBarbellDeadlift", 18},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(DeviceIndex(0)): {
+		{"Creator", 0},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(DigitalWatchfaceLayout(0)): {
+		{"Traditional", 0},
+		{"Modern", 1},
+		{"Bold", 2},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(DisplayHeart(0)): {
+		{"Bpm", 0},
+		{"Max", 1},
+		{"Reserve", 2},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(DisplayMeasure(0)): {
+		{"Metric", 0},
+		{"Statute", 1},
+		{"Nautical", 2},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(DisplayOrientation(0)): {
+		{"Auto", 0},
+		{"Portrait", 1},
+		{"Landscape", 2},
+		{"PortraitFlipped", 3},
+		{"LandscapeFlipped", 4},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(DisplayPosition(0)): {
+		{"Degree", 0},
+		{"DegreeMinute", 1},
+		{"DegreeMinuteSecond", 2},
+		{"AustrianGrid", 3},
+		{"BritishGrid", 4},
+		{"DutchGrid", 5},
+		{"HungarianGrid", 6},
+		{"FinnishGrid", 7},
+		{"GermanGrid", 8},
+		{"IcelandicGrid", 9},
+		{"IndonesianEquatorial", 10},
+		{"IndonesianIrian", 11},
+		{"IndonesianSouthern", 12},
+		{"IndiaZone0", 13},
+		{"IndiaZoneIA", 14},
+		{"IndiaZoneIB", 15},
+		{"IndiaZoneIIA", 16},
+		{"IndiaZoneIIB", 17},
+		{"IndiaZoneIIIA", 18},
+		{"IndiaZoneIIIB", 19},
+		{"IndiaZoneIVA", 20},
+		{"IndiaZoneIVB", 21},
+		{"IrishTransverse", 22},
+		{"IrishGrid", 23},
+		{"Loran", 24},
+		{"MaidenheadGrid", 25},
+		{"MgrsGrid", 26},
+		{"NewZealandGrid", 27},
+		{"NewZealandTransverse", 28},
+		{"QatarGrid", 29},
+		{"ModifiedSwedishGrid", 30},
+		{"SwedishGrid", 31},
+		{"SouthAfricanGrid", 32},
+		{"SwissGrid", 33},
+		{"TaiwanGrid", 34},
+		{"UnitedStatesGrid", 35},
+		{"UtmUpsGrid", 36},
+		{"WestMalayan", 37},
+		{"BorneoRso", 38},
+		{"EstonianGrid", 39},
+		{"LatvianGrid", 40},
+		{"SwedishRef99Grid", 41},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(DisplayPower(0)): {
+		{"Watts", 0},
+		{"PercentFtp", 1},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(DiveAlarmType(0)): {
+		{"Depth", 0},
+		{"Time", 1},
+		{"Speed", 2},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(DiveAlert(0)): {
+		{"NdlReached", 0},
+		{"GasSwitchPrompted", 1},
+		{"NearSurface", 2},
+		{"ApproachingNdl", 3},
+		{"Po2Warn", 4},
+		{"Po2CritHigh", 5},
+		{"Po2CritLow", 6},
+		{"TimeAlert", 7},
+		{"DepthAlert", 8},
+		{"DecoCeilingBroken", 9},
+		{"DecoComplete", 10},
+		{"SafetyStopBroken", 11},
+		{"SafetyStopComplete", 12},
+		{"CnsWarning", 13},
+		{"CnsCritical", 14},
+		{"OtuWarning", 15},
+		{"OtuCritical", 16},
+		{"AscentCritical", 17},
+		{"AlertDismissedByKey", 18},
+		{"AlertDismissedByTimeout", 19},
+		{"BatteryLow", 20},
+		{"BatteryCritical", 21},
+		{"SafetyStopStarted", 22},
+		{"ApproachingFirstDecoStop", 23},
+		{"SetpointSwitchAutoLow", 24},
+		{"SetpointSwitchAutoHigh", 25},
+		{"SetpointSwitchManualLow", 26},
+		{"SetpointSwitchManualHigh", 27},
+		{"AutoSetpointSwitchIgnored", 28},
+		{"SwitchedToOpenCircuit", 29},
+		{"SwitchedToClosedCircuit", 30},
+		{"TankBatteryLow", 32},
+		{"Po2CcrDilLow", 33},
+		{"DecoStopCleared", 34},
+		{"ApneaNeutralBuoyancy", 35},
+		{"ApneaTargetDepth", 36},
+		{"ApneaSurface", 37},
+		{"ApneaHighSpeed", 38},
+		{"ApneaLowSpeed", 39},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(DiveBacklightMode(0)): {
+		{"AtDepth", 0},
+		{"AlwaysOn", 1},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(DiveGasMode(0)): {
+		{"OpenCircuit", 0},
+		{"ClosedCircuitDiluent", 1},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(DiveGasStatus(0)): {
+		{"Disabled", 0},
+		{"Enabled", 1},
+		{"BackupOnly", 2},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(Event(0)): {
+		{"Timer", 0},
+		{"Workout", 3},
+		{"WorkoutStep", 4},
+		{"PowerDown", 5},
+		{"PowerUp", 6},
+		{"OffCourse", 7},
+		{"Session", 8},
+		{"Lap", 9},
+		{"CoursePoint", 10},
+		{"Battery", 11},
+		{"VirtualPartnerPace", 12},
+		{"HrHighAlert", 13},
+		{"HrLowAlert", 14},
+		{"SpeedHighAlert", 15},
+		{"SpeedLowAlert", 16},
+		{"CadHighAlert", 17},
+		{"CadLowAlert", 18},
+		{"PowerHighAlert", 19},
+		{"PowerLowAlert", 20},
+		{"RecoveryHr", 21},
+		{"BatteryLow", 22},
+		{"TimeDurationAlert", 23},
+		{"DistanceDurationAlert", 24},
+		{"CalorieDurationAlert", 25},
+		{"Activity", 26},
+		{"FitnessEquipment", 27},
+		{"Length", 28},
+		{"UserMarker", 32},
+		{"SportPoint", 33},
+		{"Calibration", 36},
+		{"FrontGearChange", 42},
+		{"RearGearChange", 43},
+		{"RiderPositionChange", 44},
+		{"ElevHighAlert", 45},
+		{"ElevLowAlert", 46},
+		{"CommTimeout", 47},
+		{"AutoActivityDetect", 54},
+		{"DiveAlert", 56},
+		{"DiveGasSwitched", 57},
+		{"TankPressureReserve", 71},
+		{"TankPressureCritical", 72},
+		{"TankLost", 73},
+		{"RadarThreatAlert", 75},
+		{"TankBatteryLow", 76},
+		{"TankPodConnected", 81},
+		{"TankPodDisconnected", 82},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(EventType(0)): {
+		{"Start", 0},
+		{"Stop", 1},
+		{"ConsecutiveDepreciated", 2},
+		{"Marker", 3},
+		{"StopAll", 4},
+		{"BeginDepreciated", 5},
+		{"EndDepreciated", 6},
+		{"EndAllDepreciated", 7},
+		{"StopDisable", 8},
+		{"StopDisableAll", 9},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(ExdDataUnits(0)): {
+		{"NoUnits", 0},
+		{"Laps", 1},
+		{"MilesPerHour", 2},
+		{"KilometersPerHour", 3},
+		{"FeetPerHour", 4},
+		{"MetersPerHour", 5},
+		{"DegreesCelsius", 6},
+		{"DegreesFarenheit", 7},
+		{"Zone", 8},
+		{"Gear", 9},
+		{"Rpm", 10},
+		{"Bpm", 11},
+		{"Degrees", 12},
+		{"Millimeters", 13},
+		{"Meters", 14},
+		{"Kilometers", 15},
+		{"Feet", 16},
+		{"Yards", 17},
+		{"Kilofeet", 18},
+		{"Miles", 19},
+		{"Time", 20},
+		{"EnumTurnType", 21},
+		{"Percent", 22},
+		{"Watts", 23},
+		{"WattsPerKilogram", 24},
+		{"EnumBatteryStatus", 25},
+		{"EnumBikeLightBeamAngleMode", 26},
+		{"EnumBikeLightBatteryStatus", 27},
+		{"EnumBikeLightNetworkConfigType", 28},
+		{"Lights", 29},
+		{"Seconds", 30},
+		{"Minutes", 31},
+		{"Hours", 32},
+		{"Calories", 33},
+		{"Kilojoules", 34},
+		{"Milliseconds", 35},
+		{"SecondPerMile", 36},
+		{"SecondPerKilometer", 37},
+		{"Centimeter", 38},
+		{"EnumCoursePoint", 39},
+		{"Bradians", 40},
+		{"EnumSport", 41},
+		{"InchesHg", 42},
+		{"MmHg", 43},
+		{"Mbars", 44},
+		{"HectoPascals", 45},
+		{"FeetPerMin", 46},
+		{"MetersPerMin", 47},
+		{"MetersPerSec", 48},
+		{"EightCardinal", 49},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(ExdDescriptors(0)): {
+		{"BikeLightBatteryStatus", 0},
+		{"BeamAngleStatus", 1},
+		{"BateryLevel", 2},
+		{"LightNetworkMode", 3},
+		{"NumberLightsConnected", 4},
+		{"Cadence", 5},
+		{"Distance", 6},
+		{"EstimatedTimeOfArrival", 7},
+		{"Heading", 8},
+		{"Time", 9},
+		{"BatteryLevel", 10},
+		{"TrainerResistance", 11},
+		{"TrainerTargetPower", 12},
+		{"TimeSeated", 13},
+		{"TimeStanding", 14},
+		{"Elevation", 15},
+		{"Grade", 16},
+		{"Ascent", 17},
+		{"Descent", 18},
+		{"VerticalSpeed", 19},
+		{"Di2BatteryLevel", 20},
+		{"FrontGear", 21},
+		{"RearGear", 22},
+		{"GearRatio", 23},
+		{"HeartRate", 24},
+		{"HeartRateZone", 25},
+		{"TimeInHeartRateZone", 26},
+		{"HeartRateReserve", 27},
+		{"Calories", 28},
+		{"GpsAccuracy", 29},
+		{"GpsSignalStrength", 30},
+		{"Temperature", 31},
+		{"TimeOfDay", 32},
+		{"Balance", 33},
+		{"PedalSmoothness", 34},
+		{"Power", 35},
+		{"FunctionalThresholdPower", 36},
+		{"IntensityFactor", 37},
+		{"Work", 38},
+		{"PowerRatio", 39},
+		{"NormalizedPower", 40},
+		{"TrainingStressScore", 41},
+		{"TimeOnZone", 42},
+		{"Speed", 43},
+		{"Laps", 44},
+		{"Reps", 45},
+		{"WorkoutStep", 46},
+		{"CourseDistance", 47},
+		{"NavigationDistance", 48},
+		{"CourseEstimatedTimeOfArrival", 49},
+		{"NavigationEstimatedTimeOfArrival", 50},
+		{"CourseTime", 51},
+		{"NavigationTime", 52},
+		{"CourseHeading", 53},
+		{"NavigationHeading", 54},
+		{"PowerZone", 55},
+		{"TorqueEffectiveness", 56},
+		{"TimerTime", 57},
+		{"PowerWeightRatio", 58},
+		{"LeftPlatformCenterOffset", 59},
+		{"RightPlatformCenterOffset", 60},
+		{"LeftPowerPhaseStartAngle", 61},
+		{"RightPowerPhaseStartAngle", 62},
+		{"LeftPowerPhaseFinishAngle", 63},
+		{"RightPowerPhaseFinishAngle", 64},
+		{"Gears", 65},
+		{"Pace", 66},
+		{"TrainingEffect", 67},
+		{"VerticalOscillation", 68},
+		{"VerticalRatio", 69},
+		{"GroundContactTime", 70},
+		{"LeftGroundContactTimeBalance", 71},
+		{"RightGroundContactTimeBalance", 72},
+		{"StrideLength", 73},
+		{"RunningCadence", 74},
+		{"PerformanceCondition", 75},
+		{"CourseType", 76},
+		{"TimeInPowerZone", 77},
+		{"NavigationTurn", 78},
+		{"CourseLocation", 79},
+		{"NavigationLocation", 80},
+		{"Compass", 81},
+		{"GearCombo", 82},
+		{"MuscleOxygen", 83},
+		{"Icon", 84},
+		{"CompassHeading", 85},
+		{"GpsHeading", 86},
+		{"GpsElevation", 87},
+		{"AnaerobicTrainingEffect", 88},
+		{"Course", 89},
+		{"OffCourse", 90},
+		{"GlideRatio", 91},
+		{"VerticalDistance", 92},
+		{"Vmg", 93},
+		{"AmbientPressure", 94},
+		{"Pressure", 95},
+		{"Vam", 96},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(ExdDisplayType(0)): {
+		{"Numerical", 0},
+		{"Simple", 1},
+		{"Graph", 2},
+		{"Bar", 3},
+		{"CircleGraph", 4},
+		{"VirtualPartner", 5},
+		{"Balance", 6},
+		{"StringList", 7},
+		{"String", 8},
+		{"SimpleDynamicIcon", 9},
+		{"Gauge", 10},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(ExdLayout(0)): {
+		{"FullScreen", 0},
+		{"HalfVertical", 1},
+		{"HalfHorizontal", 2},
+		{"HalfVerticalRightSplit", 3},
+		{"HalfHorizontalBottomSplit", 4},
+		{"FullQuarterSplit", 5},
+		{"HalfVerticalLeftSplit", 6},
+		{"HalfHorizontalTopSplit", 7},
+		{"Dynamic", 8},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(ExdQualifiers(0)): {
+		{"NoQualifier", 0},
+		{"Instantaneous", 1},
+		{"Average", 2},
+		{"Lap", 3},
+		{"Maximum", 4},
+		{"MaximumAverage", 5},
+		{"MaximumLap", 6},
+		{"LastLap", 7},
+		{"AverageLap", 8},
+		{"ToDestination", 9},
+		{"ToGo", 10},
+		{"ToNext", 11},
+		{"NextCoursePoint", 12},
+		{"Total", 13},
+		{"ThreeSecondAverage", 14},
+		{"TenSecondAverage", 15},
+		{"ThirtySecondAverage", 16},
+		{"PercentMaximum", 17},
+		{"PercentMaximumAverage", 18},
+		{"LapPercentMaximum", 19},
+		{"Elapsed", 20},
+		{"Sunrise", 21},
+		{"Sunset", 22},
+		{"ComparedToVirtualPartner", 23},
+		{"Maximum24h", 24},
+		{"Minimum24h", 25},
+		{"Minimum", 26},
+		{"First", 27},
+		{"Second", 28},
+		{"Third", 29},
+		{"Shifter", 30},
+		{"LastSport", 31},
+		{"Moving", 32},
+		{"Stopped", 33},
+		{"EstimatedTotal", 34},
+		{"Zone9", 242},
+		{"Zone8", 243},
+		{"Zone7", 244},
+		{"Zone6", 245},
+		{"Zone5", 246},
+		{"Zone4", 247},
+		{"Zone3", 248},
+		{"Zone2", 249},
+		{"Zone1", 250},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(ExerciseCategory(0)): {
+		{"BenchPress", 0},
+		{"CalfRaise", 1},
+		{"Cardio", 2},
+		{"Carry", 3},
+		{"Chop", 4},
+		{"Core", 5},
+		{"Crunch", 6},
+		{"Curl", 7},
+		{"Deadlift", 8},
+		{"Flye", 9},
+		{"HipRaise", 10},
+		{"HipStability", 11},
+		{"HipSwing", 12},
+		{"Hyperextension", 13},
+		{"LateralRaise", 14},
+		{"LegCurl", 15},
+		{"LegRaise", 16},
+		{"Lunge", 17},
+		{"OlympicLift", 18},
+		{"Plank", 19},
+		{"Plyo", 20},
+		{"PullUp", 21},
+		{"PushUp", 22},
+		{"Row", 23},
+		{"ShoulderPress", 24},
+		{"ShoulderStability", 25},
+		{"Shrug", 26},
+		{"SitUp", 27},
+		{"Squat", 28},
+		{"TotalBody", 29},
+		{"TricepsExtension", 30},
+		{"WarmUp", 31},
+		{"Run", 32},
+		{"Unknown", 65534},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(FaveroProduct(0)): {
+		{"AssiomaUno", 10},
+		{"AssiomaDuo", 12},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(FileFlags(0)): {
+		{"Read", 0x02},
+		{"Write", 0x04},
+		{"Erase", 0x08},
+		{"Invalid", 0x00},
+	},
+	reflect.TypeOf(FileType(0)): {
+		{"Device", 1},
+		{"Settings", 2},
+		{"Sport", 3},
+		{"Activity", 4},
+		{"Workout", 5},
+		{"Course", 6},
+		{"Schedules", 7},
+		{"Weight", 9},
+		{"Totals", 10},
+		{"Goals", 11},
+		{"BloodPressure", 14},
+		{"MonitoringA", 15},
+		{"ActivitySummary", 20},
+		{"MonitoringDaily", 28},
+		{"MonitoringB", 32},
+		{"Segment", 34},
+		{"SegmentList", 35},
+		{"ExdConfiguration", 40},
+		{"MfgRangeMin", 0xF7},
+		{"MfgRangeMax", 0xFE},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(FitBaseType(0)): {
+		{"Enum", 0},
+		{"Sint8", 1},
+		{"Uint8", 2},
+		{"Sint16", 131},
+		{"Uint16", 132},
+		{"Sint32", 133},
+		{"Uint32", 134},
+		{"String", 7},
+		{"Float32", 136},
+		{"Float64", 137},
+		{"Uint8z", 10},
+		{"Uint16z", 139},
+		{"Uint32z", 140},
+		{"Byte", 13},
+		{"Sint64", 142},
+		{"Uint64", 143},
+		{"Uint64z", 144},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(FitBaseUnit(0)): {
+		{"Other", 0},
+		{"Kilogram", 1},
+		{"Pound", 2},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(FitnessEquipmentState(0)): {
+		{"Ready", 0},
+		{"InUse", 1},
+		{"Paused", 2},
+		{"Unknown", 3},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(FlyeExerciseName(0)): {
+		{"CableCrossover", 0},
+		{"DeclineDumbbellFlye", 1},
+		{"DumbbellFlye", 2},
+		{"InclineDumbbellFlye", 3},
+		{"KettlebellFlye", 4},
+		{"KneelingRearFlye", 5},
+		{"SingleArmStandingCableReverseFlye", 6},
+		{"SwissBallDumbbellFlye", 7},
+		{"ArmRotations", 8},
+		{"HugATree", 9},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(GarminProduct(0)): {
+		{"Hrm1", 1},
+		{"Axh01", 2},
+		{"Axb01", 3},
+		{"Axb02", 4},
+		{"Hrm2ss", 5},
+		{"DsiAlf02", 6},
+		{"Hrm3ss", 7},
+		{"HrmRunSingleByteProductId", 8},
+		{"Bsm", 9},
+		{"Bcm", 10},
+		{"Axs01", 11},
+		{"HrmTriSingleByteProductId", 12},
+		{"Hrm4RunSingleByteProductId", 13},
+		{"Fr225SingleByteProductId", 14},
+		{"Gen3BsmSingleByteProductId", 15},
+		{"Gen3BcmSingleByteProductId", 16},
+		{"OHR", 255},
+		{"Fr301China", 473},
+		{"Fr301Japan", 474},
+		{"Fr301Korea", 475},
+		{"Fr301Taiwan", 494},
+		{"Fr405", 717},
+		{"Fr50", 782},
+		{"Fr405Japan", 987},
+		{"Fr60", 988},
+		{"DsiAlf01", 1011},
+		{"Fr310xt", 1018},
+		{"Edge500", 1036},
+		{"Fr110", 1124},
+		{"Edge800", 1169},
+		{"Edge500Taiwan", 1199},
+		{"Edge500Japan", 1213},
+		{"Chirp", 1253},
+		{"Fr110Japan", 1274},
+		{"Edge200", 1325},
+		{"Fr910xt", 1328},
+		{"Edge800Taiwan", 1333},
+		{"Edge800Japan", 1334},
+		{"Alf04", 1341},
+		{"Fr610", 1345},
+		{"Fr210Japan", 1360},
+		{"VectorSs", 1380},
+		{"VectorCp", 1381},
+		{"Edge800China", 1386},
+		{"Edge500China", 1387},
+		{"ApproachG10", 1405},
+		{"Fr610Japan", 1410},
+		{"Edge500Korea", 1422},
+		{"Fr70", 1436},
+		{"Fr310xt4t", 1446},
+		{"Amx", 1461},
+		{"Fr10", 1482},
+		{"Edge800Korea", 1497},
+		{"Swim", 1499},
+		{"Fr910xtChina", 1537},
+		{"Fenix", 1551},
+		{"Edge200Taiwan", 1555},
+		{"Edge510", 1561},
+		{"Edge810", 1567},
+		{"Tempe", 1570},
+		{"Fr910xtJapan", 1600},
+		{"Fr620", 1623},
+		{"Fr220", 1632},
+		{"Fr910xtKorea", 1664},
+		{"Fr10Japan", 1688},
+		{"Edge810Japan", 1721},
+		{"VirbElite", 1735},
+		{"EdgeTouring", 1736},
+		{"Edge510Japan", 1742},
+		{"HrmTri", 1743},
+		{"HrmRun", 1752},
+		{"Fr920xt", 1765},
+		{"Edge510Asia", 1821},
+		{"Edge810China", 1822},
+		{"Edge810Taiwan", 1823},
+		{"Edge1000", 1836},
+		{"VivoFit", 1837},
+		{"VirbRemote", 1853},
+		{"VivoKi", 1885},
+		{"Fr15", 1903},
+		{"VivoActive", 1907},
+		{"Edge510Korea", 1918},
+		{"Fr620Japan", 1928},
+		{"Fr620China", 1929},
+		{"Fr220Japan", 1930},
+		{"Fr220China", 1931},
+		{"ApproachS6", 1936},
+		{"VivoSmart", 1956},
+		{"Fenix2", 1967},
+		{"Epix", 1988},
+		{"Fenix3", 2050},
+		{"Edge1000Taiwan", 2052},
+		{"Edge1000Japan", 2053},
+		{"Fr15Japan", 2061},
+		{"Edge520", 2067},
+		{"Edge1000China", 2070},
+		{"Fr620Russia", 2072},
+		{"Fr220Russia", 2073},
+		{"VectorS", 2079},
+		{"Edge1000Korea", 2100},
+		{"Fr920xtTaiwan", 2130},
+		{"Fr920xtChina", 2131},
+		{"Fr920xtJapan", 2132},
+		{"Virbx", 2134},
+		{"VivoSmartApac", 2135},
+		{"EtrexTouch", 2140},
+		{"Edge25", 2147},
+		{"Fr25", 2148},
+		{"VivoFit2", 2150},
+		{"Fr225", 2153},
+		{"Fr630", 2156},
+		{"Fr230", 2157},
+		{"Fr735xt", 2158},
+		{"VivoActiveApac", 2160},
+		{"Vector2", 2161},
+		{"Vector2s", 2162},
+		{"Virbxe", 2172},
+		{"Fr620Taiwan", 2173},
+		{"Fr220Taiwan", 2174},
+		{"Truswing", 2175},
+		{"D2airvenu", 2187},
+		{"Fenix3China", 2188},
+		{"Fenix3Twn", 2189},
+		{"VariaHeadlight", 2192},
+		{"VariaTaillightOld", 2193},
+		{"EdgeExplore1000", 2204},
+		{"Fr225Asia", 2219},
+		{"VariaRadarTaillight", 2225},
+		{"VariaRadarDisplay", 2226},
+		{"Edge20", 2238},
+		{"Edge520Asia", 2260},
+		{"Edge520Japan", 2261},
+		{"D2Bravo", 2262},
+		{"ApproachS20", 2266},
+		{"VivoSmart2", 2271},
+		{"Edge1000Thai", 2274},
+		{"VariaRemote", 2276},
+		{"Edge25Asia", 2288},
+		{"Edge25Jpn", 2289},
+		{"Edge20Asia", 2290},
+		{"ApproachX40", 2292},
+		{"Fenix3Japan", 2293},
+		{"VivoSmartEmea", 2294},
+		{"Fr630Asia", 2310},
+		{"Fr630Jpn", 2311},
+		{"Fr230Jpn", 2313},
+		{"Hrm4Run", 2327},
+		{"EpixJapan", 2332},
+		{"VivoActiveHr", 2337},
+		{"VivoSmartGpsHr", 2347},
+		{"VivoSmartHr", 2348},
+		{"VivoSmartHrAsia", 2361},
+		{"VivoSmartGpsHrAsia", 2362},
+		{"VivoMove", 2368},
+		{"VariaTaillight", 2379},
+		{"Fr235Asia", 2396},
+		{"Fr235Japan", 2397},
+		{"VariaVision", 2398},
+		{"VivoFit3", 2406},
+		{"Fenix3Korea", 2407},
+		{"Fenix3Sea", 2408},
+		{"Fenix3Hr", 2413},
+		{"VirbUltra30", 2417},
+		{"IndexSmartScale", 2429},
+		{"Fr235", 2431},
+		{"Fenix3Chronos", 2432},
+		{"Oregon7xx", 2441},
+		{"Rino7xx", 2444},
+		{"EpixKorea", 2457},
+		{"Fenix3HrChn", 2473},
+		{"Fenix3HrTwn", 2474},
+		{"Fenix3HrJpn", 2475},
+		{"Fenix3HrSea", 2476},
+		{"Fenix3HrKor", 2477},
+		{"Nautix", 2496},
+		{"VivoActiveHrApac", 2497},
+		{"Fr35", 2503},
+		{"Oregon7xxWw", 2512},
+		{"Edge820", 2530},
+		{"EdgeExplore820", 2531},
+		{"Fr735xtApac", 2533},
+		{"Fr735xtJapan", 2534},
+		{"Fenix5s", 2544},
+		{"D2BravoTitanium", 2547},
+		{"VariaUt800", 2567},
+		{"RunningDynamicsPod", 2593},
+		{"Edge820China", 2599},
+		{"Edge820Japan", 2600},
+		{"Fenix5x", 2604},
+		{"VivoFitJr", 2606},
+		{"VivoSmart3", 2622},
+		{"VivoSport", 2623},
+		{"Edge820Taiwan", 2628},
+		{"Edge820Korea", 2629},
+		{"Edge820Sea", 2630},
+		{"Fr35Hebrew", 2650},
+		{"ApproachS60", 2656},
+		{"Fr35Apac", 2667},
+		{"Fr35Japan", 2668},
+		{"Fenix3ChronosAsia", 2675},
+		{"Virb360", 2687},
+		{"Fr935", 2691},
+		{"Fenix5", 2697},
+		{"Vivoactive3", 2700},
+		{"Fr235ChinaNfc", 2733},
+		{"Foretrex601701", 2769},
+		{"VivoMoveHr", 2772},
+		{"Edge1030", 2713},
+		{"Fr35Sea", 2727},
+		{"Vector3", 2787},
+		{"Fenix5Asia", 2796},
+		{"Fenix5sAsia", 2797},
+		{"Fenix5xAsia", 2798},
+		{"ApproachZ80", 2806},
+		{"Fr35Korea", 2814},
+		{"D2charlie", 2819},
+		{"VivoSmart3Apac", 2831},
+		{"VivoSportApac", 2832},
+		{"Fr935Asia", 2833},
+		{"Descent", 2859},
+		{"VivoFit4", 2878},
+		{"Fr645", 2886},
+		{"Fr645m", 2888},
+		{"Fr30", 2891},
+		{"Fenix5sPlus", 2900},
+		{"Edge130", 2909},
+		{"Edge1030Asia", 2924},
+		{"Vivosmart4", 2927},
+		{"VivoMoveHrAsia", 2945},
+		{"ApproachX10", 2962},
+		{"Fr30Asia", 2977},
+		{"Vivoactive3mW", 2988},
+		{"Fr645Asia", 3003},
+		{"Fr645mAsia", 3004},
+		{"EdgeExplore", 3011},
+		{"Gpsmap66", 3028},
+		{"ApproachS10", 3049},
+		{"Vivoactive3mL", 3066},
+		{"ApproachG80", 3085},
+		{"Edge130Asia", 3092},
+		{"Edge1030Bontrager", 3095},
+		{"Fenix5Plus", 3110},
+		{"Fenix5xPlus", 3111},
+		{"Edge520Plus", 3112},
+		{"Fr945", 3113},
+		{"Edge530", 3121},
+		{"Edge830", 3122},
+		{"InstinctEsports", 3126},
+		{"Fenix5sPlusApac", 3134},
+		{"Fenix5xPlusApac", 3135},
+		{"Edge520PlusApac", 3142},
+		{"DescentT1", 3143},
+		{"Fr235lAsia", 3144},
+		{"Fr245Asia", 3145},
+		{"VivoActive3mApac", 3163},
+		{"Gen3Bsm", 3192},
+		{"Gen3Bcm", 3193},
+		{"VivoSmart4Asia", 3218},
+		{"Vivoactive4Small", 3224},
+		{"Vivoactive4Large", 3225},
+		{"Venu", 3226},
+		{"MarqDriver", 3246},
+		{"MarqAviator", 3247},
+		{"MarqCaptain", 3248},
+		{"MarqCommander", 3249},
+		{"MarqExpedition", 3250},
+		{"MarqAthlete", 3251},
+		{"DescentMk2", 3258},
+		{"Gpsmap66i", 3284},
+		{"Fenix6SSport", 3287},
+		{"Fenix6S", 3288},
+		{"Fenix6Sport", 3289},
+		{"Fenix6", 3290},
+		{"Fenix6x", 3291},
+		{"HrmDual", 3299},
+		{"HrmPro", 3300},
+		{"VivoMove3Premium", 3308},
+		{"ApproachS40", 3314},
+		{"Fr245mAsia", 3321},
+		{"Edge530Apac", 3349},
+		{"Edge830Apac", 3350},
+		{"VivoMove3", 3378},
+		{"VivoActive4SmallAsia", 3387},
+		{"VivoActive4LargeAsia", 3388},
+		{"VivoActive4OledAsia", 3389},
+		{"Swim2", 3405},
+		{"MarqDriverAsia", 3420},
+		{"MarqAviatorAsia", 3421},
+		{"VivoMove3Asia", 3422},
+		{"Fr945Asia", 3441},
+		{"VivoActive3tChn", 3446},
+		{"MarqCaptainAsia", 3448},
+		{"MarqCommanderAsia", 3449},
+		{"MarqExpeditionAsia", 3450},
+		{"MarqAthleteAsia", 3451},
+		{"InstinctSolar", 3466},
+		{"Fr45Asia", 3469},
+		{"Vivoactive3Daimler", 3473},
+		{"LegacyRey", 3498},
+		{"LegacyDarthVader", 3499},
+		{"LegacyCaptainMarvel", 3500},
+		{"LegacyFirstAvenger", 3501},
+		{"Fenix6sSportAsia", 3512},
+		{"Fenix6sAsia", 3513},
+		{"Fenix6SportAsia", 3514},
+		{"Fenix6Asia", 3515},
+		{"Fenix6xAsia", 3516},
+		{"LegacyCaptainMarvelAsia", 3535},
+		{"LegacyFirstAvengerAsia", 3536},
+		{"LegacyReyAsia", 3537},
+		{"LegacyDarthVaderAsia", 3538},
+		{"DescentMk2s", 3542},
+		{"Edge130Plus", 3558},
+		{"Edge1030Plus", 3570},
+		{"Rally200", 3578},
+		{"Fr745", 3589},
+		{"Venusq", 3600},
+		{"Lily", 3615},
+		{"MarqAdventurer", 3624},
+		{"Enduro", 3638},
+		{"Swim2Apac", 3639},
+		{"MarqAdventurerAsia", 3648},
+		{"Fr945Lte", 3652},
+		{"DescentMk2Asia", 3702},
+		{"Venu2", 3703},
+		{"Venu2s", 3704},
+		{"VenuDaimlerAsia", 3737},
+		{"MarqGolfer", 3739},
+		{"VenuDaimler", 3740},
+		{"Fr745Asia", 3794},
+		{"LilyAsia", 3809},
+		{"Edge1030PlusAsia", 3812},
+		{"Edge130PlusAsia", 3813},
+		{"ApproachS12", 3823},
+		{"EnduroAsia", 3872},
+		{"VenusqAsia", 3837},
+		{"Edge1040", 3843},
+		{"MarqGolferAsia", 3850},
+		{"Venu2Plus", 3851},
+		{"Gnss", 3865},
+		{"Fr55", 3869},
+		{"Instinct2", 3888},
+		{"Fenix7s", 3905},
+		{"Fenix7", 3906},
+		{"Fenix7x", 3907},
+		{"Fenix7sApac", 3908},
+		{"Fenix7Apac", 3909},
+		{"Fenix7xApac", 3910},
+		{"ApproachG12", 3927},
+		{"DescentMk2sAsia", 3930},
+		{"ApproachS42", 3934},
+		{"EpixGen2", 3943},
+		{"EpixGen2Apac", 3944},
+		{"Venu2sAsia", 3949},
+		{"Venu2Asia", 3950},
+		{"Fr945LteAsia", 3978},
+		{"VivoMoveSport", 3982},
+		{"VivomoveTrend", 3983},
+		{"ApproachS12Asia", 3986},
+		{"Fr255Music", 3990},
+		{"Fr255SmallMusic", 3991},
+		{"Fr255", 3992},
+		{"Fr255Small", 3993},
+		{"ApproachG12Asia", 4001},
+		{"ApproachS42Asia", 4002},
+		{"DescentG1", 4005},
+		{"Venu2PlusAsia", 4017},
+		{"Fr955", 4024},
+		{"Fr55Asia", 4033},
+		{"Edge540", 4061},
+		{"Edge840", 4062},
+		{"Vivosmart5", 4063},
+		{"Instinct2Asia", 4071},
+		{"MarqGen2", 4105},
+		{"Venusq2", 4115},
+		{"Venusq2music", 4116},
+		{"MarqGen2Aviator", 4124},
+		{"D2AirX10", 4125},
+		{"HrmProPlus", 4130},
+		{"DescentG1Asia", 4132},
+		{"Tactix7", 4135},
+		{"InstinctCrossover", 4155},
+		{"EdgeExplore2", 4169},
+		{"ApproachS70", 4233},
+		{"Fr265Large", 4257},
+		{"Fr265Small", 4258},
+		{"TacxNeoSmart", 4265},
+		{"TacxNeo2Smart", 4266},
+		{"TacxNeo2TSmart", 4267},
+		{"TacxNeoSmartBike", 4268},
+		{"TacxSatoriSmart", 4269},
+		{"TacxFlowSmart", 4270},
+		{"TacxVortexSmart", 4271},
+		{"TacxBushidoSmart", 4272},
+		{"TacxGeniusSmart", 4273},
+		{"TacxFluxFluxSSmart", 4274},
+		{"TacxFlux2Smart", 4275},
+		{"TacxMagnum", 4276},
+		{"Edge1040Asia", 4305},
+		{"EpixGen2Pro42", 4312},
+		{"EpixGen2Pro47", 4313},
+		{"EpixGen2Pro51", 4314},
+		{"Fr965", 4315},
+		{"Enduro2", 4341},
+		{"Fenix7ProSolar", 4375},
+		{"Instinct2x", 4394},
+		{"DescentT2", 4442},
+		{"Sdm4", 10007},
+		{"EdgeRemote", 10014},
+		{"TacxTrainingAppWin", 20533},
+		{"TacxTrainingAppMac", 20534},
+		{"TacxTrainingAppMacCatalyst", 20565},
+		{"TrainingCenter", 20119},
+		{"TacxTrainingAppAndroid", 30045},
+		{"TacxTrainingAppIos", 30046},
+		{"TacxTrainingAppLegacy", 30047},
+		{"ConnectiqSimulator", 65531},
+		{"AndroidAntplusPlugin", 65532},
+		{"Connect", 65534},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(GasConsumptionRateType(0)): {
+		{"PressureSac", 0},
+		{"VolumeSac", 1},
+		{"Rmv", 2},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(Gender(0)): {
+		{"Female", 0},
+		{"Male", 1},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(Goal(0)): {
+		{"Time", 0},
+		{"Distance", 1},
+		{"Calories", 2},
+		{"Frequency", 3},
+		{"Steps", 4},
+		{"Ascent", 5},
+		{"ActiveMinutes", 6},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(GoalRecurrence(0)): {
+		{"Off", 0},
+		{"Daily", 1},
+		{"Weekly", 2},
+		{"Monthly", 3},
+		{"Yearly", 4},
+		{"Custom", 5},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(GoalSource(0)): {
+		{"Auto", 0},
+		{"Community", 1},
+		{"User", 2},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(HipRaiseExerciseName(0)): {
+		{"BarbellHipThrustOnFloor", 0},
+		{"BarbellHipThrustWithBench", 1},
+		{"BentKneeSwissBallReverseHipRaise", 2},
+		{"WeightedBentKneeSwissBallReverseHipRaise", 3},
+		{"BridgeWithLegExtension", 4},
+		{"WeightedBridgeWithLegExtension", 5},
+		{"ClamBridge", 6},
+		{"FrontKickTabletop", 7},
+		{"WeightedFrontKickTabletop", 8},
+		{"HipExtensionAndCross", 9},
+		{"WeightedHipExtensionAndCross", 10},
+		{"HipRaise", 11},
+		{"WeightedHipRaise", 12},
+		{"HipRaiseWithFeetOnSwissBall", 13},
+		{"WeightedHipRaiseWithFeetOnSwissBall", 14},
+		{"HipRaiseWithHeadOnBosuBall", 15},
+		{"WeightedHipRaiseWithHeadOnBosuBall", 16},
+		{"HipRaiseWithHeadOnSwissBall", 17},
+		{"WeightedHipRaiseWithHeadOnSwissBall", 18},
+		{"HipRaiseWithKneeSqueeze", 19},
+		{"WeightedHipRaiseWithKneeSqueeze", 20},
+		{"InclineRearLegExtension", 21},
+		{"WeightedInclineRearLegExtension", 22},
+		{"KettlebellSwing", 23},
+		{"MarchingHipRaise", 24},
+		{"WeightedMarchingHipRaise", 25},
+		{"MarchingHipRaiseWithFeetOnASwissBall", 26},
+		{"WeightedMarchingHipRaiseWithFeetOnASwissBall", 27},
+		{"ReverseHipRaise", 28},
+		{"WeightedReverseHipRaise", 29},
+		{"SingleLegHipRaise", 30},
+		{"WeightedSingleLegHipRaise", 31},
+		{"SingleLegHipRaiseWithFootOnBench", 32},
+		{"WeightedSingleLegHipRaiseWithFootOnBench", 33},
+		{"SingleLegHipRaiseWithFootOnBosuBall", 34},
+		{"WeightedSingleLegHipRaiseWithFootOnBosuBall", 35},
+		{"SingleLegHipRaiseWithFootOnFoamRoller", 36},
+		{"WeightedSingleLegHipRaiseWithFootOnFoamRoller", 37},
+		{"SingleLegHipRaiseWithFootOnMedicineBall", 38},
+		{"WeightedSingleLegHipRaiseWithFootOnMedicineBall", 39},
+		{"SingleLegHipRaiseWithHeadOnBosuBall", 40},
+		{"WeightedSingleLegHipRaiseWithHeadOnBosuBall", 41},
+		{"WeightedClamBridge", 42},
+		{"SingleLegSwissBallHipRaiseAndLegCurl", 43},
+		{"Clams", 44},
+		{"InnerThighCircles", 45},
+		{"InnerThighSideLift", 46},
+		{"LegCircles", 47},
+		{"LegLift", 48},
+		{"LegLiftInExternalRotation", 49},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(HipStabilityExerciseName(0)): {
+		{"BandSideLyingLegRaise", 0},
+		{"DeadBug", 1},
+		{"WeightedDeadBug", 2},
+		{"ExternalHipRaise", 3},
+		{"WeightedExternalHipRaise", 4},
+		{"FireHydrantKicks", 5},
+		{"WeightedFireHydrantKicks", 6},
+		{"HipCircles", 7},
+		{"WeightedHipCircles", 8},
+		{"InnerThighLift", 9},
+		{"WeightedInnerThighLift", 10},
+		{"LateralWalksWithBandAtAnkles", 11},
+		{"PretzelSideKick", 12},
+		{"WeightedPretzelSideKick", 13},
+		{"ProneHipInternalRotation", 14},
+		{"WeightedProneHipInternalRotation", 15},
+		{"Quadruped", 16},
+		{"QuadrupedHipExtension", 17},
+		{"WeightedQuadrupedHipExtension", 18},
+		{"QuadrupedWithLegLift", 19},
+		{"WeightedQuadrupedWithLegLift", 20},
+		{"SideLyingLegRaise", 21},
+		{"WeightedSideLyingLegRaise", 22},
+		{"SlidingHipAdduction", 23},
+		{"WeightedSlidingHipAdduction", 24},
+		{"StandingAdduction", 25},
+		{"WeightedStandingAdduction", 26},
+		{"StandingCableHipAbduction", 27},
+		{"StandingHipAbduction", 28},
+		{"WeightedStandingHipAbduction", 29},
+		{"StandingRearLegRaise", 30},
+		{"WeightedStandingRearLegRaise", 31},
+		{"SupineHipInternalRotation", 32},
+		{"WeightedSupineHipInternalRotation", 33},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(HipSwingExerciseName(0)): {
+		{"SingleArmKettlebellSwing", 0},
+		{"SingleArmDumbbellSwing", 1},
+		{"StepOutSwing", 2},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(HrType(0)): {
+		{"Normal", 0},
+		{"Irregular", 1},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(HrZoneCalc(0)): {
+		{"Custom", 0},
+		{"PercentMaxHr", 1},
+		{"PercentHrr", 2},
+		{"PercentLthr", 3},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(HrvStatus(0)): {
+		{"None", 0},
+		{"Poor", 1},
+		{"Low", 2},
+		{"Unbalanced", 3},
+		{"Balanced", 4},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(HyperextensionExerciseName(0)): {
+		{"BackExtensionWithOppositeArmAndLegReach", 0},
+		{"WeightedBackExtensionWithOppositeArmAndLegReach", 1},
+		{"BaseRotations", 2},
+		{"WeightedBaseRotations", 3},
+		{"BentKneeReverseHyperextension", 4},
+		{"WeightedBentKneeReverseHyperextension", 5},
+		{"HollowHoldAndRoll", 6},
+		{"WeightedHollowHoldAndRoll", 7},
+		{"Kicks", 8},
+		{"WeightedKicks", 9},
+		{"KneeRaises", 10},
+		{"WeightedKneeRaises", 11},
+		{"KneelingSuperman", 12},
+		{"WeightedKneelingSuperman", 13},
+		{"LatPullDownWithRow", 14},
+		{"MedicineBallDeadliftToReach", 15},
+		{"OneArmOneLegRow", 16},
+		{"OneArmRowWithBand", 17},
+		{"OverheadLungeWithMedicineBall", 18},
+		{"PlankKneeTucks", 19},
+		{"WeightedPlankKneeTucks", 20},
+		{"SideStep", 21},
+		{"WeightedSideStep", 22},
+		{"SingleLegBackExtension", 23},
+		{"WeightedSingleLegBackExtension", 24},
+		{"SpineExtension", 25},
+		{"WeightedSpineExtension", 26},
+		{"StaticBackExtension", 27},
+		{"WeightedStaticBackExtension", 28},
+		{"SupermanFromFloor", 29},
+		{"WeightedSupermanFromFloor", 30},
+		{"SwissBallBackExtension", 31},
+		{"WeightedSwissBallBackExtension", 32},
+		{"SwissBallHyperextension", 33},
+		{"WeightedSwissBallHyperextension", 34},
+		{"SwissBallOppositeArmAndLegLift", 35},
+		{"WeightedSwissBallOppositeArmAndLegLift", 36},
+		{"SupermanOnSwissBall", 37},
+		{"Cobra", 38},
+		{"SupineFloorBarre", 39},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(Intensity(0)): {
+		{"Active", 0},
+		{"Rest", 1},
+		{"Warmup", 2},
+		{"Cooldown", 3},
+		{"Recovery", 4},
+		{"Interval", 5},
+		{"Other", 6},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(Language(0)): {
+		{"English", 0},
+		{"French", 1},
+		{"Italian", 2},
+		{"German", 3},
+		{"Spanish", 4},
+		{"Croatian", 5},
+		{"Czech", 6},
+		{"Danish", 7},
+		{"Dutch", 8},
+		{"Finnish", 9},
+		{"Greek", 10},
+		{"Hungarian", 11},
+		{"Norwegian", 12},
+		{"Polish", 13},
+		{"Portuguese", 14},
+		{"Slovakian", 15},
+		{"Slovenian", 16},
+		{"Swedish", 17},
+		{"Russian", 18},
+		{"Turkish", 19},
+		{"Latvian", 20},
+		{"Ukrainian", 21},
+		{"Arabic", 22},
+		{"Farsi", 23},
+		{"Bulgarian", 24},
+		{"Romanian", 25},
+		{"Chinese", 26},
+		{"Japanese", 27},
+		{"Korean", 28},
+		{"Taiwanese", 29},
+		{"Thai", 30},
+		{"Hebrew", 31},
+		{"BrazilianPortuguese", 32},
+		{"Indonesian", 33},
+		{"Malaysian", 34},
+		{"Vietnamese", 35},
+		{"Burmese", 36},
+		{"Mongolian", 37},
+		{"Custom", 254},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(LanguageBits0(0)): {
+		{"English", 0x01},
+		{"French", 0x02},
+		{"Italian", 0x04},
+		{"German", 0x08},
+		{"Spanish", 0x10},
+		{"Croatian", 0x20},
+		{"Czech", 0x40},
+		{"Danish", 0x80},
+		{"Invalid", 0x00},
+	},
+	reflect.TypeOf(LanguageBits1(0)): {
+		{"Dutch", 0x01},
+		{"Finnish", 0x02},
+		{"Greek", 0x04},
+		{"Hungarian", 0x08},
+		{"Norwegian", 0x10},
+		{"Polish", 0x20},
+		{"Portuguese", 0x40},
+		{"Slovakian", 0x80},
+		{"Invalid", 0x00},
+	},
+	reflect.TypeOf(LanguageBits2(0)): {
+		{"Slovenian", 0x01},
+		{"Swedish", 0x02},
+		{"Russian", 0x04},
+		{"Turkish", 0x08},
+		{"Latvian", 0x10},
+		{"Ukrainian", 0x20},
+		{"Arabic", 0x40},
+		{"Farsi", 0x80},
+		{"Invalid", 0x00},
+	},
+	reflect.TypeOf(LanguageBits3(0)): {
+		{"Bulgarian", 0x01},
+		{"Romanian", 0x02},
+		{"Chinese", 0x04},
+		{"Japanese", 0x08},
+		{"Korean", 0x10},
+		{"Taiwanese", 0x20},
+		{"Thai", 0x40},
+		{"Hebrew", 0x80},
+		{"Invalid", 0x00},
+	},
+	reflect.TypeOf(LanguageBits4(0)): {
+		{"BrazilianPortuguese", 0x01},
+		{"Indonesian", 0x02},
+		{"Malaysian", 0x04},
+		{"Vietnamese", 0x08},
+		{"Burmese", 0x10},
+		{"Mongolian", 0x20},
+		{"Invalid", 0x00},
+	},
+	reflect.TypeOf(LapTrigger(0)): {
+		{"Manual", 0},
+		{"Time", 1},
+		{"Distance", 2},
+		{"PositionStart", 3},
+		{"PositionLap", 4},
+		{"PositionWaypoint", 5},
+		{"PositionMarked", 6},
+		{"SessionEnd", 7},
+		{"FitnessEquipment", 8},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(LateralRaiseExerciseName(0)): {
+		{"45DegreeCableExternalRotation", 0},
+		{"AlternatingLateralRaiseWithStaticHold", 1},
+		{"BarMuscleUp", 2},
+		{"BentOverLateralRaise", 3},
+		{"CableDiagonalRaise", 4},
+		{"CableFrontRaise", 5},
+		{"CalorieRow", 6},
+		{"ComboShoulderRaise", 7},
+		{"DumbbellDiagonalRaise", 8},
+		{"DumbbellVRaise", 9},
+		{"FrontRaise", 10},
+		{"LeaningDumbbellLateralRaise", 11},
+		{"LyingDumbbellRaise", 12},
+		{"MuscleUp", 13},
+		{"OneArmCableLateralRaise", 14},
+		{"OverhandGripRearLateralRaise", 15},
+		{"PlateRaises", 16},
+		{"RingDip", 17},
+		{"WeightedRingDip", 18},
+		{"RingMuscleUp", 19},
+		{"WeightedRingMuscleUp", 20},
+		{"RopeClimb", 21},
+		{"WeightedRopeClimb", 22},
+		{"Scaption", 23},
+		{"SeatedLateralRaise", 24},
+		{"SeatedRearLateralRaise", 25},
+		{"SideLyingLateralRaise", 26},
+		{"StandingLift", 27},
+		{"SuspendedRow", 28},
+		{"UnderhandGripRearLateralRaise", 29},
+		{"WallSlide", 30},
+		{"WeightedWallSlide", 31},
+		{"ArmCircles", 32},
+		{"ShavingTheHead", 33},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(LeftRightBalance(0)): {
+		{"Mask", 0x7F},
+		{"Right", 0x80},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(LeftRightBalance100(0)): {
+		{"Mask", 0x3FFF},
+		{"Right", 0x8000},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(LegCurlExerciseName(0)): {
+		{"LegCurl", 0},
+		{"WeightedLegCurl", 1},
+		{"GoodMorning", 2},
+		{"SeatedBarbellGoodMorning", 3},
+		{"SingleLegBarbellGoodMorning", 4},
+		{"SingleLegSlidingLegCurl", 5},
+		{"SlidingLegCurl", 6},
+		{"SplitBarbellGoodMorning", 7},
+		{"SplitStanceExtension", 8},
+		{"StaggeredStanceGoodMorning", 9},
+		{"SwissBallHipRaiseAndLegCurl", 10},
+		{"ZercherGoodMorning", 11},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(LegRaiseExerciseName(0)): {
+		{"HangingKneeRaise", 0},
+		{"HangingLegRaise", 1},
+		{"WeightedHangingLegRaise", 2},
+		{"HangingSingleLegRaise", 3},
+		{"WeightedHangingSingleLegRaise", 4},
+		{"KettlebellLegRaises", 5},
+		{"LegLoweringDrill", 6},
+		{"WeightedLegLoweringDrill", 7},
+		{"LyingStraightLegRaise", 8},
+		{"WeightedLyingStraightLegRaise", 9},
+		{"MedicineBallLegDrops", 10},
+		{"QuadrupedLegRaise", 11},
+		{"WeightedQuadrupedLegRaise", 12},
+		{"ReverseLegRaise", 13},
+		{"WeightedReverseLegRaise", 14},
+		{"ReverseLegRaiseOnSwissBall", 15},
+		{"WeightedReverseLegRaiseOnSwissBall", 16},
+		{"SingleLegLoweringDrill", 17},
+		{"WeightedSingleLegLoweringDrill", 18},
+		{"WeightedHangingKneeRaise", 19},
+		{"LateralStepover", 20},
+		{"WeightedLateralStepover", 21},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(LengthType(0)): {
+		{"Idle", 0},
+		{"Active", 1},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(LocalDeviceType(0)): {
+		{"Gps", 0},
+		{"Glonass", 1},
+		{"GpsGlonass", 2},
+		{"Accelerometer", 3},
+		{"Barometer", 4},
+		{"Temperature", 5},
+		{"Whr", 10},
+		{"SensorHub", 12},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(LocaltimeIntoDay(0)): {
+		{"Invalid", 0xFFFFFFFF},
+	},
+	reflect.TypeOf(LungeExerciseName(0)): {
+		{"OverheadLunge", 0},
+		{"LungeMatrix", 1},
+		{"WeightedLungeMatrix", 2},
+		{"AlternatingBarbellForwardLunge", 3},
+		{"AlternatingDumbbellLungeWithReach", 4},
+		{"BackFootElevatedDumbbellSplitSquat", 5},
+		{"BarbellBoxLunge", 6},
+		{"BarbellBulgarianSplitSquat", 7},
+		{"BarbellCrossoverLunge", 8},
+		{"BarbellFrontSplitSquat", 9},
+		{"BarbellLunge", 10},
+		{"BarbellReverseLunge", 11},
+		{"BarbellSideLunge", 12},
+		{"BarbellSplitSquat", 13},
+		{"CoreControlRearLunge", 14},
+		{"DiagonalLunge", 15},
+		{"DropLunge", 16},
+		{"DumbbellBoxLunge", 17},
+		{"DumbbellBulgarianSplitSquat", 18},
+		{"DumbbellCrossoverLunge", 19},
+		{"DumbbellDiagonalLunge", 20},
+		{"DumbbellLunge", 21},
+		{"DumbbellLungeAndRotation", 22},
+		{"DumbbellOverheadBulgarianSplitSquat", 23},
+		{"DumbbellReverseLungeToHighKneeAndPress", 24},
+		{"DumbbellSideLunge", 25},
+		{"ElevatedFrontFootBarbellSplitSquat", 26},
+		{"FrontFootElevatedDumbbellSplitSquat", 27},
+		{"GunslingerLunge", 28},
+		{"LawnmowerLunge", 29},
+		{"LowLungeWithIsometricAdduction", 30},
+		{"LowSideToSideLunge", 31},
+		{"Lunge", 32},
+		{"WeightedLunge", 33},
+		{"LungeWithArmReach", 34},
+		{"LungeWithDiagonalReach", 35},
+		{"LungeWithSideBend", 36},
+		{"OffsetDumbbellLunge", 37},
+		{"OffsetDumbbellReverseLunge", 38},
+		{"OverheadBulgarianSplitSquat", 39},
+		{"OverheadDumbbellReverseLunge", 40},
+		{"OverheadDumbbellSplitSquat", 41},
+		{"OverheadLungeWithRotation", 42},
+		{"ReverseBarbellBoxLunge", 43},
+		{"ReverseBoxLunge", 44},
+		{"ReverseDumbbellBoxLunge", 45},
+		{"ReverseDumbbellCrossoverLunge", 46},
+		{"ReverseDumbbellDiagonalLunge", 47},
+		{"ReverseLungeWithReachBack", 48},
+		{"WeightedReverseLungeWithReachBack", 49},
+		{"ReverseLungeWithTwistAndOverheadReach", 50},
+		{"WeightedReverseLungeWithTwistAndOverheadReach", 51},
+		{"ReverseSlidingBoxLunge", 52},
+		{"WeightedReverseSlidingBoxLunge", 53},
+		{"ReverseSlidingLunge", 54},
+		{"WeightedReverseSlidingLunge", 55},
+		{"RunnersLungeToBalance", 56},
+		{"WeightedRunnersLungeToBalance", 57},
+		{"ShiftingSideLunge", 58},
+		{"SideAndCrossoverLunge", 59},
+		{"WeightedSideAndCrossoverLunge", 60},
+		{"SideLunge", 61},
+		{"WeightedSideLunge", 62},
+		{"SideLungeAndPress", 63},
+		{"SideLungeJumpOff", 64},
+		{"SideLungeSweep", 65},
+		{"WeightedSideLungeSweep", 66},
+		{"SideLungeToCrossoverTap", 67},
+		{"WeightedSideLungeToCrossoverTap", 68},
+		{"SideToSideLungeChops", 69},
+		{"WeightedSideToSideLungeChops", 70},
+		{"SiffJumpLunge", 71},
+		{"WeightedSiffJumpLunge", 72},
+		{"SingleArmReverseLungeAndPress", 73},
+		{"SlidingLateralLunge", 74},
+		{"WeightedSlidingLateralLunge", 75},
+		{"WalkingBarbellLunge", 76},
+		{"WalkingDumbbellLunge", 77},
+		{"WalkingLunge", 78},
+		{"WeightedWalkingLunge", 79},
+		{"WideGripOverheadBarbellSplitSquat", 80},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(Manufacturer(0)): {
+		{"Garmin", 1},
+		{"GarminFr405Antfs", 2},
+		{"Zephyr", 3},
+		{"Dayton", 4},
+		{"Idt", 5},
+		{"Srm", 6},
+		{"Quarq", 7},
+		{"Ibike", 8},
+		{"Saris", 9},
+		{"SparkHk", 10},
+		{"Tanita", 11},
+		{"Echowell", 12},
+		{"DynastreamOem", 13},
+		{"Nautilus", 14},
+		{"Dynastream", 15},
+		{"Timex", 16},
+		{"Metrigear", 17},
+		{"Xelic", 18},
+		{"Beurer", 19},
+		{"Cardiosport", 20},
+		{"AAndD", 21},
+		{"Hmm", 22},
+		{"Suunto", 23},
+		{"ThitaElektronik", 24},
+		{"Gpulse", 25},
+		{"CleanMobile", 26},
+		{"PedalBrain", 27},
+		{"Peaksware", 28},
+		{"Saxonar", 29},
+		{"LemondFitness", 30},
+		{"Dexcom", 31},
+		{"WahooFitness", 32},
+		{"OctaneFitness", 33},
+		{"Archinoetics", 34},
+		{"TheHurtBox", 35},
+		{"CitizenSystems", 36},
+		{"Magellan", 37},
+		{"Osynce", 38},
+		{"Holux", 39},
+		{"Concept2", 40},
+		{"Shimano", 41},
+		{"OneGiantLeap", 42},
+		{"AceSensor", 43},
+		{"BrimBrothers", 44},
+		{"Xplova", 45},
+		{"PerceptionDigital", 46},
+		{"Bf1systems", 47},
+		{"Pioneer", 48},
+		{"Spantec", 49},
+		{"Metalogics", 50},
+		{"4iiiis", 51},
+		{"SeikoEpson", 52},
+		{"SeikoEpsonOem", 53},
+		{"IforPowell", 54},
+		{"MaxwellGuider", 55},
+		{"StarTrac", 56},
+		{"Breakaway", 57},
+		{"AlatechTechnologyLtd", 58},
+		{"MioTechnologyEurope", 59},
+		{"Rotor", 60},
+		{"Geonaute", 61},
+		{"IdBike", 62},
+		{"Specialized", 63},
+		{"Wtek", 64},
+		{"PhysicalEnterprises", 65},
+		{"NorthPoleEngineering", 66},
+		{"Bkool", 67},
+		{"Cateye", 68},
+		{"StagesCycling", 69},
+		{"Sigmasport", 70},
+		{"Tomtom", 71},
+		{"Peripedal", 72},
+		{"Wattbike", 73},
+		{"Moxy", 76},
+		{"Ciclosport", 77},
+		{"Powerbahn", 78},
+		{"AcornProjectsAps", 79},
+		{"Lifebeam", 80},
+		{"Bontrager", 81},
+		{"Wellgo", 82},
+		{"Scosche", 83},
+		{"Magura", 84},
+		{"Woodway", 85},
+		{"Elite", 86},
+		{"NielsenKellerman", 87},
+		{"DkCity", 88},
+		{"Tacx", 89},
+		{"DirectionTechnology", 90},
+		{"Magtonic", 91},
+		{"1partcarbon", 92},
+		{"InsideRideTechnologies", 93},
+		{"SoundOfMotion", 94},
+		{"Stryd", 95},
+		{"Icg", 96},
+		{"MiPulse", 97},
+		{"BsxAthletics", 98},
+		{"Look", 99},
+		{"CampagnoloSrl", 100},
+		{"BodyBikeSmart", 101},
+		{"Praxisworks", 102},
+		{"LimitsTechnology", 103},
+		{"TopactionTechnology", 104},
+		{"Cosinuss", 105},
+		{"Fitcare", 106},
+		{"Magene", 107},
+		{"GiantManufacturingCo", 108},
+		{"Tigrasport", 109},
+		{"Salutron", 110},
+		{"Technogym", 111},
+		{"BrytonSensors", 112},
+		{"LatitudeLimited", 113},
+		{"SoaringTechnology", 114},
+		{"Igpsport", 115},
+		{"Thinkrider", 116},
+		{"GopherSport", 117},
+		{"Waterrower", 118},
+		{"Orangetheory", 119},
+		{"Inpeak", 120},
+		{"Kinetic", 121},
+		{"JohnsonHealthTech", 122},
+		{"PolarElectro", 123},
+		{"Seesense", 124},
+		{"NciTechnology", 125},
+		{"Iqsquare", 126},
+		{"Leomo", 127},
+		{"IfitCom", 128},
+		{"CorosByte", 129},
+		{"VersaDesign", 130},
+		{"Chileaf", 131},
+		{"Cycplus", 132},
+		{"GravaaByte", 133},
+		{"Sigeyi", 134},
+		{"Coospo", 135},
+		{"Geoid", 136},
+		{"Bosch", 137},
+		{"Kyto", 138},
+		{"KineticSports", 139},
+		{"DecathlonByte", 140},
+		{"TqSystems", 141},
+		{"TagHeuer", 142},
+		{"KeiserFitness", 143},
+		{"ZwiftByte", 144},
+		{"PorscheEp", 145},
+		{"Blackbird", 146},
+		{"MeilanByte", 147},
+		{"Ezon", 148},
+		{"Development", 255},
+		{"Healthandlife", 257},
+		{"Lezyne", 258},
+		{"ScribeLabs", 259},
+		{"Zwift", 260},
+		{"Watteam", 261},
+		{"Recon", 262},
+		{"FaveroElectronics", 263},
+		{"Dynovelo", 264},
+		{"Strava", 265},
+		{"Precor", 266},
+		{"Bryton", 267},
+		{"Sram", 268},
+		{"Navman", 269},
+		{"Cobi", 270},
+		{"Spivi", 271},
+		{"MioMagellan", 272},
+		{"Evesports", 273},
+		{"SensitivusGauge", 274},
+		{"Podoon", 275},
+		{"LifeTimeFitness", 276},
+		{"FalcoEMotors", 277},
+		{"Minoura", 278},
+		{"Cycliq", 279},
+		{"Luxottica", 280},
+		{"TrainerRoad", 281},
+		{"TheSufferfest", 282},
+		{"Fullspeedahead", 283},
+		{"Virtualtraining", 284},
+		{"Feedbacksports", 285},
+		{"Omata", 286},
+		{"Vdo", 287},
+		{"Magneticdays", 288},
+		{"Hammerhead", 289},
+		{"KineticByKurt", 290},
+		{"Shapelog", 291},
+		{"Dabuziduo", 292},
+		{"Jetblack", 293},
+		{"Coros", 294},
+		{"Virtugo", 295},
+		{"Velosense", 296},
+		{"Cycligentinc", 297},
+		{"Trailforks", 298},
+		{"MahleEbikemotion", 299},
+		{"Nurvv", 300},
+		{"Microprogram", 301},
+		{"Zone5cloud", 302},
+		{"Greenteg", 303},
+		{"YamahaMotors", 304},
+		{"Whoop", 305},
+		{"Gravaa", 306},
+		{"Onelap", 307},
+		{"MonarkExercise", 308},
+		{"Form", 309},
+		{"Decathlon", 310},
+		{"Syncros", 311},
+		{"Heatup", 312},
+		{"Cannondale", 313},
+		{"TrueFitness", 314},
+		{"RGTCycling", 315},
+		{"Vasa", 316},
+		{"RaceRepublic", 317},
+		{"Fazua", 318},
+		{"OrekaTraining", 319},
+		{"Lsec", 320},
+		{"LululemonStudio", 321},
+		{"Shanyue", 322},
+		{"SpinningMda", 323},
+		{"Hilldating", 324},
+		{"Actigraphcorp", 5759},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(MaxMetCategory(0)): {
+		{"Generic", 0},
+		{"Cycling", 1},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(MaxMetHeartRateSource(0)): {
+		{"Whr", 0},
+		{"Hrm", 1},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(MaxMetSpeedSource(0)): {
+		{"OnboardGps", 0},
+		{"ConnectedGps", 1},
+		{"Cadence", 2},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(MesgCount(0)): {
+		{"NumPerFile", 0},
+		{"MaxPerFile", 1},
+		{"MaxPerFileType", 2},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(MesgNum(0)): {
+		{"FileId", 0},
+		{"Capabilities", 1},
+		{"DeviceSettings", 2},
+		{"UserProfile", 3},
+		{"HrmProfile", 4},
+		{"SdmProfile", 5},
+		{"BikeProfile", 6},
+		{"ZonesTarget", 7},
+		{"HrZone", 8},
+		{"PowerZone", 9},
+		{"MetZone", 10},
+		{"Sport", 12},
+		{"Goal", 15},
+		{"Session", 18},
+		{"Lap", 19},
+		{"Record", 20},
+		{"Event", 21},
+		{"DeviceInfo", 23},
+		{"Workout", 26},
+		{"WorkoutStep", 27},
+		{"Schedule", 28},
+		{"WeightScale", 30},
+		{"Course", 31},
+		{"CoursePoint", 32},
+		{"Totals", 33},
+		{"Activity", 34},
+		{"Software", 35},
+		{"FileCapabilities", 37},
+		{"MesgCapabilities", 38},
+		{"FieldCapabilities", 39},
+		{"FileCreator", 49},
+		{"BloodPressure", 51},
+		{"SpeedZone", 53},
+		{"Monitoring", 55},
+		{"TrainingFile", 72},
+		{"Hrv", 78},
+		{"AntRx", 80},
+		{"AntTx", 81},
+		{"AntChannelId", 82},
+		{"Length", 101},
+		{"MonitoringInfo", 103},
+		{"Pad", 105},
+		{"SlaveDevice", 106},
+		{"Connectivity", 127},
+		{"WeatherConditions", 128},
+		{"WeatherAlert", 129},
+		{"CadenceZone", 131},
+		{"Hr", 132},
+		{"SegmentLap", 142},
+		{"MemoGlob", 145},
+		{"SegmentId", 148},
+		{"SegmentLeaderboardEntry", 149},
+		{"SegmentPoint", 150},
+		{"SegmentFile", 151},
+		{"WorkoutSession", 158},
+		{"WatchfaceSettings", 159},
+		{"GpsMetadata", 160},
+		{"CameraEvent", 161},
+		{"TimestampCorrelation", 162},
+		{"GyroscopeData", 164},
+		{"AccelerometerData", 165},
+		{"ThreeDSensorCalibration", 167},
+		{"VideoFrame", 169},
+		{"ObdiiData", 174},
+		{"NmeaSentence", 177},
+		{"AviationAttitude", 178},
+		{"Video", 184},
+		{"VideoTitle", 185},
+		{"VideoDescription", 186},
+		{"VideoClip", 187},
+		{"OhrSettings", 188},
+		{"ExdScreenConfiguration", 200},
+		{"ExdDataFieldConfiguration", 201},
+		{"ExdDataConceptConfiguration", 202},
+		{"FieldDescription", 206},
+		{"DeveloperDataId", 207},
+		{"MagnetometerData", 208},
+		{"BarometerData", 209},
+		{"OneDSensorCalibration", 210},
+		{"MonitoringHrData", 211},
+		{"TimeInZone", 216},
+		{"Set", 225},
+		{"StressLevel", 227},
+		{"MaxMetData", 229},
+		{"DiveSettings", 258},
+		{"DiveGas", 259},
+		{"DiveAlarm", 262},
+		{"ExerciseTitle", 264},
+		{"DiveSummary", 268},
+		{"Spo2Data", 269},
+		{"SleepLevel", 275},
+		{"Jump", 285},
+		{"BeatIntervals", 290},
+		{"RespirationRate", 297},
+		{"Split", 312},
+		{"ClimbPro", 317},
+		{"TankUpdate", 319},
+		{"TankSummary", 323},
+		{"SleepAssessment", 346},
+		{"HrvStatusSummary", 370},
+		{"HrvValue", 371},
+		{"DeviceAuxBatteryInfo", 375},
+		{"DiveApneaAlarm", 393},
+		{"MfgRangeMin", 0xFF00},
+		{"MfgRangeMax", 0xFFFE},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(MessageIndex(0)): {
+		{"Selected", 0x8000},
+		{"Reserved", 0x7000},
+		{"Mask", 0x0FFF},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(NoFlyTimeMode(0)): {
+		{"Standard", 0},
+		{"Flat24Hours", 1},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(OlympicLiftExerciseName(0)): {
+		{"BarbellHangPowerClean", 0},
+		{"BarbellHangSquatClean", 1},
+		{"BarbellPowerClean", 2},
+		{"BarbellPowerSnatch", 3},
+		{"BarbellSquatClean", 4},
+		{"CleanAndJerk", 5},
+		{"BarbellHangPowerSnatch", 6},
+		{"BarbellHangPull", 7},
+		{"BarbellHighPull", 8},
+		{"BarbellSnatch", 9},
+		{"BarbellSplitJerk", 10},
+		{"Clean", 11},
+		{"DumbbellClean", 12},
+		{"DumbbellHangPull", 13},
+		{"OneHandDumbbellSplitSnatch", 14},
+		{"PushJerk", 15},
+		{"SingleArmDumbbellSnatch", 16},
+		{"SingleArmHangSnatch", 17},
+		{"SingleArmKettlebellSnatch", 18},
+		{"SplitJerk", 19},
+		{"SquatCleanAndJerk", 20},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(PlankExerciseName(0)): {
+		{"45DegreePlank", 0},
+		{"Weighted45DegreePlank", 1},
+		{"90DegreeStaticHold", 2},
+		{"Weighted90DegreeStaticHold", 3},
+		{"BearCrawl", 4},
+		{"WeightedBearCrawl", 5},
+		{"CrossBodyMountainClimber", 6},
+		{"WeightedCrossBodyMountainClimber", 7},
+		{"ElbowPlankPikeJacks", 8},
+		{"WeightedElbowPlankPikeJacks", 9},
+		{"ElevatedFeetPlank", 10},
+		{"WeightedElevatedFeetPlank", 11},
+		{"ElevatorAbs", 12},
+		{"WeightedElevatorAbs", 13},
+		{"ExtendedPlank", 14},
+		{"WeightedExtendedPlank", 15},
+		{"FullPlankPasseTwist", 16},
+		{"WeightedFullPlankPasseTwist", 17},
+		{"InchingElbowPlank", 18},
+		{"WeightedInchingElbowPlank", 19},
+		{"InchwormToSidePlank", 20},
+		{"WeightedInchwormToSidePlank", 21},
+		{"KneelingPlank", 22},
+		{"WeightedKneelingPlank", 23},
+		{"KneelingSidePlankWithLegLift", 24},
+		{"WeightedKneelingSidePlankWithLegLift", 25},
+		{"LateralRoll", 26},
+		{"WeightedLateralRoll", 27},
+		{"LyingReversePlank", 28},
+		{"WeightedLyingReversePlank", 29},
+		{"MedicineBallMountainClimber", 30},
+		{"WeightedMedicineBallMountainClimber", 31},
+		{"ModifiedMountainClimberAndExtension", 32},
+		{"WeightedModifiedMountainClimberAndExtension", 33},
+		{"MountainClimber", 34},
+		{"WeightedMountainClimber", 35},
+		{"MountainClimberOnSlidingDiscs", 36},
+		{"WeightedMountainClimberOnSlidingDiscs", 37},
+		{"MountainClimberWithFeetOnBosuBall", 38},
+		{"WeightedMountainClimberWithFeetOnBosuBall", 39},
+		{"MountainClimberWithHandsOnBench", 40},
+		{"MountainClimberWithHandsOnSwissBall", 41},
+		{"WeightedMountainClimberWithHandsOnSwissBall", 42},
+		{"Plank", 43},
+		{"PlankJacksWithFeetOnSlidingDiscs", 44},
+		{"WeightedPlankJacksWithFeetOnSlidingDiscs", 45},
+		{"PlankKneeTwist", 46},
+		{"WeightedPlankKneeTwist", 47},
+		{"PlankPikeJumps", 48},
+		{"WeightedPlankPikeJumps", 49},
+		{"PlankPikes", 50},
+		{"WeightedPlankPikes", 51},
+		{"PlankToStandUp", 52},
+		{"WeightedPlankToStandUp", 53},
+		{"PlankWithArmRaise", 54},
+		{"WeightedPlankWithArmRaise", 55},
+		{"PlankWithKneeToElbow", 56},
+		{"WeightedPlankWithKneeToElbow", 57},
+		{"PlankWithObliqueCrunch", 58},
+		{"WeightedPlankWithObliqueCrunch", 59},
+		{"PlyometricSidePlank", 60},
+		{"WeightedPlyometricSidePlank", 61},
+		{"RollingSidePlank", 62},
+		{"WeightedRollingSidePlank", 63},
+		{"SideKickPlank", 64},
+		{"WeightedSideKickPlank", 65},
+		{"SidePlank", 66},
+		{"WeightedSidePlank", 67},
+		{"SidePlankAndRow", 68},
+		{"WeightedSidePlankAndRow", 69},
+		{"SidePlankLift", 70},
+		{"WeightedSidePlankLift", 71},
+		{"SidePlankWithElbowOnBosuBall", 72},
+		{"WeightedSidePlankWithElbowOnBosuBall", 73},
+		{"SidePlankWithFeetOnBench", 74},
+		{"WeightedSidePlankWithFeetOnBench", 75},
+		{"SidePlankWithKneeCircle", 76},
+		{"WeightedSidePlankWithKneeCircle", 77},
+		{"SidePlankWithKneeTuck", 78},
+		{"WeightedSidePlankWithKneeTuck", 79},
+		{"SidePlankWithLegLift", 80},
+		{"WeightedSidePlankWithLegLift", 81},
+		{"SidePlankWithReachUnder", 82},
+		{"WeightedSidePlankWithReachUnder", 83},
+		{"SingleLegElevatedFeetPlank", 84},
+		{"WeightedSingleLegElevatedFeetPlank", 85},
+		{"SingleLegFlexAndExtend", 86},
+		{"WeightedSingleLegFlexAndExtend", 87},
+		{"SingleLegSidePlank", 88},
+		{"WeightedSingleLegSidePlank", 89},
+		{"SpidermanPlank", 90},
+		{"WeightedSpidermanPlank", 91},
+		{"StraightArmPlank", 92},
+		{"WeightedStraightArmPlank", 93},
+		{"StraightArmPlankWithShoulderTouch", 94},
+		{"WeightedStraightArmPlankWithShoulderTouch", 95},
+		{"SwissBallPlank", 96},
+		{"WeightedSwissBallPlank", 97},
+		{"SwissBallPlankLegLift", 98},
+		{"WeightedSwissBallPlankLegLift", 99},
+		{"SwissBallPlankLegLiftAndHold", 100},
+		{"SwissBallPlankWithFeetOnBench", 101},
+		{"WeightedSwissBallPlankWithFeetOnBench", 102},
+		{"SwissBallProneJackknife", 103},
+		{"WeightedSwissBallProneJackknife", 104},
+		{"SwissBallSidePlank", 105},
+		{"WeightedSwissBallSidePlank", 106},
+		{"ThreeWayPlank", 107},
+		{"WeightedThreeWayPlank", 108},
+		{"TowelPlankAndKneeIn", 109},
+		{"WeightedTowelPlankAndKneeIn", 110},
+		{"TStabilization", 111},
+		{"WeightedTStabilization", 112},
+		{"TurkishGetUpToSidePlank", 113},
+		{"WeightedTurkishGetUpToSidePlank", 114},
+		{"TwoPointPlank", 115},
+		{"WeightedTwoPointPlank", 116},
+		{"WeightedPlank", 117},
+		{"WideStancePlankWithDiagonalArmLift", 118},
+		{"WeightedWideStancePlankWithDiagonalArmLift", 119},
+		{"WideStancePlankWithDiagonalLegLift", 120},
+		{"WeightedWideStancePlankWithDiagonalLegLift", 121},
+		{"WideStancePlankWithLegLift", 122},
+		{"WeightedWideStancePlankWithLegLift", 123},
+		{"WideStancePlankWithOppositeArmAndLegLift", 124},
+		{"WeightedMountainClimberWithHandsOnBench", 125},
+		{"WeightedSwissBallPlankLegLiftAndHold", 126},
+		{"WeightedWideStancePlankWithOppositeArmAndLegLift", 127},
+		{"PlankWithFeetOnSwissBall", 128},
+		{"SidePlankToPlankWithReachUnder", 129},
+		{"BridgeWithGluteLowerLift", 130},
+		{"BridgeOneLegBridge", 131},
+		{"PlankWithArmVariations", 132},
+		{"PlankWithLegLift", 133},
+		{"ReversePlankWithLegPull", 134},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(PlyoExerciseName(0)): {
+		{"AlternatingJumpLunge", 0},
+		{"WeightedAlternatingJumpLunge", 1},
+		{"BarbellJumpSquat", 2},
+		{"BodyWeightJumpSquat", 3},
+		{"WeightedJumpSquat", 4},
+		{"CrossKneeStrike", 5},
+		{"WeightedCrossKneeStrike", 6},
+		{"DepthJump", 7},
+		{"WeightedDepthJump", 8},
+		{"DumbbellJumpSquat", 9},
+		{"DumbbellSplitJump", 10},
+		{"FrontKneeStrike", 11},
+		{"WeightedFrontKneeStrike", 12},
+		{"HighBoxJump", 13},
+		{"WeightedHighBoxJump", 14},
+		{"IsometricExplosiveBodyWeightJumpSquat", 15},
+		{"WeightedIsometricExplosiveJumpSquat", 16},
+		{"LateralLeapAndHop", 17},
+		{"WeightedLateralLeapAndHop", 18},
+		{"LateralPlyoSquats", 19},
+		{"WeightedLateralPlyoSquats", 20},
+		{"LateralSlide", 21},
+		{"WeightedLateralSlide", 22},
+		{"MedicineBallOverheadThrows", 23},
+		{"MedicineBallSideThrow", 24},
+		{"MedicineBallSlam", 25},
+		{"SideToSideMedicineBallThrows", 26},
+		{"SideToSideShuffleJump", 27},
+		{"WeightedSideToSideShuffleJump", 28},
+		{"SquatJumpOntoBox", 29},
+		{"WeightedSquatJumpOntoBox", 30},
+		{"SquatJumpsInAndOut", 31},
+		{"WeightedSquatJumpsInAndOut", 32},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(PowerPhaseType(0)): {
+		{"PowerPhaseStartAngle", 0},
+		{"PowerPhaseEndAngle", 1},
+		{"PowerPhaseArcLength", 2},
+		{"PowerPhaseCenter", 3},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(PullUpExerciseName(0)): {
+		{"BandedPullUps", 0},
+		{"30DegreeLatPulldown", 1},
+		{"BandAssistedChinUp", 2},
+		{"CloseGripChinUp", 3},
+		{"WeightedCloseGripChinUp", 4},
+		{"CloseGripLatPulldown", 5},
+		{"CrossoverChinUp", 6},
+		{"WeightedCrossoverChinUp", 7},
+		{"EzBarPullover", 8},
+		{"HangingHurdle", 9},
+		{"WeightedHangingHurdle", 10},
+		{"KneelingLatPulldown", 11},
+		{"KneelingUnderhandGripLatPulldown", 12},
+		{"LatPulldown", 13},
+		{"MixedGripChinUp", 14},
+		{"WeightedMixedGripChinUp", 15},
+		{"MixedGripPullUp", 16},
+		{"WeightedMixedGripPullUp", 17},
+		{"ReverseGripPulldown", 18},
+		{"StandingCablePullover", 19},
+		{"StraightArmPulldown", 20},
+		{"SwissBallEzBarPullover", 21},
+		{"TowelPullUp", 22},
+		{"WeightedTowelPullUp", 23},
+		{"WeightedPullUp", 24},
+		{"WideGripLatPulldown", 25},
+		{"WideGripPullUp", 26},
+		{"WeightedWideGripPullUp", 27},
+		{"BurpeePullUp", 28},
+		{"WeightedBurpeePullUp", 29},
+		{"JumpingPullUps", 30},
+		{"WeightedJumpingPullUps", 31},
+		{"KippingPullUp", 32},
+		{"WeightedKippingPullUp", 33},
+		{"LPullUp", 34},
+		{"WeightedLPullUp", 35},
+		{"SuspendedChinUp", 36},
+		{"WeightedSuspendedChinUp", 37},
+		{"PullUp", 38},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(PushUpExerciseName(0)): {
+		{"ChestPressWithBand", 0},
+		{"AlternatingStaggeredPushUp", 1},
+		{"WeightedAlternatingStaggeredPushUp", 2},
+		{"AlternatingHandsMedicineBallPushUp", 3},
+		{"WeightedAlternatingHandsMedicineBallPushUp", 4},
+		{"BosuBallPushUp", 5},
+		{"WeightedBosuBallPushUp", 6},
+		{"ClappingPushUp", 7},
+		{"WeightedClappingPushUp", 8},
+		{"CloseGripMedicineBallPushUp", 9},
+		{"WeightedCloseGripMedicineBallPushUp", 10},
+		{"CloseHandsPushUp", 11},
+		{"WeightedCloseHandsPushUp", 12},
+		{"DeclinePushUp", 13},
+		{"WeightedDeclinePushUp", 14},
+		{"DiamondPushUp", 15},
+		{"WeightedDiamondPushUp", 16},
+		{"ExplosiveCrossoverPushUp", 17},
+		{"WeightedExplosiveCrossoverPushUp", 18},
+		{"ExplosivePushUp", 19},
+		{"WeightedExplosivePushUp", 20},
+		{"FeetElevatedSideToSidePushUp", 21},
+		{"WeightedFeetElevatedSideToSidePushUp", 22},
+		{"HandReleasePushUp", 23},
+		{"WeightedHandReleasePushUp", 24},
+		{"HandstandPushUp", 25},
+		{"WeightedHandstandPushUp", 26},
+		{"InclinePushUp", 27},
+		{"WeightedInclinePushUp", 28},
+		{"IsometricExplosivePushUp", 29},
+		{"WeightedIsometricExplosivePushUp", 30},
+		{"JudoPushUp", 31},
+		{"WeightedJudoPushUp", 32},
+		{"KneelingPushUp", 33},
+		{"WeightedKneelingPushUp", 34},
+		{"MedicineBallChestPass", 35},
+		{"MedicineBallPushUp", 36},
+		{"WeightedMedicineBallPushUp", 37},
+		{"OneArmPushUp", 38},
+		{"WeightedOneArmPushUp", 39},
+		{"WeightedPushUp", 40},
+		{"PushUpAndRow", 41},
+		{"WeightedPushUpAndRow", 42},
+		{"PushUpPlus", 43},
+		{"WeightedPushUpPlus", 44},
+		{"PushUpWithFeetOnSwissBall", 45},
+		{"WeightedPushUpWithFeetOnSwissBall", 46},
+		{"PushUpWithOneHandOnMedicineBall", 47},
+		{"WeightedPushUpWithOneHandOnMedicineBall", 48},
+		{"ShoulderPushUp", 49},
+		{"WeightedShoulderPushUp", 50},
+		{"SingleArmMedicineBallPushUp", 51},
+		{"WeightedSingleArmMedicineBallPushUp", 52},
+		{"SpidermanPushUp", 53},
+		{"WeightedSpidermanPushUp", 54},
+		{"StackedFeetPushUp", 55},
+		{"WeightedStackedFeetPushUp", 56},
+		{"StaggeredHandsPushUp", 57},
+		{"WeightedStaggeredHandsPushUp", 58},
+		{"SuspendedPushUp", 59},
+		{"WeightedSuspendedPushUp", 60},
+		{"SwissBallPushUp", 61},
+		{"WeightedSwissBallPushUp", 62},
+		{"SwissBallPushUpPlus", 63},
+		{"WeightedSwissBallPushUpPlus", 64},
+		{"TPushUp", 65},
+		{"WeightedTPushUp", 66},
+		{"TripleStopPushUp", 67},
+		{"WeightedTripleStopPushUp", 68},
+		{"WideHandsPushUp", 69},
+		{"WeightedWideHandsPushUp", 70},
+		{"ParalletteHandstandPushUp", 71},
+		{"WeightedParalletteHandstandPushUp", 72},
+		{"RingHandstandPushUp", 73},
+		{"WeightedRingHandstandPushUp", 74},
+		{"RingPushUp", 75},
+		{"WeightedRingPushUp", 76},
+		{"PushUp", 77},
+		{"PilatesPushup", 78},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(PwrZoneCalc(0)): {
+		{"Custom", 0},
+		{"PercentFtp", 1},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(RadarThreatLevelType(0)): {
+		{"ThreatUnknown", 0},
+		{"ThreatNone", 1},
+		{"ThreatApproaching", 2},
+		{"ThreatApproachingFast", 3},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(RiderPositionType(0)): {
+		{"Seated", 0},
+		{"Standing", 1},
+		{"TransitionToSeated", 2},
+		{"TransitionToStanding", 3},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(RowExerciseName(0)): {
+		{"BarbellStraightLegDeadliftToRow", 0},
+		{"CableRowStanding", 1},
+		{"DumbbellRow", 2},
+		{"ElevatedFeetInvertedRow", 3},
+		{"WeightedElevatedFeetInvertedRow", 4},
+		{"FacePull", 5},
+		{"FacePullWithExternalRotation", 6},
+		{"InvertedRowWithFeetOnSwissBall", 7},
+		{"WeightedInvertedRowWithFeetOnSwissBall", 8},
+		{"KettlebellRow", 9},
+		{"ModifiedInvertedRow", 10},
+		{"WeightedModifiedInvertedRow", 11},
+		{"NeutralGripAlternatingDumbbellRow", 12},
+		{"OneArmBentOverRow", 13},
+		{"OneLeggedDumbbellRow", 14},
+		{"RenegadeRow", 15},
+		{"ReverseGripBarbellRow", 16},
+		{"RopeHandleCableRow", 17},
+		{"SeatedCableRow", 18},
+		{"SeatedDumbbellRow", 19},
+		{"SingleArmCableRow", 20},
+		{"SingleArmCableRowAndRotation", 21},
+		{"SingleArmInvertedRow", 22},
+		{"WeightedSingleArmInvertedRow", 23},
+		{"SingleArmNeutralGripDumbbellRow", 24},
+		{"SingleArmNeutralGripDumbbellRowAndRotation", 25},
+		{"SuspendedInvertedRow", 26},
+		{"WeightedSuspendedInvertedRow", 27},
+		{"TBarRow", 28},
+		{"TowelGripInvertedRow", 29},
+		{"WeightedTowelGripInvertedRow", 30},
+		{"UnderhandGripCableRow", 31},
+		{"VGripCableRow", 32},
+		{"WideGripSeatedCableRow", 33},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(RunExerciseName(0)): {
+		{"Run", 0},
+		{"Walk", 1},
+		{"Jog", 2},
+		{"Sprint", 3},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(Schedule(0)): {
+		{"Workout", 0},
+		{"Course", 1},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(SegmentDeleteStatus(0)): {
+		{"DoNotDelete", 0},
+		{"DeleteOne", 1},
+		{"DeleteAll", 2},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(SegmentLapStatus(0)): {
+		{"End", 0},
+		{"Fail", 1},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(SegmentLeaderboardType(0)): {
+		{"Overall", 0},
+		{"PersonalBest", 1},
+		{"Connections", 2},
+		{"Group", 3},
+		{"Challenger", 4},
+		{"Kom", 5},
+		{"Qom", 6},
+		{"Pr", 7},
+		{"Goal", 8},
+		{"Rival", 9},
+		{"ClubLeader", 10},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(SegmentSelectionType(0)): {
+		{"Starred", 0},
+		{"Suggested", 1},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(SensorType(0)): {
+		{"Accelerometer", 0},
+		{"Gyroscope", 1},
+		{"Compass", 2},
+		{"Barometer", 3},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(SessionTrigger(0)): {
+		{"ActivityEnd", 0},
+		{"Manual", 1},
+		{"AutoMultiSport", 2},
+		{"FitnessEquipment", 3},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(SetType(0)): {
+		{"Rest", 0},
+		{"Active", 1},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(ShoulderPressExerciseName(0)): {
+		{"AlternatingDumbbellShoulderPress", 0},
+		{"ArnoldPress", 1},
+		{"BarbellFrontSquatToPushPress", 2},
+		{"BarbellPushPress", 3},
+		{"BarbellShoulderPress", 4},
+		{"DeadCurlPress", 5},
+		{"DumbbellAlternatingShoulderPressAndTwist", 6},
+		{"DumbbellHammerCurlToLungeToPress", 7},
+		{"DumbbellPushPress", 8},
+		{"FloorInvertedShoulderPress", 9},
+		{"WeightedFloorInvertedShoulderPress", 10},
+		{"InvertedShoulderPress", 11},
+		{"WeightedInvertedShoulderPress", 12},
+		{"OneArmPushPress", 13},
+		{"OverheadBarbellPress", 14},
+		{"OverheadDumbbellPress", 15},
+		{"SeatedBarbellShoulderPress", 16},
+		{"SeatedDumbbellShoulderPress", 17},
+		{"SingleArmDumbbellShoulderPress", 18},
+		{"SingleArmStepUpAndPress", 19},
+		{"SmithMachineOverheadPress", 20},
+		{"SplitStanceHammerCurlToPress", 21},
+		{"SwissBallDumbbellShoulderPress", 22},
+		{"WeightPlateFrontRaise", 23},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(ShoulderStabilityExerciseName(0)): {
+		{"90DegreeCableExternalRotation", 0},
+		{"BandExternalRotation", 1},
+		{"BandInternalRotation", 2},
+		{"BentArmLateralRaiseAndExternalRotation", 3},
+		{"CableExternalRotation", 4},
+		{"DumbbellFacePullWithExternalRotation", 5},
+		{"FloorIRaise", 6},
+		{"WeightedFloorIRaise", 7},
+		{"FloorTRaise", 8},
+		{"WeightedFloorTRaise", 9},
+		{"FloorYRaise", 10},
+		{"WeightedFloorYRaise", 11},
+		{"InclineIRaise", 12},
+		{"WeightedInclineIRaise", 13},
+		{"InclineLRaise", 14},
+		{"WeightedInclineLRaise", 15},
+		{"InclineTRaise", 16},
+		{"WeightedInclineTRaise", 17},
+		{"InclineWRaise", 18},
+		{"WeightedInclineWRaise", 19},
+		{"InclineYRaise", 20},
+		{"WeightedInclineYRaise", 21},
+		{"LyingExternalRotation", 22},
+		{"SeatedDumbbellExternalRotation", 23},
+		{"StandingLRaise", 24},
+		{"SwissBallIRaise", 25},
+		{"WeightedSwissBallIRaise", 26},
+		{"SwissBallTRaise", 27},
+		{"WeightedSwissBallTRaise", 28},
+		{"SwissBallWRaise", 29},
+		{"WeightedSwissBallWRaise", 30},
+		{"SwissBallYRaise", 31},
+		{"WeightedSwissBallYRaise", 32},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(ShrugExerciseName(0)): {
+		{"BarbellJumpShrug", 0},
+		{"BarbellShrug", 1},
+		{"BarbellUprightRow", 2},
+		{"BehindTheBackSmithMachineShrug", 3},
+		{"DumbbellJumpShrug", 4},
+		{"DumbbellShrug", 5},
+		{"DumbbellUprightRow", 6},
+		{"InclineDumbbellShrug", 7},
+		{"OverheadBarbellShrug", 8},
+		{"OverheadDumbbellShrug", 9},
+		{"ScaptionAndShrug", 10},
+		{"ScapularRetraction", 11},
+		{"SerratusChairShrug", 12},
+		{"WeightedSerratusChairShrug", 13},
+		{"SerratusShrug", 14},
+		{"WeightedSerratusShrug", 15},
+		{"WideGripJumpShrug", 16},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(Side(0)): {
+		{"Right", 0},
+		{"Left", 1},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(SitUpExerciseName(0)): {
+		{"AlternatingSitUp", 0},
+		{"WeightedAlternatingSitUp", 1},
+		{"BentKneeVUp", 2},
+		{"WeightedBentKneeVUp", 3},
+		{"ButterflySitUp", 4},
+		{"WeightedButterflySitup", 5},
+		{"CrossPunchRollUp", 6},
+		{"WeightedCrossPunchRollUp", 7},
+		{"CrossedArmsSitUp", 8},
+		{"WeightedCrossedArmsSitUp", 9},
+		{"GetUpSitUp", 10},
+		{"WeightedGetUpSitUp", 11},
+		{"HoveringSitUp", 12},
+		{"WeightedHoveringSitUp", 13},
+		{"KettlebellSitUp", 14},
+		{"MedicineBallAlternatingVUp", 15},
+		{"MedicineBallSitUp", 16},
+		{"MedicineBallVUp", 17},
+		{"ModifiedSitUp", 18},
+		{"NegativeSitUp", 19},
+		{"OneArmFullSitUp", 20},
+		{"RecliningCircle", 21},
+		{"WeightedRecliningCircle", 22},
+		{"ReverseCurlUp", 23},
+		{"WeightedReverseCurlUp", 24},
+		{"SingleLegSwissBallJackknife", 25},
+		{"WeightedSingleLegSwissBallJackknife", 26},
+		{"TheTeaser", 27},
+		{"TheTeaserWeighted", 28},
+		{"ThreePartRollDown", 29},
+		{"WeightedThreePartRollDown", 30},
+		{"VUp", 31},
+		{"WeightedVUp", 32},
+		{"WeightedRussianTwistOnSwissBall", 33},
+		{"WeightedSitUp", 34},
+		{"XAbs", 35},
+		{"WeightedXAbs", 36},
+		{"SitUp", 37},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(SleepLevel(0)): {
+		{"Unmeasurable", 0},
+		{"Awake", 1},
+		{"Light", 2},
+		{"Deep", 3},
+		{"Rem", 4},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(SourceType(0)): {
+		{"Ant", 0},
+		{"Antplus", 1},
+		{"Bluetooth", 2},
+		{"BluetoothLowEnergy", 3},
+		{"Wifi", 4},
+		{"Local", 5},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(SplitType(0)): {
+		{"AscentSplit", 1},
+		{"DescentSplit", 2},
+		{"IntervalActive", 3},
+		{"IntervalRest", 4},
+		{"IntervalWarmup", 5},
+		{"IntervalCooldown", 6},
+		{"IntervalRecovery", 7},
+		{"IntervalOther", 8},
+		{"ClimbActive", 9},
+		{"ClimbRest", 10},
+		{"SurfActive", 11},
+		{"RunActive", 12},
+		{"RunRest", 13},
+		{"WorkoutRound", 14},
+		{"RwdRun", 17},
+		{"RwdWalk", 18},
+		{"WindsurfActive", 21},
+		{"RwdStand", 22},
+		{"Transition", 23},
+		{"SkiLiftSplit", 28},
+		{"SkiRunSplit", 29},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(Spo2MeasurementType(0)): {
+		{"OffWrist", 0},
+		{"SpotCheck", 1},
+		{"ContinuousCheck", 2},
+		{"Periodic", 3},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(Sport(0)): {
+		{"Generic", 0},
+		{"Running", 1},
+		{"Cycling", 2},
+		{"Transition", 3},
+		{"FitnessEquipment", 4},
+		{"Swimming", 5},
+		{"Basketball", 6},
+		{"Soccer", 7},
+		{"Tennis", 8},
+		{"AmericanFootball", 9},
+		{"Training", 10},
+		{"Walking", 11},
+		{"CrossCountrySkiing", 12},
+		{"AlpineSkiing", 13},
+		{"Snowboarding", 14},
+		{"Rowing", 15},
+		{"Mountaineering", 16},
+		{"Hiking", 17},
+		{"Multisport", 18},
+		{"Paddling", 19},
+		{"Flying", 20},
+		{"EBiking", 21},
+		{"Motorcycling", 22},
+		{"Boating", 23},
+		{"Driving", 24},
+		{"Golf", 25},
+		{"HangGliding", 26},
+		{"HorsebackRiding", 27},
+		{"Hunting", 28},
+		{"Fishing", 29},
+		{"InlineSkating", 30},
+		{"RockClimbing", 31},
+		{"Sailing", 32},
+		{"IceSkating", 33},
+		{"SkyDiving", 34},
+		{"Snowshoeing", 35},
+		{"Snowmobiling", 36},
+		{"StandUpPaddleboarding", 37},
+		{"Surfing", 38},
+		{"Wakeboarding", 39},
+		{"WaterSkiing", 40},
+		{"Kayaking", 41},
+		{"Rafting", 42},
+		{"Windsurfing", 43},
+		{"Kitesurfing", 44},
+		{"Tactical", 45},
+		{"Jumpmaster", 46},
+		{"Boxing", 47},
+		{"FloorClimbing", 48},
+		{"Diving", 53},
+		{"Hiit", 62},
+		{"Racket", 64},
+		{"WaterTubing", 76},
+		{"Wakesurfing", 77},
+		{"All", 254},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(SportBits0(0)): {
+		{"Generic", 0x01},
+		{"Running", 0x02},
+		{"Cycling", 0x04},
+		{"Transition", 0x08},
+		{"FitnessEquipment", 0x10},
+		{"Swimming", 0x20},
+		{"Basketball", 0x40},
+		{"Soccer", 0x80},
+		{"Invalid", 0x00},
+	},
+	reflect.TypeOf(SportBits1(0)): {
+		{"Tennis", 0x01},
+		{"AmericanFootball", 0x02},
+		{"Training", 0x04},
+		{"Walking", 0x08},
+		{"CrossCountrySkiing", 0x10},
+		{"AlpineSkiing", 0x20},
+		{"Snowboarding", 0x40},
+		{"Rowing", 0x80},
+		{"Invalid", 0x00},
+	},
+	reflect.TypeOf(SportBits2(0)): {
+		{"Mountaineering", 0x01},
+		{"Hiking", 0x02},
+		{"Multisport", 0x04},
+		{"Paddling", 0x08},
+		{"Flying", 0x10},
+		{"EBiking", 0x20},
+		{"Motorcycling", 0x40},
+		{"Boating", 0x80},
+		{"Invalid", 0x00},
+	},
+	reflect.TypeOf(SportBits3(0)): {
+		{"Driving", 0x01},
+		{"Golf", 0x02},
+		{"HangGliding", 0x04},
+		{"HorsebackRiding", 0x08},
+		{"Hunting", 0x10},
+		{"Fishing", 0x20},
+		{"InlineSkating", 0x40},
+		{"RockClimbing", 0x80},
+		{"Invalid", 0x00},
+	},
+	reflect.TypeOf(SportBits4(0)): {
+		{"Sailing", 0x01},
+		{"IceSkating", 0x02},
+		{"SkyDiving", 0x04},
+		{"Snowshoeing", 0x08},
+		{"Snowmobiling", 0x10},
+		{"StandUpPaddleboarding", 0x20},
+		{"Surfing", 0x40},
+		{"Wakeboarding", 0x80},
+		{"Invalid", 0x00},
+	},
+	reflect.TypeOf(SportBits5(0)): {
+		{"WaterSkiing", 0x01},
+		{"Kayaking", 0x02},
+		{"Rafting", 0x04},
+		{"Windsurfing", 0x08},
+		{"Kitesurfing", 0x10},
+		{"Tactical", 0x20},
+		{"Jumpmaster", 0x40},
+		{"Boxing", 0x80},
+		{"Invalid", 0x00},
+	},
+	reflect.TypeOf(SportBits6(0)): {
+		{"FloorClimbing", 0x01},
+		{"Invalid", 0x00},
+	},
+	reflect.TypeOf(SportEvent(0)): {
+		{"Uncategorized", 0},
+		{"Geocaching", 1},
+		{"Fitness", 2},
+		{"Recreation", 3},
+		{"Race", 4},
+		{"SpecialEvent", 5},
+		{"Training", 6},
+		{"Transportation", 7},
+		{"Touring", 8},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(SquatExerciseName(0)): {
+		{"LegPress", 0},
+		{"BackSquatWithBodyBar", 1},
+		{"BackSquats", 2},
+		{"WeightedBackSquats", 3},
+		{"BalancingSquat", 4},
+		{"WeightedBalancingSquat", 5},
+		{"BarbellBackSquat", 6},
+		{"BarbellBoxSquat", 7},
+		{"BarbellFrontSquat", 8},
+		{"BarbellHackSquat", 9},
+		{"BarbellHangSquatSnatch", 10},
+		{"BarbellLateralStepUp", 11},
+		{"BarbellQuarterSquat", 12},
+		{"BarbellSiffSquat", 13},
+		{"BarbellSquatSnatch", 14},
+		{"BarbellSquatWithHeelsRaised", 15},
+		{"BarbellStepover", 16},
+		{"BarbellStepUp", 17},
+		{"BenchSquatWithRotationalChop", 18},
+		{"WeightedBenchSquatWithRotationalChop", 19},
+		{"BodyWeightWallSquat", 20},
+		{"WeightedWallSquat", 21},
+		{"BoxStepSquat", 22},
+		{"WeightedBoxStepSquat", 23},
+		{"BracedSquat", 24},
+		{"CrossedArmBarbellFrontSquat", 25},
+		{"CrossoverDumbbellStepUp", 26},
+		{"DumbbellFrontSquat", 27},
+		{"DumbbellSplitSquat", 28},
+		{"DumbbellSquat", 29},
+		{"DumbbellSquatClean", 30},
+		{"DumbbellStepover", 31},
+		{"DumbbellStepUp", 32},
+		{"ElevatedSingleLegSquat", 33},
+		{"WeightedElevatedSingleLegSquat", 34},
+		{"FigureFourSquats", 35},
+		{"WeightedFigureFourSquats", 36},
+		{"GobletSquat", 37},
+		{"KettlebellSquat", 38},
+		{"KettlebellSwingOverhead", 39},
+		{"KettlebellSwingWithFlipToSquat", 40},
+		{"LateralDumbbellStepUp", 41},
+		{"OneLeggedSquat", 42},
+		{"OverheadDumbbellSquat", 43},
+		{"OverheadSquat", 44},
+		{"PartialSingleLegSquat", 45},
+		{"WeightedPartialSingleLegSquat", 46},
+		{"PistolSquat", 47},
+		{"WeightedPistolSquat", 48},
+		{"PlieSlides", 49},
+		{"WeightedPlieSlides", 50},
+		{"PlieSquat", 51},
+		{"WeightedPlieSquat", 52},
+		{"PrisonerSquat", 53},
+		{"WeightedPrisonerSquat", 54},
+		{"SingleLegBenchGetUp", 55},
+		{"WeightedSingleLegBenchGetUp", 56},
+		{"SingleLegBenchSquat", 57},
+		{"WeightedSingleLegBenchSquat", 58},
+		{"SingleLegSquatOnSwissBall", 59},
+		{"WeightedSingleLegSquatOnSwissBall", 60},
+		{"Squat", 61},
+		{"WeightedSquat", 62},
+		{"SquatsWithBand", 63},
+		{"StaggeredSquat", 64},
+		{"WeightedStaggeredSquat", 65},
+		{"StepUp", 66},
+		{"WeightedStepUp", 67},
+		{"SuitcaseSquats", 68},
+		{"SumoSquat", 69},
+		{"SumoSquatSlideIn", 70},
+		{"WeightedSumoSquatSlideIn", 71},
+		{"SumoSquatToHighPull", 72},
+		{"SumoSquatToStand", 73},
+		{"WeightedSumoSquatToStand", 74},
+		{"SumoSquatWithRotation", 75},
+		{"WeightedSumoSquatWithRotation", 76},
+		{"SwissBallBodyWeightWallSquat", 77},
+		{"WeightedSwissBallWallSquat", 78},
+		{"Thrusters", 79},
+		{"UnevenSquat", 80},
+		{"WeightedUnevenSquat", 81},
+		{"WaistSlimmingSquat", 82},
+		{"WallBall", 83},
+		{"WideStanceBarbellSquat", 84},
+		{"WideStanceGobletSquat", 85},
+		{"ZercherSquat", 86},
+		{"KbsOverhead", 87},
+		{"SquatAndSideKick", 88},
+		{"SquatJumpsInNOut", 89},
+		{"PilatesPlieSquatsParallelTurnedOutFlatAndHeels", 90},
+		{"ReleveStraightLegAndKneeBentWithOneLegVariation", 91},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(StrokeType(0)): {
+		{"NoEvent", 0},
+		{"Other", 1},
+		{"Serve", 2},
+		{"Forehand", 3},
+		{"Backhand", 4},
+		{"Smash", 5},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(SubSport(0)): {
+		{"Generic", 0},
+		{"Treadmill", 1},
+		{"Street", 2},
+		{"Trail", 3},
+		{"Track", 4},
+		{"Spin", 5},
+		{"IndoorCycling", 6},
+		{"Road", 7},
+		{"Mountain", 8},
+		{"Downhill", 9},
+		{"Recumbent", 10},
+		{"Cyclocross", 11},
+		{"HandCycling", 12},
+		{"TrackCycling", 13},
+		{"IndoorRowing", 14},
+		{"Elliptical", 15},
+		{"StairClimbing", 16},
+		{"LapSwimming", 17},
+		{"OpenWater", 18},
+		{"FlexibilityTraining", 19},
+		{"StrengthTraining", 20},
+		{"WarmUp", 21},
+		{"Match", 22},
+		{"Exercise", 23},
+		{"Challenge", 24},
+		{"IndoorSkiing", 25},
+		{"CardioTraining", 26},
+		{"IndoorWalking", 27},
+		{"EBikeFitness", 28},
+		{"Bmx", 29},
+		{"CasualWalking", 30},
+		{"SpeedWalking", 31},
+		{"BikeToRunTransition", 32},
+		{"RunToBikeTransition", 33},
+		{"SwimToBikeTransition", 34},
+		{"Atv", 35},
+		{"Motocross", 36},
+		{"Backcountry", 37},
+		{"Resort", 38},
+		{"RcDrone", 39},
+		{"Wingsuit", 40},
+		{"Whitewater", 41},
+		{"SkateSkiing", 42},
+		{"Yoga", 43},
+		{"Pilates", 44},
+		{"IndoorRunning", 45},
+		{"GravelCycling", 46},
+		{"EBikeMountain", 47},
+		{"Commuting", 48},
+		{"MixedSurface", 49},
+		{"Navigate", 50},
+		{"TrackMe", 51},
+		{"Map", 52},
+		{"SingleGasDiving", 53},
+		{"MultiGasDiving", 54},
+		{"GaugeDiving", 55},
+		{"ApneaDiving", 56},
+		{"ApneaHunting", 57},
+		{"VirtualActivity", 58},
+		{"Obstacle", 59},
+		{"Breathing", 62},
+		{"SailRace", 65},
+		{"Ultra", 67},
+		{"IndoorClimbing", 68},
+		{"Bouldering", 69},
+		{"Hiit", 70},
+		{"Amrap", 73},
+		{"Emom", 74},
+		{"Tabata", 75},
+		{"Pickleball", 84},
+		{"Padel", 85},
+		{"FlyCanopy", 110},
+		{"FlyParaglide", 111},
+		{"FlyParamotor", 112},
+		{"FlyPressurized", 113},
+		{"FlyNavigate", 114},
+		{"FlyTimer", 115},
+		{"FlyAltimeter", 116},
+		{"FlyWx", 117},
+		{"FlyVfr", 118},
+		{"FlyIfr", 119},
+		{"All", 254},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(SupportedExdScreenLayouts(0)): {
+		{"FullScreen", 0x00000001},
+		{"HalfVertical", 0x00000002},
+		{"HalfHorizontal", 0x00000004},
+		{"HalfVerticalRightSplit", 0x00000008},
+		{"HalfHorizontalBottomSplit", 0x00000010},
+		{"FullQuarterSplit", 0x00000020},
+		{"HalfVerticalLeftSplit", 0x00000040},
+		{"HalfHorizontalTopSplit", 0x00000080},
+		{"Invalid", 0x00000000},
+	},
+	reflect.TypeOf(SwimStroke(0)): {
+		{"Freestyle", 0},
+		{"Backstroke", 1},
+		{"Breaststroke", 2},
+		{"Butterfly", 3},
+		{"Drill", 4},
+		{"Mixed", 5},
+		{"Im", 6},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(Switch(0)): {
+		{"Off", 0},
+		{"On", 1},
+		{"Auto", 2},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(TapSensitivity(0)): {
+		{"High", 0},
+		{"Medium", 1},
+		{"Low", 2},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(TimeIntoDay(0)): {
+		{"Invalid", 0xFFFFFFFF},
+	},
+	reflect.TypeOf(TimeMode(0)): {
+		{"Hour12", 0},
+		{"Hour24", 1},
+		{"Military", 2},
+		{"Hour12WithSeconds", 3},
+		{"Hour24WithSeconds", 4},
+		{"Utc", 5},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(TimeZone(0)): {
+		{"Almaty", 0},
+		{"Bangkok", 1},
+		{"Bombay", 2},
+		{"Brasilia", 3},
+		{"Cairo", 4},
+		{"CapeVerdeIs", 5},
+		{"Darwin", 6},
+		{"Eniwetok", 7},
+		{"Fiji", 8},
+		{"HongKong", 9},
+		{"Islamabad", 10},
+		{"Kabul", 11},
+		{"Magadan", 12},
+		{"MidAtlantic", 13},
+		{"Moscow", 14},
+		{"Muscat", 15},
+		{"Newfoundland", 16},
+		{"Samoa", 17},
+		{"Sydney", 18},
+		{"Tehran", 19},
+		{"Tokyo", 20},
+		{"UsAlaska", 21},
+		{"UsAtlantic", 22},
+		{"UsCentral", 23},
+		{"UsEastern", 24},
+		{"UsHawaii", 25},
+		{"UsMountain", 26},
+		{"UsPacific", 27},
+		{"Other", 28},
+		{"Auckland", 29},
+		{"Kathmandu", 30},
+		{"EuropeWesternWet", 31},
+		{"EuropeCentralCet", 32},
+		{"EuropeEasternEet", 33},
+		{"Jakarta", 34},
+		{"Perth", 35},
+		{"Adelaide", 36},
+		{"Brisbane", 37},
+		{"Tasmania", 38},
+		{"Iceland", 39},
+		{"Amsterdam", 40},
+		{"Athens", 41},
+		{"Barcelona", 42},
+		{"Berlin", 43},
+		{"Brussels", 44},
+		{"Budapest", 45},
+		{"Copenhagen", 46},
+		{"Dublin", 47},
+		{"Helsinki", 48},
+		{"Lisbon", 49},
+		{"London", 50},
+		{"Madrid", 51},
+		{"Munich", 52},
+		{"Oslo", 53},
+		{"Paris", 54},
+		{"Prague", 55},
+		{"Reykjavik", 56},
+		{"Rome", 57},
+		{"Stockholm", 58},
+		{"Vienna", 59},
+		{"Warsaw", 60},
+		{"Zurich", 61},
+		{"Quebec", 62},
+		{"Ontario", 63},
+		{"Manitoba", 64},
+		{"Saskatchewan", 65},
+		{"Alberta", 66},
+		{"BritishColumbia", 67},
+		{"Boise", 68},
+		{"Boston", 69},
+		{"Chicago", 70},
+		{"Dallas", 71},
+		{"Denver", 72},
+		{"KansasCity", 73},
+		{"LasVegas", 74},
+		{"LosAngeles", 75},
+		{"Miami", 76},
+		{"Minneapolis", 77},
+		{"NewYork", 78},
+		{"NewOrleans", 79},
+		{"Phoenix", 80},
+		{"SantaFe", 81},
+		{"Seattle", 82},
+		{"WashingtonDc", 83},
+		{"UsArizona", 84},
+		{"Chita", 85},
+		{"Ekaterinburg", 86},
+		{"Irkutsk", 87},
+		{"Kaliningrad", 88},
+		{"Krasnoyarsk", 89},
+		{"Novosibirsk", 90},
+		{"PetropavlovskKamchatskiy", 91},
+		{"Samara", 92},
+		{"Vladivostok", 93},
+		{"MexicoCentral", 94},
+		{"MexicoMountain", 95},
+		{"MexicoPacific", 96},
+		{"CapeTown", 97},
+		{"Winkhoek", 98},
+		{"Lagos", 99},
+		{"Riyahd", 100},
+		{"Venezuela", 101},
+		{"AustraliaLh", 102},
+		{"Santiago", 103},
+		{"Manual", 253},
+		{"Automatic", 254},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(TimerTrigger(0)): {
+		{"Manual", 0},
+		{"Auto", 1},
+		{"FitnessEquipment", 2},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(TissueModelType(0)): {
+		{"Zhl16c", 0},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(Tone(0)): {
+		{"Off", 0},
+		{"Tone", 1},
+		{"Vibrate", 2},
+		{"ToneAndVibrate", 3},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(TotalBodyExerciseName(0)): {
+		{"Burpee", 0},
+		{"WeightedBurpee", 1},
+		{"BurpeeBoxJump", 2},
+		{"WeightedBurpeeBoxJump", 3},
+		{"HighPullBurpee", 4},
+		{"ManMakers", 5},
+		{"OneArmBurpee", 6},
+		{"SquatThrusts", 7},
+		{"WeightedSquatThrusts", 8},
+		{"SquatPlankPushUp", 9},
+		{"WeightedSquatPlankPushUp", 10},
+		{"StandingTRotationBalance", 11},
+		{"WeightedStandingTRotationBalance", 12},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(TricepsExtensionExerciseName(0)): {
+		{"BenchDip", 0},
+		{"WeightedBenchDip", 1},
+		{"BodyWeightDip", 2},
+		{"CableKickback", 3},
+		{"CableLyingTricepsExtension", 4},
+		{"CableOverheadTricepsExtension", 5},
+		{"DumbbellKickback", 6},
+		{"DumbbellLyingTricepsExtension", 7},
+		{"EzBarOverheadTricepsExtension", 8},
+		{"InclineDip", 9},
+		{"WeightedInclineDip", 10},
+		{"InclineEzBarLyingTricepsExtension", 11},
+		{"LyingDumbbellPulloverToExtension", 12},
+		{"LyingEzBarTricepsExtension", 13},
+		{"LyingTricepsExtensionToCloseGripBenchPress", 14},
+		{"OverheadDumbbellTricepsExtension", 15},
+		{"RecliningTricepsPress", 16},
+		{"ReverseGripPressdown", 17},
+		{"ReverseGripTricepsPressdown", 18},
+		{"RopePressdown", 19},
+		{"SeatedBarbellOverheadTricepsExtension", 20},
+		{"SeatedDumbbellOverheadTricepsExtension", 21},
+		{"SeatedEzBarOverheadTricepsExtension", 22},
+		{"SeatedSingleArmOverheadDumbbellExtension", 23},
+		{"SingleArmDumbbellOverheadTricepsExtension", 24},
+		{"SingleDumbbellSeatedOverheadTricepsExtension", 25},
+		{"SingleLegBenchDipAndKick", 26},
+		{"WeightedSingleLegBenchDipAndKick", 27},
+		{"SingleLegDip", 28},
+		{"WeightedSingleLegDip", 29},
+		{"StaticLyingTricepsExtension", 30},
+		{"SuspendedDip", 31},
+		{"WeightedSuspendedDip", 32},
+		{"SwissBallDumbbellLyingTricepsExtension", 33},
+		{"SwissBallEzBarLyingTricepsExtension", 34},
+		{"SwissBallEzBarOverheadTricepsExtension", 35},
+		{"TabletopDip", 36},
+		{"WeightedTabletopDip", 37},
+		{"TricepsExtensionOnFloor", 38},
+		{"TricepsPressdown", 39},
+		{"WeightedDip", 40},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(TurnType(0)): {
+		{"ArrivingIdx", 0},
+		{"ArrivingLeftIdx", 1},
+		{"ArrivingRightIdx", 2},
+		{"ArrivingViaIdx", 3},
+		{"ArrivingViaLeftIdx", 4},
+		{"ArrivingViaRightIdx", 5},
+		{"BearKeepLeftIdx", 6},
+		{"BearKeepRightIdx", 7},
+		{"ContinueIdx", 8},
+		{"ExitLeftIdx", 9},
+		{"ExitRightIdx", 10},
+		{"FerryIdx", 11},
+		{"Roundabout45Idx", 12},
+		{"Roundabout90Idx", 13},
+		{"Roundabout135Idx", 14},
+		{"Roundabout180Idx", 15},
+		{"Roundabout225Idx", 16},
+		{"Roundabout270Idx", 17},
+		{"Roundabout315Idx", 18},
+		{"Roundabout360Idx", 19},
+		{"RoundaboutNeg45Idx", 20},
+		{"RoundaboutNeg90Idx", 21},
+		{"RoundaboutNeg135Idx", 22},
+		{"RoundaboutNeg180Idx", 23},
+		{"RoundaboutNeg225Idx", 24},
+		{"RoundaboutNeg270Idx", 25},
+		{"RoundaboutNeg315Idx", 26},
+		{"RoundaboutNeg360Idx", 27},
+		{"RoundaboutGenericIdx", 28},
+		{"RoundaboutNegGenericIdx", 29},
+		{"SharpTurnLeftIdx", 30},
+		{"SharpTurnRightIdx", 31},
+		{"TurnLeftIdx", 32},
+		{"TurnRightIdx", 33},
+		{"UturnLeftIdx", 34},
+		{"UturnRightIdx", 35},
+		{"IconInvIdx", 36},
+		{"IconIdxCnt", 37},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(UserLocalId(0)): {
+		{"LocalMin", 0x0000},
+		{"LocalMax", 0x000F},
+		{"StationaryMin", 0x0010},
+		{"StationaryMax", 0x00FF},
+		{"PortableMin", 0x0100},
+		{"PortableMax", 0xFFFE},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(WarmUpExerciseName(0)): {
+		{"QuadrupedRocking", 0},
+		{"NeckTilts", 1},
+		{"AnkleCircles", 2},
+		{"AnkleDorsiflexionWithBand", 3},
+		{"AnkleInternalRotation", 4},
+		{"ArmCircles", 5},
+		{"BentOverReachToSky", 6},
+		{"CatCamel", 7},
+		{"ElbowToFootLunge", 8},
+		{"ForwardAndBackwardLegSwings", 9},
+		{"Groiners", 10},
+		{"InvertedHamstringStretch", 11},
+		{"LateralDuckUnder", 12},
+		{"NeckRotations", 13},
+		{"OppositeArmAndLegBalance", 14},
+		{"ReachRollAndLift", 15},
+		{"Scorpion", 16},
+		{"ShoulderCircles", 17},
+		{"SideToSideLegSwings", 18},
+		{"SleeperStretch", 19},
+		{"SlideOut", 20},
+		{"SwissBallHipCrossover", 21},
+		{"SwissBallReachRollAndLift", 22},
+		{"SwissBallWindshieldWipers", 23},
+		{"ThoracicRotation", 24},
+		{"WalkingHighKicks", 25},
+		{"WalkingHighKnees", 26},
+		{"WalkingKneeHugs", 27},
+		{"WalkingLegCradles", 28},
+		{"Walkout", 29},
+		{"WalkoutFromPushUpPosition", 30},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(WatchfaceMode(0)): {
+		{"Digital", 0},
+		{"Analog", 1},
+		{"ConnectIq", 2},
+		{"Disabled", 3},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(WaterType(0)): {
+		{"Fresh", 0},
+		{"Salt", 1},
+		{"En13319", 2},
+		{"Custom", 3},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(WeatherReport(0)): {
+		{"Current", 0},
+		{"Forecast", 1},
+		{"HourlyForecast", 1},
+		{"DailyForecast", 2},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(WeatherSevereType(0)): {
+		{"Unspecified", 0},
+		{"Tornado", 1},
+		{"Tsunami", 2},
+		{"Hurricane", 3},
+		{"ExtremeWind", 4},
+		{"Typhoon", 5},
+		{"InlandHurricane", 6},
+		{"HurricaneForceWind", 7},
+		{"Waterspout", 8},
+		{"SevereThunderstorm", 9},
+		{"WreckhouseWinds", 10},
+		{"LesSuetesWind", 11},
+		{"Avalanche", 12},
+		{"FlashFlood", 13},
+		{"TropicalStorm", 14},
+		{"InlandTropicalStorm", 15},
+		{"Blizzard", 16},
+		{"IceStorm", 17},
+		{"FreezingRain", 18},
+		{"DebrisFlow", 19},
+		{"FlashFreeze", 20},
+		{"DustStorm", 21},
+		{"HighWind", 22},
+		{"WinterStorm", 23},
+		{"HeavyFreezingSpray", 24},
+		{"ExtremeCold", 25},
+		{"WindChill", 26},
+		{"ColdWave", 27},
+		{"HeavySnowAlert", 28},
+		{"LakeEffectBlowingSnow", 29},
+		{"SnowSquall", 30},
+		{"LakeEffectSnow", 31},
+		{"WinterWeather", 32},
+		{"Sleet", 33},
+		{"Snowfall", 34},
+		{"SnowAndBlowingSnow", 35},
+		{"BlowingSnow", 36},
+		{"SnowAlert", 37},
+		{"ArcticOutflow", 38},
+		{"FreezingDrizzle", 39},
+		{"Storm", 40},
+		{"StormSurge", 41},
+		{"Rainfall", 42},
+		{"ArealFlood", 43},
+		{"CoastalFlood", 44},
+		{"LakeshoreFlood", 45},
+		{"ExcessiveHeat", 46},
+		{"Heat", 47},
+		{"Weather", 48},
+		{"HighHeatAndHumidity", 49},
+		{"HumidexAndHealth", 50},
+		{"Humidex", 51},
+		{"Gale", 52},
+		{"FreezingSpray", 53},
+		{"SpecialMarine", 54},
+		{"Squall", 55},
+		{"StrongWind", 56},
+		{"LakeWind", 57},
+		{"MarineWeather", 58},
+		{"Wind", 59},
+		{"SmallCraftHazardousSeas", 60},
+		{"HazardousSeas", 61},
+		{"SmallCraft", 62},
+		{"SmallCraftWinds", 63},
+		{"SmallCraftRoughBar", 64},
+		{"HighWaterLevel", 65},
+		{"Ashfall", 66},
+		{"FreezingFog", 67},
+		{"DenseFog", 68},
+		{"DenseSmoke", 69},
+		{"BlowingDust", 70},
+		{"HardFreeze", 71},
+		{"Freeze", 72},
+		{"Frost", 73},
+		{"FireWeather", 74},
+		{"Flood", 75},
+		{"RipTide", 76},
+		{"HighSurf", 77},
+		{"Smog", 78},
+		{"AirQuality", 79},
+		{"BriskWind", 80},
+		{"AirStagnation", 81},
+		{"LowWater", 82},
+		{"Hydrological", 83},
+		{"SpecialWeather", 84},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(WeatherSeverity(0)): {
+		{"Unknown", 0},
+		{"Warning", 1},
+		{"Watch", 2},
+		{"Advisory", 3},
+		{"Statement", 4},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(WeatherStatus(0)): {
+		{"Clear", 0},
+		{"PartlyCloudy", 1},
+		{"MostlyCloudy", 2},
+		{"Rain", 3},
+		{"Snow", 4},
+		{"Windy", 5},
+		{"Thunderstorms", 6},
+		{"WintryMix", 7},
+		{"Fog", 8},
+		{"Hazy", 11},
+		{"Hail", 12},
+		{"ScatteredShowers", 13},
+		{"ScatteredThunderstorms", 14},
+		{"UnknownPrecipitation", 15},
+		{"LightRain", 16},
+		{"HeavyRain", 17},
+		{"LightSnow", 18},
+		{"HeavySnow", 19},
+		{"LightRainSnow", 20},
+		{"HeavyRainSnow", 21},
+		{"Cloudy", 22},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(Weight(0)): {
+		{"Calculating", 0xFFFE},
+		{"Invalid", 0xFFFF},
+	},
+	reflect.TypeOf(WktStepDuration(0)): {
+		{"Time", 0},
+		{"Distance", 1},
+		{"HrLessThan", 2},
+		{"HrGreaterThan", 3},
+		{"Calories", 4},
+		{"Open", 5},
+		{"RepeatUntilStepsCmplt", 6},
+		{"RepeatUntilTime", 7},
+		{"RepeatUntilDistance", 8},
+		{"RepeatUntilCalories", 9},
+		{"RepeatUntilHrLessThan", 10},
+		{"RepeatUntilHrGreaterThan", 11},
+		{"RepeatUntilPowerLessThan", 12},
+		{"RepeatUntilPowerGreaterThan", 13},
+		{"PowerLessThan", 14},
+		{"PowerGreaterThan", 15},
+		{"TrainingPeaksTss", 16},
+		{"RepeatUntilPowerLastLapLessThan", 17},
+		{"RepeatUntilMaxPowerLastLapLessThan", 18},
+		{"Power3sLessThan", 19},
+		{"Power10sLessThan", 20},
+		{"Power30sLessThan", 21},
+		{"Power3sGreaterThan", 22},
+		{"Power10sGreaterThan", 23},
+		{"Power30sGreaterThan", 24},
+		{"PowerLapLessThan", 25},
+		{"PowerLapGreaterThan", 26},
+		{"RepeatUntilTrainingPeaksTss", 27},
+		{"RepetitionTime", 28},
+		{"Reps", 29},
+		{"TimeOnly", 31},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(WktStepTarget(0)): {
+		{"Speed", 0},
+		{"HeartRate", 1},
+		{"Open", 2},
+		{"Cadence", 3},
+		{"Power", 4},
+		{"Grade", 5},
+		{"Resistance", 6},
+		{"Power3s", 7},
+		{"Power10s", 8},
+		{"Power30s", 9},
+		{"PowerLap", 10},
+		{"SwimStroke", 11},
+		{"SpeedLap", 12},
+		{"HeartRateLap", 13},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(WorkoutCapabilities(0)): {
+		{"Interval", 0x00000001},
+		{"Custom", 0x00000002},
+		{"FitnessEquipment", 0x00000004},
+		{"Firstbeat", 0x00000008},
+		{"NewLeaf", 0x00000010},
+		{"Tcx", 0x00000020},
+		{"Speed", 0x00000080},
+		{"HeartRate", 0x00000100},
+		{"Distance", 0x00000200},
+		{"Cadence", 0x00000400},
+		{"Power", 0x00000800},
+		{"Grade", 0x00001000},
+		{"Resistance", 0x00002000},
+		{"Protected", 0x00004000},
+		{"Invalid", 0x00000000},
+	},
+	reflect.TypeOf(WorkoutEquipment(0)): {
+		{"None", 0},
+		{"SwimFins", 1},
+		{"SwimKickboard", 2},
+		{"SwimPaddles", 3},
+		{"SwimPullBuoy", 4},
+		{"SwimSnorkel", 5},
+		{"Invalid", 0xFF},
+	},
+	reflect.TypeOf(WorkoutHr(0)): {
+		{"BpmOffset", 100},
+		{"Invalid", 0xFFFFFFFF},
+	},
+	reflect.TypeOf(WorkoutPower(0)): {
+		{"WattsOffset", 1000},
+		{"Invalid", 0xFFFFFFFF},
+	},
+}
+
+var msgNames = [...]string{
+	MesgNumFileId:                      "file_id",
+	MesgNumFileCreator:                 "file_creator",
+	MesgNumTimestampCorrelation:        "timestamp_correlation",
+	MesgNumSoftware:                    "software",
+	MesgNumSlaveDevice:                 "slave_device",
+	MesgNumCapabilities:                "capabilities",
+	MesgNumFileCapabilities:            "file_capabilities",
+	MesgNumMesgCapabilities:            "mesg_capabilities",
+	MesgNumFieldCapabilities:           "field_capabilities",
+	MesgNumDeviceSettings:              "device_settings",
+	MesgNumUserProfile:                 "user_profile",
+	MesgNumHrmProfile:                  "hrm_profile",
+	MesgNumSdmProfile:                  "sdm_profile",
+	MesgNumBikeProfile:                 "bike_profile",
+	MesgNumConnectivity:                "connectivity",
+	MesgNumWatchfaceSettings:           "watchface_settings",
+	MesgNumOhrSettings:                 "ohr_settings",
+	MesgNumTimeInZone:                  "time_in_zone",
+	MesgNumZonesTarget:                 "zones_target",
+	MesgNumSport:                       "sport",
+	MesgNumHrZone:                      "hr_zone",
+	MesgNumSpeedZone:                   "speed_zone",
+	MesgNumCadenceZone:                 "cadence_zone",
+	MesgNumPowerZone:                   "power_zone",
+	MesgNumMetZone:                     "met_zone",
+	MesgNumDiveSettings:                "dive_settings",
+	MesgNumDiveAlarm:                   "dive_alarm",
+	MesgNumDiveApneaAlarm:              "dive_apnea_alarm",
+	MesgNumDiveGas:                     "dive_gas",
+	MesgNumGoal:                        "goal",
+	MesgNumActivity:                    "activity",
+	MesgNumSession:                     "session",
+	MesgNumLap:                         "lap",
+	MesgNumLength:                      "length",
+	MesgNumRecord:                      "record",
+	MesgNumEvent:                       "event",
+	MesgNumDeviceInfo:                  "device_info",
+	MesgNumDeviceAuxBatteryInfo:        "device_aux_battery_info",
+	MesgNumTrainingFile:                "training_file",
+	MesgNumWeatherConditions:           "weather_conditions",
+	MesgNumWeatherAlert:                "weather_alert",
+	MesgNumGpsMetadata:                 "gps_metadata",
+	MesgNumCameraEvent:                 "camera_event",
+	MesgNumGyroscopeData:               "gyroscope_data",
+	MesgNumAccelerometerData:           "accelerometer_data",
+	MesgNumMagnetometerData:            "magnetometer_data",
+	MesgNumBarometerData:               "barometer_data",
+	MesgNumThreeDSensorCalibration:     "three_d_sensor_calibration",
+	MesgNumOneDSensorCalibration:       "one_d_sensor_calibration",
+	MesgNumVideoFrame:                  "video_frame",
+	MesgNumObdiiData:                   "obdii_data",
+	MesgNumNmeaSentence:                "nmea_sentence",
+	MesgNumAviationAttitude:            "aviation_attitude",
+	MesgNumVideo:                       "video",
+	MesgNumVideoTitle:                  "video_title",
+	MesgNumVideoDescription:            "video_description",
+	MesgNumVideoClip:                   "video_clip",
+	MesgNumSet:                         "set",
+	MesgNumJump:                        "jump",
+	MesgNumSplit:                       "split",
+	MesgNumClimbPro:                    "climb_pro",
+	MesgNumFieldDescription:            "field_description",
+	MesgNumDeveloperDataId:             "developer_data_id",
+	MesgNumCourse:                      "course",
+	MesgNumCoursePoint:                 "course_point",
+	MesgNumSegmentId:                   "segment_id",
+	MesgNumSegmentLeaderboardEntry:     "segment_leaderboard_entry",
+	MesgNumSegmentPoint:                "segment_point",
+	MesgNumSegmentLap:                  "segment_lap",
+	MesgNumSegmentFile:                 "segment_file",
+	MesgNumWorkout:                     "workout",
+	MesgNumWorkoutSession:              "workout_session",
+	MesgNumWorkoutStep:                 "workout_step",
+	MesgNumExerciseTitle:               "exercise_title",
+	MesgNumSchedule:                    "schedule",
+	MesgNumTotals:                      "totals",
+	MesgNumWeightScale:                 "weight_scale",
+	MesgNumBloodPressure:               "blood_pressure",
+	MesgNumMonitoringInfo:              "monitoring_info",
+	MesgNumMonitoring:                  "monitoring",
+	MesgNumMonitoringHrData:            "monitoring_hr_data",
+	MesgNumSpo2Data:                    "spo2_data",
+	MesgNumHr:                          "hr",
+	MesgNumStressLevel:                 "stress_level",
+	MesgNumMaxMetData:                  "max_met_data",
+	MesgNumMemoGlob:                    "memo_glob",
+	MesgNumSleepLevel:                  "sleep_level",
+	MesgNumAntChannelId:                "ant_channel_id",
+	MesgNumAntRx:                       "ant_rx",
+	MesgNumAntTx:                       "ant_tx",
+	MesgNumExdScreenConfiguration:      "exd_screen_configuration",
+	MesgNumExdDataFieldConfiguration:   "exd_data_field_configuration",
+	MesgNumExdDataConceptConfiguration: "exd_data_concept_configuration",
+	MesgNumDiveSummary:                 "dive_summary",
+	MesgNumHrv:                         "hrv",
+	MesgNumBeatIntervals:               "beat_intervals",
+	MesgNumHrvStatusSummary:            "hrv_status_summary",
+	MesgNumHrvValue:                    "hrv_value",
+	MesgNumRespirationRate:             "respiration_rate",
+	MesgNumTankUpdate:                  "tank_update",
+	MesgNumTankSummary:                 "tank_summary",
+	MesgNumSleepAssessment:             "sleep_assessment",
+}
+
 var msgsTypes = [...]reflect.Type{
 	MesgNumFileId:                      reflect.TypeOf(FileIdMsg{}),
 	MesgNumFileCreator:                 reflect.TypeOf(FileCreatorMsg{}),
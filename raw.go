@@ -0,0 +1,331 @@
+package fit
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/tormoder/fit/dyncrc16"
+	"github.com/tormoder/fit/internal/types"
+)
+
+// RawDefinition represents a definition message as found in a FIT file.
+type RawDefinition struct {
+	LocalMesgNum byte
+	Arch         binary.ByteOrder
+	MesgNum      MesgNum
+	Fields       []RawFieldDef
+	DevFields    []RawDevFieldDef
+}
+
+// RawFieldDef represents a field definition in a definition message.
+type RawFieldDef struct {
+	Num      byte
+	Size     byte
+	BaseType FitBaseType
+}
+
+// RawDevFieldDef represents a developer field definition in a definition
+// message.
+type RawDevFieldDef struct {
+	Num          byte
+	Size         byte
+	DevDataIndex byte
+}
+
+// DataSize returns the size in bytes of a data message described by rd,
+// excluding the record header.
+func (rd *RawDefinition) DataSize() int {
+	var n int
+	for _, fd := range rd.Fields {
+		n += int(fd.Size)
+	}
+	for _, dfd := range rd.DevFields {
+		n += int(dfd.Size)
+	}
+	return n
+}
+
+// Validate verifies the field definitions of rd against the FIT profile, as
+// done by Decode: base types must be known, and sizes and base types must be
+// compatible with the profile. Developer fields are not verified.
+func (rd *RawDefinition) Validate() error {
+	for _, fd := range rd.Fields {
+		dfield := fieldDef{num: fd.Num, size: fd.Size, btype: types.Base(fd.BaseType)}
+		if err := validateFieldDef(rd.MesgNum, dfield); err != nil {
+			return fmt.Errorf("validating %v failed: %w", rd.MesgNum, err)
+		}
+	}
+	return nil
+}
+
+// fieldOffset returns the offset and field definition for the field with
+// the given number, relative to the start of a data message record
+// (including the record header).
+func (rd *RawDefinition) fieldOffset(num byte) (int, RawFieldDef, bool) {
+	offset := 1
+	for _, fd := range rd.Fields {
+		if fd.Num == num {
+			return offset, fd, true
+		}
+		offset += int(fd.Size)
+	}
+	return 0, RawFieldDef{}, false
+}
+
+// RawRecord represents a single definition or data message record as found
+// in a FIT file.
+type RawRecord struct {
+	// Offset is the byte offset of the record header from the start of
+	// the FIT file.
+	Offset int64
+
+	// Bytes holds the complete record, including the record header.
+	Bytes []byte
+
+	// Def is the definition message for the record. For definition
+	// records it is the definition found in the record itself, for data
+	// records it is the definition the record was read with.
+	Def *RawDefinition
+
+	timestamp uint32
+}
+
+// Header returns the record header byte.
+func (rr *RawRecord) Header() byte {
+	return rr.Bytes[0]
+}
+
+// IsDefinition reports whether rr is a definition message record.
+func (rr *RawRecord) IsDefinition() bool {
+	h := rr.Header()
+	return h&compressedHeaderMask == 0 && h&mesgDefinitionMask == mesgDefinitionMask
+}
+
+// IsCompressed reports whether rr is a data message record with a compressed
+// timestamp header.
+func (rr *RawRecord) IsCompressed() bool {
+	return rr.Header()&compressedHeaderMask == compressedHeaderMask
+}
+
+// MesgNum returns the global message number of the record.
+func (rr *RawRecord) MesgNum() MesgNum {
+	return rr.Def.MesgNum
+}
+
+// Field returns the raw bytes for the field with the given field number in a
+// data message record. The returned slice aliases rr.Bytes.
+func (rr *RawRecord) Field(num byte) ([]byte, bool) {
+	if rr.IsDefinition() {
+		return nil, false
+	}
+	offset, fd, found := rr.Def.fieldOffset(num)
+	if !found {
+		return nil, false
+	}
+	return rr.Bytes[offset : offset+int(fd.Size)], true
+}
+
+// RawReader reads the records of a FIT file one at a time without decoding
+// them into messages. A RawReader does not buffer reads from the underlying
+// reader, and never reads past the file CRC, so that chained FIT files can be
+// read by creating a new RawReader for every file.
+type RawReader struct {
+	r   io.Reader
+	crc dyncrc16.Hash16
+	h   Header
+
+	offset int64
+	n      int
+	done   bool
+
+	defs [maxLocalMesgs]*RawDefinition
+	buf  []byte
+
+	timestamp      uint32
+	lastTimeOffset int32
+}
+
+// NewRawReader returns a RawReader reading from r. The FIT file header is
+// read and verified before NewRawReader returns.
+func NewRawReader(r io.Reader) (*RawReader, error) {
+	d := decoder{r: r, crc: dyncrc16.New()}
+	if err := d.decodeHeader(); err != nil {
+		return nil, fmt.Errorf("error decoding header: %w", err)
+	}
+	return &RawReader{
+		r:      r,
+		crc:    d.crc,
+		h:      d.h,
+		offset: int64(d.h.Size),
+	}, nil
+}
+
+// Header returns the FIT file header.
+func (rr *RawReader) Header() Header {
+	return rr.h
+}
+
+// Next returns the next record in the FIT file. The returned record and its
+// bytes are only valid until the next call to Next. When all records have
+// been read, the file CRC is verified and io.EOF is returned.
+func (rr *RawReader) Next() (*RawRecord, error) {
+	if rr.done {
+		return nil, io.EOF
+	}
+	if rr.n >= int(rr.h.DataSize) {
+		rr.done = true
+		if err := rr.checkCRC(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+
+	rr.buf = rr.buf[:0]
+	if err := rr.read(1); err != nil {
+		return nil, fmt.Errorf("error parsing record header: %w", err)
+	}
+
+	rec := &RawRecord{Offset: rr.offset}
+	hdr := rr.buf[0]
+	var err error
+	switch {
+	case hdr&compressedHeaderMask == compressedHeaderMask:
+		err = rr.readData(rec, (hdr&compressedLocalMesgNumMask)>>5, true)
+	case hdr&mesgDefinitionMask == mesgDefinitionMask:
+		err = rr.readDefinition(rec, hdr)
+	default:
+		err = rr.readData(rec, hdr&localMesgNumMask, false)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rec.Bytes = rr.buf
+	rr.offset += int64(len(rr.buf))
+	return rec, nil
+}
+
+func (rr *RawReader) read(n int) error {
+	if rr.n+n > int(rr.h.DataSize) {
+		return FormatError("fit file requested data beyond data size listed in header")
+	}
+	start := len(rr.buf)
+	for cap(rr.buf) < start+n {
+		rr.buf = append(rr.buf[:cap(rr.buf)], 0)
+	}
+	rr.buf = rr.buf[:start+n]
+	if _, err := io.ReadFull(rr.r, rr.buf[start:]); err != nil {
+		return noEOF(err)
+	}
+	rr.crc.Write(rr.buf[start:])
+	rr.n += n
+	return nil
+}
+
+func (rr *RawReader) readDefinition(rec *RawRecord, hdr byte) error {
+	// Reserved, architecture, global message number and number of fields.
+	if err := rr.read(5); err != nil {
+		return fmt.Errorf("error parsing definition message: %w", err)
+	}
+
+	def := &RawDefinition{LocalMesgNum: hdr & localMesgNumMask}
+	switch rr.buf[2] {
+	case littleEndian:
+		def.Arch = le
+	case bigEndian:
+		def.Arch = be
+	default:
+		return fmt.Errorf("unknown arch: %#x", rr.buf[2])
+	}
+	def.MesgNum = MesgNum(def.Arch.Uint16(rr.buf[3:5]))
+	if def.MesgNum == MesgNumInvalid {
+		return FormatError("global message number was set invalid")
+	}
+
+	nfields := int(rr.buf[5])
+	start := len(rr.buf)
+	if err := rr.read(3 * nfields); err != nil {
+		return fmt.Errorf("error parsing fields: %w", err)
+	}
+	def.Fields = make([]RawFieldDef, nfields)
+	for i := range def.Fields {
+		b := rr.buf[start+i*3:]
+		def.Fields[i] = RawFieldDef{Num: b[0], Size: b[1], BaseType: FitBaseType(b[2])}
+	}
+
+	if hdr&devDataMask == devDataMask {
+		if err := rr.read(1); err != nil {
+			return fmt.Errorf("error reading number of developer data fields: %w", err)
+		}
+		ndev := int(rr.buf[len(rr.buf)-1])
+		start = len(rr.buf)
+		if err := rr.read(3 * ndev); err != nil {
+			return fmt.Errorf("error reading developer data field description data: %w", err)
+		}
+		def.DevFields = make([]RawDevFieldDef, ndev)
+		for i := range def.DevFields {
+			b := rr.buf[start+i*3:]
+			def.DevFields[i] = RawDevFieldDef{Num: b[0], Size: b[1], DevDataIndex: b[2]}
+		}
+	}
+
+	rr.defs[def.LocalMesgNum] = def
+	rec.Def = def
+	return nil
+}
+
+func (rr *RawReader) readData(rec *RawRecord, localMsgNum byte, compressed bool) error {
+	def := rr.defs[localMsgNum]
+	if def == nil {
+		return fmt.Errorf(
+			"missing data definition message for local message number %d",
+			localMsgNum)
+	}
+	rec.Def = def
+
+	if compressed && rr.timestamp != 0 {
+		timeOffset := int32(rr.buf[0] & compressedTimeMask)
+		rr.timestamp += uint32((timeOffset - rr.lastTimeOffset) & int32(compressedTimeMask))
+		rr.lastTimeOffset = timeOffset
+		rec.timestamp = rr.timestamp
+	}
+
+	if err := rr.read(def.DataSize()); err != nil {
+		return fmt.Errorf("error parsing data message: %w", err)
+	}
+
+	if compressed {
+		return nil
+	}
+	offset, fd, found := def.fieldOffset(fieldNumTimeStamp)
+	if !found || fd.Size != 4 {
+		return nil
+	}
+	if !knownMsgNums[def.MesgNum] {
+		return nil
+	}
+	pfield, pfound := getField(def.MesgNum, fieldNumTimeStamp)
+	if !pfound || pfield.t.Kind() != types.TimeUTC {
+		return nil
+	}
+	ts := def.Arch.Uint32(rr.buf[offset : offset+4])
+	if ts == 0xFFFFFFFF {
+		return nil
+	}
+	rr.timestamp = ts
+	rr.lastTimeOffset = int32(ts & uint32(compressedTimeMask))
+	return nil
+}
+
+func (rr *RawReader) checkCRC() error {
+	var tmp [bytesForCRC]byte
+	if _, err := io.ReadFull(rr.r, tmp[:]); err != nil {
+		return fmt.Errorf("error parsing file CRC: %w", noEOF(err))
+	}
+	rr.crc.Write(tmp[:])
+	if rr.crc.Sum16() != 0x0000 {
+		return IntegrityError("file checksum failed")
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package fit_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/tormoder/fit"
+)
+
+func TestRawReader(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join(tdfolder, "fitsdk", "Activity.fit"))
+	if err != nil {
+		t.Fatalf("reading file failed: %v", err)
+	}
+
+	rr, err := fit.NewRawReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("NewRawReader: got error, want none; error is: %v", err)
+	}
+
+	var defs, records int
+	offset := int64(rr.Header().Size)
+	for {
+		rec, err := rr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Next: got error, want none; error is: %v", err)
+		}
+		if rec.Offset != offset {
+			t.Fatalf("record offset: got %d, want %d", rec.Offset, offset)
+		}
+		if !bytes.Equal(rec.Bytes, data[offset:offset+int64(len(rec.Bytes))]) {
+			t.Fatalf("record at offset %d: bytes differ from file", offset)
+		}
+		offset += int64(len(rec.Bytes))
+		if rec.IsDefinition() {
+			defs++
+			continue
+		}
+		if rec.MesgNum() == fit.MesgNumRecord {
+			records++
+		}
+	}
+
+	if defs == 0 {
+		t.Errorf("got no definition messages")
+	}
+	if wantOffset := int64(len(data)) - 2; offset != wantOffset {
+		t.Errorf("end offset: got %d, want %d", offset, wantOffset)
+	}
+
+	file, err := fit.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("decode: got error, want none; error is: %v", err)
+	}
+	activity, err := file.Activity()
+	if err != nil {
+		t.Fatalf("activity: got error, want none; error is: %v", err)
+	}
+	if records != len(activity.Records) {
+		t.Errorf("record messages: got %d, want %d", records, len(activity.Records))
+	}
+}
+
+func TestRawDefinitionValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields []fit.RawFieldDef
+		valid  bool
+	}{
+		{"valid", []fit.RawFieldDef{{Num: 253, Size: 4, BaseType: fit.FitBaseTypeUint32}, {Num: 3, Size: 1, BaseType: fit.FitBaseTypeUint8}}, true},
+		{"unknown field", []fit.RawFieldDef{{Num: 200, Size: 8, BaseType: fit.FitBaseTypeUint16}}, true},
+		{"unknown base type", []fit.RawFieldDef{{Num: 3, Size: 1, BaseType: fit.FitBaseType(0x1F)}}, false},
+		{"size greater than profile", []fit.RawFieldDef{{Num: 3, Size: 2, BaseType: fit.FitBaseTypeUint16}}, false},
+		{"size less than base type", []fit.RawFieldDef{{Num: 253, Size: 2, BaseType: fit.FitBaseTypeUint32}}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			def := &fit.RawDefinition{MesgNum: fit.MesgNumRecord, Fields: test.fields}
+			err := def.Validate()
+			if test.valid && err != nil {
+				t.Errorf("got error, want none; error is: %v", err)
+			}
+			if !test.valid && err == nil {
+				t.Errorf("got no error, want one")
+			}
+		})
+	}
+}
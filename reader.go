@@ -454,7 +454,7 @@ func (d *decoder) parseDefinitionMessage(recordHeader byte) (*defmsg, error) {
 		fd.num = d.tmp[i*3]
 		fd.size = d.tmp[(i*3)+1]
 		fd.btype = types.Base(d.tmp[(i*3)+2])
-		if err = d.validateFieldDef(dm.globalMsgNum, fd); err != nil {
+		if err = validateFieldDef(dm.globalMsgNum, fd); err != nil {
 			if d.debug {
 				d.opts.logger.Println("illegal definition message:", dm)
 			}
@@ -489,7 +489,7 @@ func (d *decoder) parseDefinitionMessage(recordHeader byte) (*defmsg, error) {
 	return &dm, nil
 }
 
-func (d *decoder) validateFieldDef(gmsgnum MesgNum, dfield fieldDef) error {
+func validateFieldDef(gmsgnum MesgNum, dfield fieldDef) error {
 	if !dfield.btype.Known() {
 		return fmt.Errorf("field %d: unknown base type: %v", dfield.num, dfield.btype)
 	}
@@ -590,6 +590,9 @@ func (d *decoder) parseDataMessage(recordHeader byte, compressed bool) (reflect.
 
 	var msgv reflect.Value
 	knownMsg := knownMsgNums[dm.globalMsgNum]
+	if knownMsg && d.opts.messages != nil && !d.opts.messages[dm.globalMsgNum] {
+		return msgv, d.skipDataMessage(dm, recordHeader, compressed)
+	}
 	if knownMsg {
 		msgv = getMesgAllInvalid(dm.globalMsgNum)
 	} else if d.opts.unknownMessages {
@@ -612,9 +615,7 @@ func (d *decoder) parseDataMessage(recordHeader byte, compressed bool) (reflect.
 		return d.parseDataFields(dm, knownMsg, msgv)
 	}
 
-	timeOffset := int32(recordHeader & compressedTimeMask)
-	d.timestamp += uint32((timeOffset - d.lastTimeOffset) & int32(compressedTimeMask))
-	d.lastTimeOffset = timeOffset
+	d.applyTimeOffset(recordHeader)
 
 	fieldTimestamp, found := getField(dm.globalMsgNum, fieldNumTimeStamp)
 	if found {
@@ -633,6 +634,62 @@ func (d *decoder) parseDataMessage(recordHeader byte, compressed bool) (reflect.
 	return d.parseDataFields(dm, knownMsg, msgv)
 }
 
+// applyTimeOffset advances the reference timestamp by the time offset of a
+// compressed timestamp header.
+func (d *decoder) applyTimeOffset(recordHeader byte) {
+	timeOffset := int32(recordHeader & compressedTimeMask)
+	d.timestamp += uint32((timeOffset - d.lastTimeOffset) & int32(compressedTimeMask))
+	d.lastTimeOffset = timeOffset
+}
+
+// skipDataMessage reads the fields of a data message that is not to be
+// decoded. Only the timestamp is used, as reference for compressed
+// timestamps, and it is tracked exactly as by parseDataMessage.
+func (d *decoder) skipDataMessage(dm *defmsg, recordHeader byte, compressed bool) error {
+	if compressed && d.timestamp != 0 {
+		// As for decoded messages, the offset is only applied when
+		// there is a reference timestamp.
+		d.applyTimeOffset(recordHeader)
+	}
+
+	for i, dfield := range dm.fieldDefs {
+		dsize := int(dfield.size)
+		err := d.readFull(d.tmp[0:dsize])
+		if err != nil {
+			return fmt.Errorf(
+				"error parsing data message: %w (field %d [%v] for [%v])",
+				err, i, dfield, dm)
+		}
+		if dfield.num != fieldNumTimeStamp {
+			continue
+		}
+		pfield, pfound := getField(dm.globalMsgNum, dfield.num)
+		if !pfound || pfield.t.Kind() != types.TimeUTC {
+			continue
+		}
+		// Pad smaller fields as parseDataFields does.
+		var b [4]byte
+		if dm.arch == le {
+			copy(b[:], d.tmp[:dsize])
+		} else {
+			copy(b[len(b)-dsize:], d.tmp[:dsize])
+		}
+		if u32 := dm.arch.Uint32(b[:]); u32 != 0xFFFFFFFF {
+			d.timestamp = u32
+			d.lastTimeOffset = int32(d.timestamp & uint32(compressedTimeMask))
+		}
+	}
+
+	for i, ddfd := range dm.devDataFieldDescs {
+		err := d.readFull(d.tmp[0:int(ddfd.size)])
+		if err != nil {
+			return fmt.Errorf("error parsing data developer message: %w (field %d [%v] for [%v])", err, i, ddfd, dm)
+		}
+	}
+
+	return nil
+}
+
 func (d *decoder) parseDataFields(dm *defmsg, knownMsg bool, msgv reflect.Value) (reflect.Value, error) {
 	for i, dfield := range dm.fieldDefs {
 		dsize := int(dfield.size)
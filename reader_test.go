@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
 	"runtime"
 	"strings"
 	"sync"
@@ -284,6 +285,134 @@ func TestDecodeHeaderAndFileID(t *testing.T) {
 	}
 }
 
+func TestDecodeWithMessages(t *testing.T) {
+	tests := []struct {
+		desc, path string
+		mesgNums   []fit.MesgNum
+		// get returns the messages decoded, and messages that should
+		// have been skipped.
+		get func(t *testing.T, f *fit.File) (decoded, skipped interface{})
+	}{
+		{
+			"ActivitySmall", activitySmallPath,
+			[]fit.MesgNum{fit.MesgNumSession, fit.MesgNumLap},
+			func(t *testing.T, f *fit.File) (interface{}, interface{}) {
+				a, err := f.Activity()
+				if err != nil {
+					t.Fatalf("got error, want none; error is: %v", err)
+				}
+				return []interface{}{a.Sessions, a.Laps}, a.Records
+			},
+		},
+		{
+			"ActivityLarge", activityLargePath,
+			[]fit.MesgNum{fit.MesgNumSession},
+			func(t *testing.T, f *fit.File) (interface{}, interface{}) {
+				a, err := f.Activity()
+				if err != nil {
+					t.Fatalf("got error, want none; error is: %v", err)
+				}
+				return a.Sessions, a.Events
+			},
+		},
+		{
+			// Compressed timestamps of records must be correct with the
+			// messages giving the reference timestamp skipped.
+			"CompressedTimestamps", filepath.Join(tdfolder, "python-fitparse", "antfs-dump.63.fit"),
+			[]fit.MesgNum{fit.MesgNumRecord},
+			func(t *testing.T, f *fit.File) (interface{}, interface{}) {
+				a, err := f.Activity()
+				if err != nil {
+					t.Fatalf("got error, want none; error is: %v", err)
+				}
+				return a.Records, a.Events
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			data, err := os.ReadFile(test.path)
+			if err != nil {
+				t.Fatalf("%q: error reading file: %v", test.path, err)
+			}
+			full, err := fit.Decode(bytes.NewReader(data))
+			if err != nil {
+				t.Fatalf("got error, want none; error is: %v", err)
+			}
+			partial, err := fit.Decode(bytes.NewReader(data), fit.WithMessages(test.mesgNums...))
+			if err != nil {
+				t.Fatalf("got error, want none; error is: %v", err)
+			}
+			if partial.FileId != full.FileId {
+				t.Errorf("file id: got %v, want %v", partial.FileId, full.FileId)
+			}
+			want, _ := test.get(t, full)
+			got, skipped := test.get(t, partial)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("decoded messages differ from full decode")
+			}
+			if v := reflect.ValueOf(skipped); v.Len() != 0 {
+				t.Errorf("got %d messages of skipped type, want none", v.Len())
+			}
+		})
+	}
+}
+
+func TestDecodeWithMessagesAllFiles(t *testing.T) {
+	paths, err := filepath.Glob(filepath.Join(tdfolder, "*", "*.fit"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mesgNumSets := [][]fit.MesgNum{
+		{fit.MesgNumSession},
+		{fit.MesgNumRecord},
+		{fit.MesgNumLap, fit.MesgNumEvent, fit.MesgNumMonitoring},
+	}
+	for _, path := range paths {
+		path := path
+		t.Run(path, func(t *testing.T) {
+			if filepath.Base(path) == "compressed-speed-distance.fit" {
+				// Accumulated component values continue from the
+				// previous decode, as accumulators are package level.
+				t.Skip("accumulated distance depends on earlier decodes")
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("reading file failed: %v", err)
+			}
+			full, err := fit.Decode(bytes.NewReader(data))
+			if err != nil {
+				t.Skipf("full decode failed: %v", err)
+			}
+			for _, mesgNums := range mesgNumSets {
+				partial, err := fit.Decode(bytes.NewReader(data), fit.WithMessages(mesgNums...))
+				if err != nil {
+					t.Fatalf("%v: got error, want none; error is: %v", mesgNums, err)
+				}
+				var want []interface{}
+				for _, msg := range full.Messages() {
+					mn, _ := fit.MesgNumOf(msg)
+					for _, n := range append(mesgNums, fit.MesgNumFileId) {
+						if mn == n {
+							want = append(want, msg)
+						}
+					}
+				}
+				got := partial.Messages()
+				if len(got) != len(want) {
+					t.Fatalf("%v: got %d messages, want %d", mesgNums, len(got), len(want))
+				}
+				for i := range got {
+					if !reflect.DeepEqual(got[i], want[i]) {
+						t.Fatalf("%v: message %d:\ngot:  %+v\nwant: %+v", mesgNums, i, got[i], want[i])
+					}
+				}
+			}
+		})
+	}
+}
+
 func BenchmarkDecode(b *testing.B) {
 	files := []struct {
 		desc, path string
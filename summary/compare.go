@@ -0,0 +1,86 @@
+package summary
+
+import (
+	"math"
+	"reflect"
+
+	"github.com/tormoder/fit"
+)
+
+// Difference is a summary value that differs between the device and the
+// computed summary. Invalid values are NaN.
+type Difference struct {
+	// Field is the profile name of the field, e.g. "total_distance".
+	Field    string
+	Units    string
+	Device   float64
+	Computed float64
+}
+
+// comparedFields are the summary fields compared by CompareLap and
+// CompareSession.
+var comparedFields = map[string]bool{
+	"total_elapsed_time": true,
+	"total_timer_time":   true,
+	"total_distance":     true,
+	"total_ascent":       true,
+	"total_descent":      true,
+	"avg_speed":          true,
+	"max_speed":          true,
+	"enhanced_avg_speed": true,
+	"enhanced_max_speed": true,
+	"avg_heart_rate":     true,
+	"max_heart_rate":     true,
+	"avg_cadence":        true,
+	"max_cadence":        true,
+	"avg_power":          true,
+	"max_power":          true,
+	"total_calories":     true,
+}
+
+// CompareLap compares a lap reported by the device with a computed lap, and
+// returns the summary values that differ by more than the given relative
+// tolerance, e.g. 0.01 for 1%. Values that could not be computed, such as
+// calories for records without calories, are not compared. Values that are
+// invalid in the device lap only are always reported.
+func CompareLap(device, computed *fit.LapMsg, tolerance float64) []Difference {
+	return compare(fit.MesgNumLap, reflect.ValueOf(device).Elem(), reflect.ValueOf(computed).Elem(), tolerance)
+}
+
+// CompareSession compares a session reported by the device with a computed
+// session, like CompareLap.
+func CompareSession(device, computed *fit.SessionMsg, tolerance float64) []Difference {
+	return compare(fit.MesgNumSession, reflect.ValueOf(device).Elem(), reflect.ValueOf(computed).Elem(), tolerance)
+}
+
+func compare(mn fit.MesgNum, device, computed reflect.Value, tolerance float64) []Difference {
+	var diffs []Difference
+	for _, pf := range fit.ProfileFields(mn) {
+		if !comparedFields[pf.Name] {
+			continue
+		}
+		d := scaled(pf, device.Field(pf.Index))
+		c := scaled(pf, computed.Field(pf.Index))
+		if math.IsNaN(c) {
+			continue
+		}
+		if math.IsNaN(d) || math.Abs(d-c) > tolerance*math.Abs(d) {
+			diffs = append(diffs, Difference{
+				Field:    pf.Name,
+				Units:    pf.Units,
+				Device:   d,
+				Computed: c,
+			})
+		}
+	}
+	return diffs
+}
+
+// scaled returns the scaled value of the unsigned integer field v, or NaN if
+// it is invalid.
+func scaled(pf fit.ProfileField, v reflect.Value) float64 {
+	if pf.IsInvalid(v) {
+		return math.NaN()
+	}
+	return float64(v.Uint())/pf.Scale - pf.Offset
+}
@@ -0,0 +1,111 @@
+package summary
+
+import (
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/internal/activity"
+)
+
+// Lap returns a new lap message with the summary values set. Values that
+// could not be computed are left invalid.
+func (s *Summary) Lap() *fit.LapMsg {
+	l := fit.NewLapMsg()
+	l.StartTime = s.StartTime
+	l.Timestamp = s.EndTime
+	l.TotalElapsedTime = milliseconds(s.ElapsedTime)
+	l.TotalTimerTime = milliseconds(s.TimerTime)
+	l.TotalDistance = uint32(activity.Raw(s.Distance, 100, 0, 0xFFFFFFFF))
+	l.TotalAscent = uint16(activity.Raw(s.Ascent, 1, 0, 0xFFFF))
+	l.TotalDescent = uint16(activity.Raw(s.Descent, 1, 0, 0xFFFF))
+	l.EnhancedAvgSpeed = uint32(activity.Raw(s.AvgSpeed, 1000, 0, 0xFFFFFFFF))
+	l.EnhancedMaxSpeed = uint32(activity.Raw(s.MaxSpeed, 1000, 0, 0xFFFFFFFF))
+	l.AvgSpeed = uint16(activity.Raw(s.AvgSpeed, 1000, 0, 0xFFFF))
+	l.MaxSpeed = uint16(activity.Raw(s.MaxSpeed, 1000, 0, 0xFFFF))
+	l.AvgHeartRate = uint8(activity.Raw(s.AvgHeartRate, 1, 0, 0xFF))
+	l.MaxHeartRate = uint8(activity.Raw(s.MaxHeartRate, 1, 0, 0xFF))
+	l.AvgCadence = uint8(activity.Raw(s.AvgCadence, 1, 0, 0xFF))
+	l.MaxCadence = uint8(activity.Raw(s.MaxCadence, 1, 0, 0xFF))
+	l.AvgPower = uint16(activity.Raw(s.AvgPower, 1, 0, 0xFFFF))
+	l.MaxPower = uint16(activity.Raw(s.MaxPower, 1, 0, 0xFFFF))
+	l.TotalCalories = uint16(activity.Raw(s.Calories, 1, 0, 0xFFFF))
+	l.StartPositionLat, l.StartPositionLong = s.StartPositionLat, s.StartPositionLong
+	l.EndPositionLat, l.EndPositionLong = s.EndPositionLat, s.EndPositionLong
+	return l
+}
+
+// Session returns a new session message with the summary values set. Values
+// that could not be computed are left invalid.
+func (s *Summary) Session() *fit.SessionMsg {
+	l := s.Lap()
+	ss := fit.NewSessionMsg()
+	ss.StartTime = l.StartTime
+	ss.Timestamp = l.Timestamp
+	ss.TotalElapsedTime = l.TotalElapsedTime
+	ss.TotalTimerTime = l.TotalTimerTime
+	ss.TotalDistance = l.TotalDistance
+	ss.TotalAscent = l.TotalAscent
+	ss.TotalDescent = l.TotalDescent
+	ss.EnhancedAvgSpeed = l.EnhancedAvgSpeed
+	ss.EnhancedMaxSpeed = l.EnhancedMaxSpeed
+	ss.AvgSpeed = l.AvgSpeed
+	ss.MaxSpeed = l.MaxSpeed
+	ss.AvgHeartRate = l.AvgHeartRate
+	ss.MaxHeartRate = l.MaxHeartRate
+	ss.AvgCadence = l.AvgCadence
+	ss.MaxCadence = l.MaxCadence
+	ss.AvgPower = l.AvgPower
+	ss.MaxPower = l.MaxPower
+	ss.TotalCalories = l.TotalCalories
+	ss.StartPositionLat, ss.StartPositionLong = l.StartPositionLat, l.StartPositionLong
+	ss.EndPositionLat, ss.EndPositionLong = l.EndPositionLat, l.EndPositionLong
+	return ss
+}
+
+// Laps recomputes the laps of an activity. A new lap is returned for every
+// lap of the activity, covering the same period and with the same message
+// index, event, trigger, sport and intensity.
+func Laps(a *fit.ActivityFile) []*fit.LapMsg {
+	laps := make([]*fit.LapMsg, len(a.Laps))
+	for i, dl := range a.Laps {
+		end := activity.LapEnd(dl)
+		l := Compute(a.Records, a.Events, dl.StartTime, end).Lap()
+		l.MessageIndex = dl.MessageIndex
+		l.Event = dl.Event
+		l.EventType = dl.EventType
+		l.LapTrigger = dl.LapTrigger
+		l.Sport = dl.Sport
+		l.SubSport = dl.SubSport
+		l.Intensity = dl.Intensity
+		laps[i] = l
+	}
+	return laps
+}
+
+// Sessions recomputes the sessions of an activity. A new session is returned
+// for every session of the activity, covering the same period and with the
+// same message index, event, trigger, sport and laps.
+func Sessions(a *fit.ActivityFile) []*fit.SessionMsg {
+	sessions := make([]*fit.SessionMsg, len(a.Sessions))
+	for i, ds := range a.Sessions {
+		end := activity.SessionEnd(ds)
+		s := Compute(a.Records, a.Events, ds.StartTime, end).Session()
+		s.MessageIndex = ds.MessageIndex
+		s.Event = ds.Event
+		s.EventType = ds.EventType
+		s.Trigger = ds.Trigger
+		s.Sport = ds.Sport
+		s.SubSport = ds.SubSport
+		s.FirstLapIndex = ds.FirstLapIndex
+		s.NumLaps = ds.NumLaps
+		sessions[i] = s
+	}
+	return sessions
+}
+
+func milliseconds(d time.Duration) uint32 {
+	if d < 0 {
+		return 0xFFFFFFFF
+	}
+	return uint32(activity.Raw(d.Seconds(), 1000, 0, 0xFFFFFFFF))
+}
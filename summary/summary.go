@@ -0,0 +1,226 @@
+// Package summary computes lap and session summaries from the records and
+// timer events of an activity, and compares them with the summaries reported
+// by the device.
+//
+// This is useful for files where the records have been changed, e.g. by
+// trimming, merging or repairing, leaving the device summaries stale.
+package summary
+
+import (
+	"math"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/internal/activity"
+)
+
+// ascentThreshold is the altitude change in meters needed to count as ascent
+// or descent. It filters out noise in the recorded altitude.
+const ascentThreshold = 2.0
+
+// Summary holds values computed from the records in a time period. Values
+// that can not be computed, e.g. heart rate for records without heart rate,
+// are NaN.
+type Summary struct {
+	StartTime   time.Time
+	EndTime     time.Time
+	ElapsedTime time.Duration
+	TimerTime   time.Duration
+
+	// Distance, Ascent and Descent in meters.
+	Distance float64
+	Ascent   float64
+	Descent  float64
+
+	// Speeds in m/s. The average speed is the distance divided by the timer
+	// time.
+	AvgSpeed float64
+	MaxSpeed float64
+
+	// Heart rate in bpm, cadence in rpm and power in watts. Averages are
+	// computed over the records while the timer was running, including
+	// zero values.
+	AvgHeartRate float64
+	MaxHeartRate float64
+	AvgCadence   float64
+	MaxCadence   float64
+	AvgPower     float64
+	MaxPower     float64
+
+	// Calories in kcal, if recorded.
+	Calories float64
+
+	StartPositionLat  fit.Latitude
+	StartPositionLong fit.Longitude
+	EndPositionLat    fit.Latitude
+	EndPositionLong   fit.Longitude
+}
+
+// Compute returns the summary of the records from start to end, inclusive.
+// Events are used to determine when the timer was running; records while
+// the timer was stopped are not included in averages and maximums. If the
+// timer was not running during the period according to the events, or there
+// are no timer events, the timer is assumed to be running for all of it. Records
+// must be sorted by time, and may include records outside of the period, in
+// which case the accumulated distance and calories at start are taken from
+// the last record before it.
+func Compute(recs []*fit.RecordMsg, events []*fit.EventMsg, start, end time.Time) *Summary {
+	tm := activity.TimerPeriods(events).Within(start, end)
+	s := &Summary{
+		StartTime:         start,
+		EndTime:           end,
+		ElapsedTime:       end.Sub(start),
+		TimerTime:         tm.Duration(start, end),
+		StartPositionLat:  fit.NewLatitudeInvalid(),
+		StartPositionLong: fit.NewLongitudeInvalid(),
+		EndPositionLat:    fit.NewLatitudeInvalid(),
+		EndPositionLong:   fit.NewLongitudeInvalid(),
+	}
+
+	var (
+		hr, cad, power       stat
+		speed                stat
+		dist, cal            accumulated
+		alt, ascent, descent = math.NaN(), 0.0, 0.0
+		hasAltitude          bool
+	)
+	for _, r := range recs {
+		if r.Timestamp.After(end) {
+			break
+		}
+		d := r.GetDistanceScaled()
+		c := math.NaN()
+		if r.Calories != 0xFFFF {
+			c = float64(r.Calories)
+		}
+		if r.Timestamp.Before(start) {
+			dist.base(d)
+			cal.base(c)
+			continue
+		}
+		dist.add(d)
+		cal.add(c)
+
+		if !r.PositionLat.Invalid() && !r.PositionLong.Invalid() {
+			if s.StartPositionLat.Invalid() {
+				s.StartPositionLat, s.StartPositionLong = r.PositionLat, r.PositionLong
+			}
+			s.EndPositionLat, s.EndPositionLong = r.PositionLat, r.PositionLong
+		}
+
+		if !tm.Running(r.Timestamp) {
+			continue
+		}
+		if r.HeartRate != 0xFF {
+			hr.add(float64(r.HeartRate))
+		}
+		if r.Cadence != 0xFF {
+			cad.add(float64(r.Cadence))
+		}
+		if r.Power != 0xFFFF {
+			power.add(float64(r.Power))
+		}
+		speed.add(firstValid(r.GetEnhancedSpeedScaled(), r.GetSpeedScaled()))
+
+		a := firstValid(r.GetEnhancedAltitudeScaled(), r.GetAltitudeScaled())
+		switch {
+		case math.IsNaN(a):
+		case math.IsNaN(alt):
+			alt, hasAltitude = a, true
+		case a-alt >= ascentThreshold:
+			ascent += a - alt
+			alt = a
+		case alt-a >= ascentThreshold:
+			descent += alt - a
+			alt = a
+		}
+	}
+
+	s.Distance = dist.total()
+	s.Calories = cal.total()
+	s.Ascent, s.Descent = math.NaN(), math.NaN()
+	if hasAltitude {
+		s.Ascent, s.Descent = ascent, descent
+	}
+	s.AvgSpeed = math.NaN()
+	if secs := s.TimerTime.Seconds(); secs > 0 && !math.IsNaN(s.Distance) {
+		s.AvgSpeed = s.Distance / secs
+	}
+	s.MaxSpeed = speed.max()
+	s.AvgHeartRate, s.MaxHeartRate = hr.avg(), hr.max()
+	s.AvgCadence, s.MaxCadence = cad.avg(), cad.max()
+	s.AvgPower, s.MaxPower = power.avg(), power.max()
+	return s
+}
+
+// stat accumulates the average and maximum of a series of values.
+type stat struct {
+	sum, hi float64
+	n       int
+}
+
+func (st *stat) add(v float64) {
+	if math.IsNaN(v) {
+		return
+	}
+	if st.n == 0 || v > st.hi {
+		st.hi = v
+	}
+	st.sum += v
+	st.n++
+}
+
+func (st *stat) avg() float64 {
+	if st.n == 0 {
+		return math.NaN()
+	}
+	return st.sum / float64(st.n)
+}
+
+func (st *stat) max() float64 {
+	if st.n == 0 {
+		return math.NaN()
+	}
+	return st.hi
+}
+
+// accumulated computes the total of an accumulated value, such as distance,
+// over a period.
+type accumulated struct {
+	start, last float64
+	valid       bool
+	hasStart    bool
+}
+
+// base sets the value at the start of the period, from a record before it.
+func (a *accumulated) base(v float64) {
+	if !math.IsNaN(v) {
+		a.start, a.hasStart = v, true
+	}
+}
+
+func (a *accumulated) add(v float64) {
+	if math.IsNaN(v) {
+		return
+	}
+	if !a.hasStart {
+		a.start, a.hasStart = v, true
+	}
+	a.last, a.valid = v, true
+}
+
+func (a *accumulated) total() float64 {
+	if !a.valid {
+		return math.NaN()
+	}
+	return a.last - a.start
+}
+
+func firstValid(vs ...float64) float64 {
+	for _, v := range vs {
+		if !math.IsNaN(v) {
+			return v
+		}
+	}
+	return math.NaN()
+}
@@ -0,0 +1,173 @@
+package summary_test
+
+import (
+	"bytes"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/summary"
+)
+
+func TestRecomputeDeviceSummaries(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "testdata", "dcrainmaker", "Edge810-Vector-2013-08-16-15-35-10.fit"))
+	if err != nil {
+		t.Fatalf("reading file failed: %v", err)
+	}
+	file, err := fit.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("decode: got error, want none; error is: %v", err)
+	}
+	activity, err := file.Activity()
+	if err != nil {
+		t.Fatalf("activity: got error, want none; error is: %v", err)
+	}
+
+	// Ascent and descent depend on how the device filters the altitude,
+	// and are compared with a larger tolerance.
+	const tolerance, ascentTolerance = 0.02, 0.4
+
+	check := func(t *testing.T, diffs []summary.Difference) {
+		t.Helper()
+		for _, d := range diffs {
+			if d.Field == "total_ascent" || d.Field == "total_descent" {
+				if math.Abs(d.Device-d.Computed) <= ascentTolerance*d.Device+3 {
+					continue
+				}
+			}
+			t.Errorf("%s: device %v %s, computed %v %s", d.Field, d.Device, d.Units, d.Computed, d.Units)
+		}
+	}
+
+	laps := summary.Laps(activity)
+	if len(laps) != len(activity.Laps) {
+		t.Fatalf("got %d laps, want %d", len(laps), len(activity.Laps))
+	}
+	for i, l := range laps {
+		if l.MessageIndex != activity.Laps[i].MessageIndex || !l.StartTime.Equal(activity.Laps[i].StartTime) {
+			t.Errorf("lap %d: got index %d starting %v, want %d starting %v", i,
+				l.MessageIndex, l.StartTime, activity.Laps[i].MessageIndex, activity.Laps[i].StartTime)
+		}
+		check(t, summary.CompareLap(activity.Laps[i], l, tolerance))
+	}
+
+	sessions := summary.Sessions(activity)
+	if len(sessions) != 1 {
+		t.Fatalf("got %d sessions, want 1", len(sessions))
+	}
+	check(t, summary.CompareSession(activity.Sessions[0], sessions[0], tolerance))
+	if got, want := sessions[0].MaxPower, activity.Sessions[0].MaxPower; got != want {
+		t.Errorf("max power: got %d, want %d", got, want)
+	}
+}
+
+func TestCompute(t *testing.T) {
+	start := time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)
+	at := func(secs int) time.Time { return start.Add(time.Duration(secs) * time.Second) }
+
+	var events []*fit.EventMsg
+	for _, ev := range []struct {
+		secs int
+		typ  fit.EventType
+	}{{0, fit.EventTypeStart}, {10, fit.EventTypeStop}, {20, fit.EventTypeStart}, {30, fit.EventTypeStopAll}} {
+		e := fit.NewEventMsg()
+		e.Timestamp = at(ev.secs)
+		e.Event = fit.EventTimer
+		e.EventType = ev.typ
+		events = append(events, e)
+	}
+
+	// A record every second with 5 m/s, 100 bpm and 200 W while the timer
+	// is running, and 60 bpm and no power while it is stopped.
+	var recs []*fit.RecordMsg
+	dist := 1000.0
+	for i := -5; i <= 35; i++ {
+		r := fit.NewRecordMsg()
+		r.Timestamp = at(i)
+		running := i >= 0 && i <= 10 || i >= 20
+		r.HeartRate = 60
+		if running {
+			if i > 0 && i != 20 {
+				dist += 5
+			}
+			r.HeartRate = 100
+			r.Power = 200
+			r.Speed = 5000
+		}
+		r.Distance = uint32(dist * 100)
+		r.Altitude = uint16((100 + float64(i) + 500) * 5)
+		recs = append(recs, r)
+	}
+	recs[20].HeartRate = 180 // Stopped, not included in the maximum.
+
+	s := summary.Compute(recs, events, at(0), at(30))
+	if s.ElapsedTime != 30*time.Second || s.TimerTime != 20*time.Second {
+		t.Errorf("got elapsed time %v and timer time %v, want 30s and 20s", s.ElapsedTime, s.TimerTime)
+	}
+	if s.Distance != 20*5 {
+		t.Errorf("distance: got %v, want 100", s.Distance)
+	}
+	if s.AvgSpeed != 5 || s.MaxSpeed != 5 {
+		t.Errorf("speed: got avg %v and max %v, want 5", s.AvgSpeed, s.MaxSpeed)
+	}
+	if s.AvgHeartRate != 100 || s.MaxHeartRate != 100 {
+		t.Errorf("heart rate: got avg %v and max %v, want 100", s.AvgHeartRate, s.MaxHeartRate)
+	}
+	if s.AvgPower != 200 || s.MaxPower != 200 {
+		t.Errorf("power: got avg %v and max %v, want 200", s.AvgPower, s.MaxPower)
+	}
+	if !math.IsNaN(s.AvgCadence) || !math.IsNaN(s.Calories) {
+		t.Errorf("got cadence %v and calories %v, want NaN", s.AvgCadence, s.Calories)
+	}
+	if s.Ascent != 30 || s.Descent != 0 {
+		t.Errorf("got ascent %v and descent %v, want 30 and 0", s.Ascent, s.Descent)
+	}
+
+	l := s.Lap()
+	if l.TotalTimerTime != 20000 || l.TotalDistance != 10000 || l.AvgHeartRate != 100 || l.AvgCadence != 0xFF {
+		t.Errorf("lap: got timer time %d, distance %d, heart rate %d and cadence %d",
+			l.TotalTimerTime, l.TotalDistance, l.AvgHeartRate, l.AvgCadence)
+	}
+
+	device := s.Session()
+	device.TotalDistance = 10500
+	device.AvgPower = 0xFFFF
+	diffs := summary.CompareSession(device, s.Session(), 0.01)
+	if len(diffs) != 2 || diffs[0].Field != "total_distance" || diffs[1].Field != "avg_power" {
+		t.Fatalf("got differences %v, want total_distance and avg_power", diffs)
+	}
+	if diffs[0].Device != 105 || diffs[0].Computed != 100 || !math.IsNaN(diffs[1].Device) {
+		t.Errorf("got differences %v", diffs)
+	}
+}
+
+func TestTimerPeriods(t *testing.T) {
+	start := time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)
+	newEvent := func(secs int, event fit.Event, typ fit.EventType) *fit.EventMsg {
+		e := fit.NewEventMsg()
+		e.Timestamp = start.Add(time.Duration(secs) * time.Second)
+		e.Event = event
+		e.EventType = typ
+		return e
+	}
+	events := []*fit.EventMsg{
+		newEvent(30, fit.EventTimer, fit.EventTypeStart),
+		newEvent(0, fit.EventTimer, fit.EventTypeStart),
+		newEvent(5, fit.EventLap, fit.EventTypeStop),
+		newEvent(10, fit.EventTimer, fit.EventTypeStop),
+		newEvent(12, fit.EventTimer, fit.EventTypeStopAll),
+	}
+	periods := summary.TimerPeriods(events)
+	if len(periods) != 2 {
+		t.Fatalf("got %d periods, want 2", len(periods))
+	}
+	if !periods[0].End.Equal(start.Add(10*time.Second)) || !periods[1].End.IsZero() {
+		t.Errorf("got periods %v", periods)
+	}
+	if !periods[1].Contains(start.Add(time.Hour)) || periods[0].Contains(start.Add(11*time.Second)) {
+		t.Errorf("Contains: got unexpected result for %v", periods)
+	}
+}
@@ -0,0 +1,30 @@
+package summary
+
+import (
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/internal/activity"
+)
+
+// Period is a time period during which the timer was running. A zero End
+// means that the timer was never stopped.
+type Period struct {
+	Start, End time.Time
+}
+
+// Contains reports whether t is within the period, inclusive.
+func (p Period) Contains(t time.Time) bool {
+	return activity.Period(p).Contains(t)
+}
+
+// TimerPeriods returns the periods during which the timer was running, as
+// given by the timer start and stop events. Events of other types are
+// ignored. The periods are sorted by start time.
+func TimerPeriods(events []*fit.EventMsg) []Period {
+	var periods []Period
+	for _, p := range activity.TimerPeriods(events) {
+		periods = append(periods, Period(p))
+	}
+	return periods
+}
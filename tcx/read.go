@@ -0,0 +1,292 @@
+package tcx
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+// ReadTCX reads a TCX document from r and returns it as a FIT activity file
+// that can be written using fit.Encode.
+//
+// Every TCX Activity becomes a session, with timer start and stop events
+// around it, every Lap becomes a lap and every Trackpoint a record. Session
+// totals are computed from the laps.
+func ReadTCX(r io.Reader) (*fit.File, error) {
+	var db trainingCenterDatabase
+	if err := xml.NewDecoder(r).Decode(&db); err != nil {
+		return nil, fmt.Errorf("tcx: %w", err)
+	}
+	if len(db.Activities) == 0 {
+		return nil, errors.New("tcx: no activities found")
+	}
+
+	file, err := fit.NewFile(fit.FileTypeActivity, fit.NewHeader(fit.V20, true))
+	if err != nil {
+		return nil, err
+	}
+	b := builder{file: file}
+	for i, act := range db.Activities {
+		if err = b.addActivity(act); err != nil {
+			return nil, fmt.Errorf("tcx: activity %d: %w", i, err)
+		}
+	}
+
+	file.FileId.Manufacturer = fit.ManufacturerDevelopment
+	file.FileId.TimeCreated = b.start
+
+	am := fit.NewActivityMsg()
+	am.Timestamp = b.end
+	am.TotalTimerTime = b.timerTime
+	am.NumSessions = uint16(len(db.Activities))
+	am.Type = fit.ActivityModeManual
+	am.Event = fit.EventActivity
+	am.EventType = fit.EventTypeStop
+	if err = file.Add(am); err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+type builder struct {
+	file       *fit.File
+	start, end time.Time
+	timerTime  uint32
+	lapIndex   uint16
+	sessions   uint16
+}
+
+func (b *builder) add(msg interface{}) error {
+	return b.file.Add(msg)
+}
+
+func (b *builder) addActivity(act activity) error {
+	if len(act.Laps) == 0 {
+		return errors.New("no laps found")
+	}
+
+	s := fit.NewSessionMsg()
+	s.MessageIndex = fit.MessageIndex(b.sessions)
+	s.Sport = fitSport(act.Sport)
+	s.Event = fit.EventSession
+	s.EventType = fit.EventTypeStop
+	s.Trigger = fit.SessionTriggerActivityEnd
+	s.FirstLapIndex = b.lapIndex
+	s.NumLaps = uint16(len(act.Laps))
+	s.TotalElapsedTime, s.TotalTimerTime, s.TotalDistance, s.TotalCalories = 0, 0, 0, 0
+
+	var hrSum, hrTime float64
+	for i, tl := range act.Laps {
+		l, err := b.addLap(tl, s.Sport)
+		if err != nil {
+			return fmt.Errorf("lap %d: %w", i, err)
+		}
+		if i == 0 {
+			s.StartTime = l.StartTime
+			s.StartPositionLat, s.StartPositionLong = l.StartPositionLat, l.StartPositionLong
+		}
+		s.Timestamp = l.Timestamp
+		s.TotalElapsedTime += l.TotalElapsedTime
+		s.TotalTimerTime += l.TotalTimerTime
+		if l.TotalDistance != 0xFFFFFFFF {
+			s.TotalDistance += l.TotalDistance
+		}
+		if l.TotalCalories != 0xFFFF {
+			s.TotalCalories += l.TotalCalories
+		}
+		if l.AvgHeartRate != 0xFF {
+			hrSum += float64(l.AvgHeartRate) * float64(l.TotalTimerTime)
+			hrTime += float64(l.TotalTimerTime)
+		}
+		if l.MaxHeartRate != 0xFF && (s.MaxHeartRate == 0xFF || l.MaxHeartRate > s.MaxHeartRate) {
+			s.MaxHeartRate = l.MaxHeartRate
+		}
+	}
+	if hrTime > 0 {
+		s.AvgHeartRate = uint8(math.Round(hrSum / hrTime))
+	}
+
+	start := fit.NewEventMsg()
+	start.Timestamp = s.StartTime
+	start.Event = fit.EventTimer
+	start.EventType = fit.EventTypeStart
+	stop := fit.NewEventMsg()
+	stop.Timestamp = s.Timestamp
+	stop.Event = fit.EventTimer
+	stop.EventType = fit.EventTypeStopAll
+	for _, msg := range []interface{}{start, stop, s} {
+		if err := b.add(msg); err != nil {
+			return err
+		}
+	}
+
+	if b.sessions == 0 || s.StartTime.Before(b.start) {
+		b.start = s.StartTime
+	}
+	if s.Timestamp.After(b.end) {
+		b.end = s.Timestamp
+	}
+	b.timerTime += s.TotalTimerTime
+	b.sessions++
+	return nil
+}
+
+func (b *builder) addLap(tl lap, sport fit.Sport) (*fit.LapMsg, error) {
+	start, err := parseTime(tl.StartTime)
+	if err != nil {
+		return nil, err
+	}
+
+	l := fit.NewLapMsg()
+	l.MessageIndex = fit.MessageIndex(b.lapIndex)
+	l.Event = fit.EventLap
+	l.EventType = fit.EventTypeStop
+	l.Sport = sport
+	l.StartTime = start
+	l.TotalTimerTime = uint32(math.Round(float64(tl.TotalTimeSeconds) * 1000))
+	l.TotalElapsedTime = l.TotalTimerTime
+	l.TotalDistance = uint32(math.Round(float64(tl.DistanceMeters) * 100))
+	l.TotalCalories = tl.Calories
+	l.LapTrigger = fitTrigger(tl.TriggerMethod)
+	l.Intensity = fit.IntensityActive
+	if tl.Intensity == intensityResting {
+		l.Intensity = fit.IntensityRest
+	}
+	if tl.MaximumSpeed != nil {
+		setSpeed(&l.MaxSpeed, &l.EnhancedMaxSpeed, float64(*tl.MaximumSpeed))
+	}
+	if tl.AverageHeartRateBpm != nil {
+		l.AvgHeartRate = tl.AverageHeartRateBpm.Value
+	}
+	if tl.MaximumHeartRateBpm != nil {
+		l.MaxHeartRate = tl.MaximumHeartRateBpm.Value
+	}
+	if tl.Cadence != nil {
+		l.AvgCadence = *tl.Cadence
+	}
+	if tl.Extensions != nil && tl.Extensions.LX != nil {
+		ext := tl.Extensions.LX
+		if ext.AvgSpeed != nil {
+			setSpeed(&l.AvgSpeed, &l.EnhancedAvgSpeed, float64(*ext.AvgSpeed))
+		}
+		if ext.AvgWatts != nil {
+			l.AvgPower = *ext.AvgWatts
+		}
+		if ext.MaxWatts != nil {
+			l.MaxPower = *ext.MaxWatts
+		}
+	}
+
+	l.Timestamp = start.Add(time.Duration(l.TotalTimerTime) * time.Millisecond)
+	for _, t := range tl.Tracks {
+		for _, tp := range t.Trackpoints {
+			r, err := record(tp)
+			if err != nil {
+				return nil, err
+			}
+			if l.StartPositionLat.Invalid() && !r.PositionLat.Invalid() {
+				l.StartPositionLat, l.StartPositionLong = r.PositionLat, r.PositionLong
+			}
+			if !r.PositionLat.Invalid() {
+				l.EndPositionLat, l.EndPositionLong = r.PositionLat, r.PositionLong
+			}
+			if r.Timestamp.After(l.Timestamp) {
+				l.Timestamp = r.Timestamp
+			}
+			if err = b.add(r); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if elapsed := l.Timestamp.Sub(start); elapsed > 0 {
+		l.TotalElapsedTime = uint32(elapsed / time.Millisecond)
+	}
+
+	if err = b.add(l); err != nil {
+		return nil, err
+	}
+	b.lapIndex++
+	return l, nil
+}
+
+func record(tp trackpoint) (*fit.RecordMsg, error) {
+	ts, err := parseTime(tp.Time)
+	if err != nil {
+		return nil, err
+	}
+	r := fit.NewRecordMsg()
+	r.Timestamp = ts
+	if tp.Position != nil {
+		r.PositionLat = fit.NewLatitudeDegrees(float64(tp.Position.LatitudeDegrees))
+		r.PositionLong = fit.NewLongitudeDegrees(float64(tp.Position.LongitudeDegrees))
+	}
+	if tp.AltitudeMeters != nil {
+		raw := math.Round((float64(*tp.AltitudeMeters) + 500) * 5)
+		if raw >= 0 && raw < 0xFFFF {
+			r.Altitude = uint16(raw)
+		} else if raw >= 0 && raw < 0xFFFFFFFF {
+			r.EnhancedAltitude = uint32(raw)
+		}
+	}
+	if tp.DistanceMeters != nil {
+		r.Distance = uint32(math.Round(float64(*tp.DistanceMeters) * 100))
+	}
+	if tp.HeartRateBpm != nil {
+		r.HeartRate = tp.HeartRateBpm.Value
+	}
+	if tp.Cadence != nil {
+		r.Cadence = *tp.Cadence
+	}
+	if tp.Extensions != nil && tp.Extensions.TPX != nil {
+		ext := tp.Extensions.TPX
+		if ext.Speed != nil {
+			setSpeed(&r.Speed, &r.EnhancedSpeed, float64(*ext.Speed))
+		}
+		if ext.Watts != nil {
+			r.Power = *ext.Watts
+		}
+	}
+	return r, nil
+}
+
+// setSpeed sets a speed in m/s, using the enhanced (32-bit) field if the
+// value does not fit in the 16-bit field.
+func setSpeed(speed *uint16, enhanced *uint32, v float64) {
+	raw := math.Round(v * 1000)
+	switch {
+	case raw < 0:
+	case raw < 0xFFFF:
+		*speed = uint16(raw)
+	case raw < 0xFFFFFFFF:
+		*enhanced = uint32(raw)
+	}
+}
+
+func fitSport(s string) fit.Sport {
+	switch s {
+	case sportRunning:
+		return fit.SportRunning
+	case sportBiking:
+		return fit.SportCycling
+	default:
+		return fit.SportGeneric
+	}
+}
+
+func fitTrigger(s string) fit.LapTrigger {
+	switch s {
+	case triggerTime:
+		return fit.LapTriggerTime
+	case triggerDistance:
+		return fit.LapTriggerDistance
+	case triggerLocation:
+		return fit.LapTriggerPositionLap
+	default:
+		return fit.LapTriggerManual
+	}
+}
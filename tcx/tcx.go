@@ -0,0 +1,141 @@
+// Package tcx implements conversion between FIT activity files and Garmin
+// Training Center XML (TCX) documents.
+//
+// Every session of an activity is converted to a TCX Activity, every lap to
+// a Lap and every record to a Trackpoint. Speed and power are stored using
+// the Garmin ActivityExtension.
+package tcx
+
+import (
+	"encoding/xml"
+	"strconv"
+	"time"
+)
+
+const (
+	nsTCX = "http://www.garmin.com/xmlschemas/TrainingCenterDatabase/v2"
+	nsAX  = "http://www.garmin.com/xmlschemas/ActivityExtension/v2"
+)
+
+// TCX sport names.
+const (
+	sportRunning = "Running"
+	sportBiking  = "Biking"
+	sportOther   = "Other"
+)
+
+// TCX lap intensities.
+const (
+	intensityActive  = "Active"
+	intensityResting = "Resting"
+)
+
+// TCX lap trigger methods.
+const (
+	triggerManual   = "Manual"
+	triggerDistance = "Distance"
+	triggerLocation = "Location"
+	triggerTime     = "Time"
+)
+
+type trainingCenterDatabase struct {
+	XMLName    xml.Name   `xml:"http://www.garmin.com/xmlschemas/TrainingCenterDatabase/v2 TrainingCenterDatabase"`
+	Activities []activity `xml:"Activities>Activity"`
+}
+
+type activity struct {
+	Sport string `xml:"Sport,attr"`
+	ID    string `xml:"Id"`
+	Laps  []lap  `xml:"Lap"`
+}
+
+type lap struct {
+	StartTime           string         `xml:"StartTime,attr"`
+	TotalTimeSeconds    number         `xml:"TotalTimeSeconds"`
+	DistanceMeters      number         `xml:"DistanceMeters"`
+	MaximumSpeed        *number        `xml:"MaximumSpeed,omitempty"`
+	Calories            uint16         `xml:"Calories"`
+	AverageHeartRateBpm *heartRate     `xml:"AverageHeartRateBpm,omitempty"`
+	MaximumHeartRateBpm *heartRate     `xml:"MaximumHeartRateBpm,omitempty"`
+	Intensity           string         `xml:"Intensity"`
+	Cadence             *uint8         `xml:"Cadence,omitempty"`
+	TriggerMethod       string         `xml:"TriggerMethod"`
+	Tracks              []track        `xml:"Track"`
+	Extensions          *lapExtensions `xml:"Extensions,omitempty"`
+}
+
+type heartRate struct {
+	Value uint8 `xml:"Value"`
+}
+
+type track struct {
+	Trackpoints []trackpoint `xml:"Trackpoint"`
+}
+
+type trackpoint struct {
+	Time           string                `xml:"Time"`
+	Position       *position             `xml:"Position,omitempty"`
+	AltitudeMeters *number               `xml:"AltitudeMeters,omitempty"`
+	DistanceMeters *number               `xml:"DistanceMeters,omitempty"`
+	HeartRateBpm   *heartRate            `xml:"HeartRateBpm,omitempty"`
+	Cadence        *uint8                `xml:"Cadence,omitempty"`
+	Extensions     *trackpointExtensions `xml:"Extensions,omitempty"`
+}
+
+type position struct {
+	LatitudeDegrees  number `xml:"LatitudeDegrees"`
+	LongitudeDegrees number `xml:"LongitudeDegrees"`
+}
+
+type trackpointExtensions struct {
+	TPX *tpx `xml:"http://www.garmin.com/xmlschemas/ActivityExtension/v2 TPX"`
+}
+
+type tpx struct {
+	Speed *number `xml:"Speed,omitempty"`
+	Watts *uint16 `xml:"Watts,omitempty"`
+}
+
+type lapExtensions struct {
+	LX *lx `xml:"http://www.garmin.com/xmlschemas/ActivityExtension/v2 LX"`
+}
+
+type lx struct {
+	AvgSpeed *number `xml:"AvgSpeed,omitempty"`
+	AvgWatts *uint16 `xml:"AvgWatts,omitempty"`
+	MaxWatts *uint16 `xml:"MaxWatts,omitempty"`
+}
+
+// number is a float64 that is encoded without exponent.
+type number float64
+
+func (n number) MarshalText() ([]byte, error) {
+	return strconv.AppendFloat(nil, float64(n), 'f', -1, 64), nil
+}
+
+func (n *number) UnmarshalText(text []byte) error {
+	f, err := strconv.ParseFloat(string(text), 64)
+	if err != nil {
+		return err
+	}
+	*n = number(f)
+	return nil
+}
+
+func numberPtr(f float64) *number {
+	n := number(f)
+	return &n
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+func parseTime(s string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, err
+	}
+	// FIT timestamps have a resolution of one second.
+	return t.UTC().Truncate(time.Second), nil
+}
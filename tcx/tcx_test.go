@@ -0,0 +1,238 @@
+package tcx_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/tcx"
+)
+
+type tcxDoc struct {
+	Activities []struct {
+		Sport string `xml:"Sport,attr"`
+		Laps  []struct {
+			StartTime string `xml:"StartTime,attr"`
+			Points    []struct {
+				Time  string `xml:"Time"`
+				HR    int    `xml:"HeartRateBpm>Value"`
+				Watts int    `xml:"Extensions>TPX>Watts"`
+			} `xml:"Track>Trackpoint"`
+		} `xml:"Lap"`
+	} `xml:"Activities>Activity"`
+}
+
+func decodeFile(t *testing.T, path ...string) *fit.File {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join(append([]string{"..", "testdata"}, path...)...))
+	if err != nil {
+		t.Fatalf("reading file failed: %v", err)
+	}
+	file, err := fit.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("decode: got error, want none; error is: %v", err)
+	}
+	return file
+}
+
+func TestWriteTCX(t *testing.T) {
+	file := decodeFile(t, "dcrainmaker", "Edge810-Vector-2013-08-16-15-35-10.fit")
+	activity, err := file.Activity()
+	if err != nil {
+		t.Fatalf("activity: got error, want none; error is: %v", err)
+	}
+
+	buf := new(bytes.Buffer)
+	if err = tcx.WriteTCX(buf, file); err != nil {
+		t.Fatalf("WriteTCX: got error, want none; error is: %v", err)
+	}
+	var doc tcxDoc
+	if err = xml.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("unmarshal: got error, want none; error is: %v", err)
+	}
+
+	if len(doc.Activities) != 1 {
+		t.Fatalf("got %d activities, want 1", len(doc.Activities))
+	}
+	act := doc.Activities[0]
+	if act.Sport != "Biking" {
+		t.Errorf("sport: got %q, want %q", act.Sport, "Biking")
+	}
+	if len(act.Laps) != len(activity.Laps) {
+		t.Fatalf("got %d laps, want %d", len(act.Laps), len(activity.Laps))
+	}
+	var points, watts int
+	for _, l := range act.Laps {
+		points += len(l.Points)
+		for _, p := range l.Points {
+			if p.Watts > 0 {
+				watts++
+			}
+		}
+	}
+	if points != len(activity.Records) {
+		t.Errorf("got %d trackpoints, want %d", points, len(activity.Records))
+	}
+	if watts == 0 {
+		t.Error("got no trackpoints with power, want some")
+	}
+	if !strings.Contains(buf.String(), `xmlns="http://www.garmin.com/xmlschemas/TrainingCenterDatabase/v2"`) {
+		t.Error("missing TrainingCenterDatabase namespace")
+	}
+}
+
+func TestWriteTCXNotActivity(t *testing.T) {
+	file := decodeFile(t, "fitsdk", "Settings.fit")
+	if err := tcx.WriteTCX(new(bytes.Buffer), file); err == nil {
+		t.Error("got no error, want error for non-activity file")
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	tests := [][]string{
+		{"fitsdk", "Activity.fit"},
+		{"dcrainmaker", "Edge810-Vector-2013-08-16-15-35-10.fit"},
+	}
+	for _, path := range tests {
+		t.Run(path[1], func(t *testing.T) {
+			orig, err := decodeFile(t, path...).Activity()
+			if err != nil {
+				t.Fatalf("activity: got error, want none; error is: %v", err)
+			}
+
+			buf := new(bytes.Buffer)
+			if err = tcx.WriteTCX(buf, decodeFile(t, path...)); err != nil {
+				t.Fatalf("WriteTCX: got error, want none; error is: %v", err)
+			}
+			file, err := tcx.ReadTCX(buf)
+			if err != nil {
+				t.Fatalf("ReadTCX: got error, want none; error is: %v", err)
+			}
+
+			// Check that the converted file is a valid FIT file.
+			buf.Reset()
+			if err = fit.Encode(buf, file, binary.LittleEndian); err != nil {
+				t.Fatalf("encode: got error, want none; error is: %v", err)
+			}
+			file, err = fit.Decode(buf)
+			if err != nil {
+				t.Fatalf("decode: got error, want none; error is: %v", err)
+			}
+			got, err := file.Activity()
+			if err != nil {
+				t.Fatalf("activity: got error, want none; error is: %v", err)
+			}
+
+			if len(got.Sessions) != 1 {
+				t.Fatalf("got %d sessions, want 1", len(got.Sessions))
+			}
+			if len(got.Laps) != len(orig.Laps) {
+				t.Errorf("got %d laps, want %d", len(got.Laps), len(orig.Laps))
+			}
+			if len(got.Records) != len(orig.Records) {
+				t.Fatalf("got %d records, want %d", len(got.Records), len(orig.Records))
+			}
+			for i, r := range got.Records {
+				o := orig.Records[i]
+				if !r.Timestamp.Equal(o.Timestamp) {
+					t.Errorf("record %d: timestamp: got %v, want %v", i, r.Timestamp, o.Timestamp)
+				}
+				if r.HeartRate != o.HeartRate {
+					t.Errorf("record %d: heart rate: got %d, want %d", i, r.HeartRate, o.HeartRate)
+				}
+				if r.Power != o.Power {
+					t.Errorf("record %d: power: got %d, want %d", i, r.Power, o.Power)
+				}
+				if r.PositionLat != o.PositionLat || r.PositionLong != o.PositionLong {
+					t.Errorf("record %d: position: got %v,%v, want %v,%v",
+						i, r.PositionLat, r.PositionLong, o.PositionLat, o.PositionLong)
+				}
+				if r.Distance != o.Distance {
+					t.Errorf("record %d: distance: got %d, want %d", i, r.Distance, o.Distance)
+				}
+				if t.Failed() {
+					break
+				}
+			}
+			if got.Sessions[0].TotalDistance != sumDistance(orig.Laps) {
+				t.Errorf("session distance: got %d, want %d", got.Sessions[0].TotalDistance, sumDistance(orig.Laps))
+			}
+		})
+	}
+}
+
+func TestReadTCXErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+	}{
+		{"malformed", "<TrainingCenterDatabase"},
+		{"no activities", `<TrainingCenterDatabase xmlns="http://www.garmin.com/xmlschemas/TrainingCenterDatabase/v2"></TrainingCenterDatabase>`},
+		{"bad time", `<TrainingCenterDatabase xmlns="http://www.garmin.com/xmlschemas/TrainingCenterDatabase/v2">
+<Activities><Activity Sport="Running"><Id>x</Id><Lap StartTime="yesterday"></Lap></Activity></Activities>
+</TrainingCenterDatabase>`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := tcx.ReadTCX(strings.NewReader(test.in)); err == nil {
+				t.Error("got no error, want error")
+			}
+		})
+	}
+}
+
+func TestReadTCXPosition(t *testing.T) {
+	tests := []struct {
+		lat, long string
+		valid     bool
+	}{
+		{"59.9", "10.7", true},
+		{"-89.9", "-179.9", true},
+		{"45", "180", true},
+		{"45", "-180", true},
+		{"90", "10", true},
+		{"-90", "10", true},
+		{"90.1", "10", false},
+		{"45", "180.1", false},
+	}
+	for _, test := range tests {
+		t.Run(test.lat+","+test.long, func(t *testing.T) {
+			in := `<TrainingCenterDatabase xmlns="http://www.garmin.com/xmlschemas/TrainingCenterDatabase/v2">
+<Activities><Activity Sport="Running"><Id>2020-01-01T00:00:00Z</Id><Lap StartTime="2020-01-01T00:00:00Z"><Track><Trackpoint>
+<Time>2020-01-01T00:00:00Z</Time><Position><LatitudeDegrees>` + test.lat + `</LatitudeDegrees><LongitudeDegrees>` + test.long + `</LongitudeDegrees></Position>
+</Trackpoint></Track></Lap></Activity></Activities>
+</TrainingCenterDatabase>`
+			file, err := tcx.ReadTCX(strings.NewReader(in))
+			if err != nil {
+				t.Fatalf("ReadTCX: got error, want none; error is: %v", err)
+			}
+			act, err := file.Activity()
+			if err != nil {
+				t.Fatalf("activity: got error, want none; error is: %v", err)
+			}
+			if len(act.Records) != 1 {
+				t.Fatalf("got %d records, want 1", len(act.Records))
+			}
+			r := act.Records[0]
+			valid := !r.PositionLat.Invalid() && !r.PositionLong.Invalid()
+			if valid != test.valid {
+				t.Errorf("got valid position %t, want %t; position is %v,%v", valid, test.valid, r.PositionLat, r.PositionLong)
+			}
+		})
+	}
+}
+
+func sumDistance(laps []*fit.LapMsg) uint32 {
+	var sum uint32
+	for _, l := range laps {
+		if l.TotalDistance != 0xFFFFFFFF {
+			sum += l.TotalDistance
+		}
+	}
+	return sum
+}
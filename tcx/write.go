@@ -0,0 +1,272 @@
+package tcx
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/internal/field"
+)
+
+// WriteTCX writes the activity file to w as a TCX document.
+//
+// Laps are assigned to the session they start in, and records to the lap
+// they were recorded in. An activity without sessions is written as a single
+// TCX Activity, and a session without laps as a single TCX Lap summarizing
+// its records.
+func WriteTCX(w io.Writer, file *fit.File) error {
+	a, err := file.Activity()
+	if err != nil {
+		return err
+	}
+
+	laps := append([]*fit.LapMsg(nil), a.Laps...)
+	sort.SliceStable(laps, func(i, j int) bool { return laps[i].StartTime.Before(laps[j].StartTime) })
+	sessions := append([]*fit.SessionMsg(nil), a.Sessions...)
+	sort.SliceStable(sessions, func(i, j int) bool { return sessions[i].StartTime.Before(sessions[j].StartTime) })
+	if len(sessions) == 0 {
+		s := fit.NewSessionMsg()
+		if a.Sport != nil {
+			s.Sport = a.Sport.Sport
+		}
+		sessions = append(sessions, s)
+	}
+
+	var sessionStarts []time.Time
+	for _, s := range sessions {
+		sessionStarts = append(sessionStarts, s.StartTime)
+	}
+	lapsBySession := make([][]*fit.LapMsg, len(sessions))
+	for _, l := range laps {
+		i := index(sessionStarts, l.StartTime)
+		lapsBySession[i] = append(lapsBySession[i], l)
+	}
+
+	var db trainingCenterDatabase
+	for i, s := range sessions {
+		sessionLaps := lapsBySession[i]
+		recs := sessionRecords(a.Records, sessionStarts, i)
+		if len(sessionLaps) == 0 {
+			if len(recs) == 0 {
+				continue
+			}
+			sessionLaps = []*fit.LapMsg{summaryLap(recs)}
+		}
+
+		var lapStarts []time.Time
+		for _, l := range sessionLaps {
+			lapStarts = append(lapStarts, l.StartTime)
+		}
+		recsByLap := make([][]*fit.RecordMsg, len(sessionLaps))
+		for _, r := range recs {
+			j := index(lapStarts, r.Timestamp)
+			recsByLap[j] = append(recsByLap[j], r)
+		}
+
+		start := s.StartTime
+		if !field.ValidTime(start) {
+			start = sessionLaps[0].StartTime
+		}
+		act := activity{
+			Sport: tcxSport(s.Sport),
+			ID:    formatTime(start),
+		}
+		for j, l := range sessionLaps {
+			act.Laps = append(act.Laps, tcxLap(l, recsByLap[j]))
+		}
+		db.Activities = append(db.Activities, act)
+	}
+	if len(db.Activities) == 0 {
+		return errors.New("tcx: activity has no laps or records")
+	}
+
+	if _, err = io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err = enc.Encode(db); err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "\n")
+	return err
+}
+
+// index returns the index of the last start time not after t, or 0 if t is
+// before all start times. Invalid start times are never selected unless all
+// start times are invalid.
+func index(starts []time.Time, t time.Time) int {
+	idx := 0
+	for i, s := range starts {
+		if field.ValidTime(s) && !s.After(t) {
+			idx = i
+		}
+	}
+	return idx
+}
+
+func sessionRecords(recs []*fit.RecordMsg, starts []time.Time, i int) []*fit.RecordMsg {
+	if len(starts) == 1 {
+		return recs
+	}
+	var out []*fit.RecordMsg
+	for _, r := range recs {
+		if index(starts, r.Timestamp) == i {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// summaryLap returns a lap covering all of recs.
+func summaryLap(recs []*fit.RecordMsg) *fit.LapMsg {
+	l := fit.NewLapMsg()
+	first, last := recs[0], recs[len(recs)-1]
+	l.StartTime = first.Timestamp
+	l.Timestamp = last.Timestamp
+	secs := last.Timestamp.Sub(first.Timestamp).Seconds()
+	l.TotalElapsedTime = uint32(secs * 1000)
+	l.TotalTimerTime = l.TotalElapsedTime
+	l.TotalDistance = last.Distance
+	return l
+}
+
+func tcxLap(l *fit.LapMsg, recs []*fit.RecordMsg) lap {
+	tl := lap{
+		StartTime:     formatTime(l.StartTime),
+		Intensity:     intensityActive,
+		TriggerMethod: tcxTrigger(l.LapTrigger),
+	}
+	if !field.ValidTime(l.StartTime) && len(recs) > 0 {
+		tl.StartTime = formatTime(recs[0].Timestamp)
+	}
+
+	secs := l.GetTotalTimerTimeScaled()
+	if math.IsNaN(secs) {
+		secs = l.GetTotalElapsedTimeScaled()
+	}
+	if !math.IsNaN(secs) {
+		tl.TotalTimeSeconds = number(secs)
+	}
+	if d := l.GetTotalDistanceScaled(); !math.IsNaN(d) {
+		tl.DistanceMeters = number(d)
+	}
+	if v := firstValid(l.GetEnhancedMaxSpeedScaled(), l.GetMaxSpeedScaled()); !math.IsNaN(v) {
+		tl.MaximumSpeed = numberPtr(v)
+	}
+	if l.TotalCalories != 0xFFFF {
+		tl.Calories = l.TotalCalories
+	}
+	if l.AvgHeartRate != 0xFF {
+		tl.AverageHeartRateBpm = &heartRate{Value: l.AvgHeartRate}
+	}
+	if l.MaxHeartRate != 0xFF {
+		tl.MaximumHeartRateBpm = &heartRate{Value: l.MaxHeartRate}
+	}
+	if l.Intensity == fit.IntensityRest {
+		tl.Intensity = intensityResting
+	}
+	if l.AvgCadence != 0xFF {
+		cad := l.AvgCadence
+		tl.Cadence = &cad
+	}
+
+	var ext lx
+	if v := firstValid(l.GetEnhancedAvgSpeedScaled(), l.GetAvgSpeedScaled()); !math.IsNaN(v) {
+		ext.AvgSpeed = numberPtr(v)
+	}
+	if l.AvgPower != 0xFFFF {
+		p := l.AvgPower
+		ext.AvgWatts = &p
+	}
+	if l.MaxPower != 0xFFFF {
+		p := l.MaxPower
+		ext.MaxWatts = &p
+	}
+	if ext != (lx{}) {
+		tl.Extensions = &lapExtensions{LX: &ext}
+	}
+
+	if len(recs) > 0 {
+		var t track
+		for _, r := range recs {
+			t.Trackpoints = append(t.Trackpoints, tcxTrackpoint(r))
+		}
+		tl.Tracks = []track{t}
+	}
+	return tl
+}
+
+func tcxTrackpoint(r *fit.RecordMsg) trackpoint {
+	tp := trackpoint{Time: formatTime(r.Timestamp)}
+	if !r.PositionLat.Invalid() && !r.PositionLong.Invalid() {
+		tp.Position = &position{
+			LatitudeDegrees:  number(r.PositionLat.Degrees()),
+			LongitudeDegrees: number(r.PositionLong.Degrees()),
+		}
+	}
+	if v := firstValid(r.GetEnhancedAltitudeScaled(), r.GetAltitudeScaled()); !math.IsNaN(v) {
+		tp.AltitudeMeters = numberPtr(v)
+	}
+	if v := r.GetDistanceScaled(); !math.IsNaN(v) {
+		tp.DistanceMeters = numberPtr(v)
+	}
+	if r.HeartRate != 0xFF {
+		tp.HeartRateBpm = &heartRate{Value: r.HeartRate}
+	}
+	if r.Cadence != 0xFF {
+		cad := r.Cadence
+		tp.Cadence = &cad
+	}
+
+	var ext tpx
+	if v := firstValid(r.GetEnhancedSpeedScaled(), r.GetSpeedScaled()); !math.IsNaN(v) {
+		ext.Speed = numberPtr(v)
+	}
+	if r.Power != 0xFFFF {
+		p := r.Power
+		ext.Watts = &p
+	}
+	if ext != (tpx{}) {
+		tp.Extensions = &trackpointExtensions{TPX: &ext}
+	}
+	return tp
+}
+
+func tcxSport(s fit.Sport) string {
+	switch s {
+	case fit.SportRunning:
+		return sportRunning
+	case fit.SportCycling:
+		return sportBiking
+	default:
+		return sportOther
+	}
+}
+
+func tcxTrigger(t fit.LapTrigger) string {
+	switch t {
+	case fit.LapTriggerTime:
+		return triggerTime
+	case fit.LapTriggerDistance:
+		return triggerDistance
+	case fit.LapTriggerPositionStart, fit.LapTriggerPositionLap,
+		fit.LapTriggerPositionWaypoint, fit.LapTriggerPositionMarked:
+		return triggerLocation
+	default:
+		return triggerManual
+	}
+}
+
+func firstValid(vs ...float64) float64 {
+	for _, v := range vs {
+		if !math.IsNaN(v) {
+			return v
+		}
+	}
+	return math.NaN()
+}
@@ -0,0 +1,160 @@
+package timeseries
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+)
+
+// Arrow IPC constants, see Schema.fbs and Message.fbs of the Arrow format
+// specification.
+const (
+	arrowMetadataV5 = 4
+
+	arrowHeaderSchema      = 1
+	arrowHeaderRecordBatch = 3
+
+	arrowTypeFloatingPoint = 3
+	arrowTypeTimestamp     = 10
+
+	arrowPrecisionDouble = 2
+	arrowTimeUnitSecond  = 0
+
+	arrowContinuation = 0xFFFFFFFF
+)
+
+// WriteArrow writes the table to w in the Apache Arrow IPC streaming format,
+// as a schema followed by a single record batch.
+//
+// Time columns are written as timestamps with second resolution in UTC, and
+// all other columns as 64-bit floating point numbers. Invalid values are
+// written as nulls. The units of every column are stored in the "units"
+// field metadata.
+func (t *Table) WriteArrow(w io.Writer) error {
+	if err := writeArrowMessage(w, t.arrowSchema(), nil); err != nil {
+		return err
+	}
+	batch, body := t.arrowRecordBatch()
+	if err := writeArrowMessage(w, batch, body); err != nil {
+		return err
+	}
+	// End of stream.
+	var eos [8]byte
+	binary.LittleEndian.PutUint32(eos[:], arrowContinuation)
+	_, err := w.Write(eos[:])
+	return err
+}
+
+func (t *Table) arrowSchema() fbTable {
+	fields := make(fbTables, len(t.Columns))
+	for i, c := range t.Columns {
+		typeType := fbUint8(arrowTypeFloatingPoint)
+		typ := fbTable{fbInt16(arrowPrecisionDouble)}
+		if c.Time {
+			typeType = fbUint8(arrowTypeTimestamp)
+			typ = fbTable{fbInt16(arrowTimeUnitSecond), fbOffset(fbString("UTC"))}
+		}
+		var meta fbField
+		if c.Units != "" {
+			meta = fbOffset(fbTables{{fbOffset(fbString("units")), fbOffset(fbString(c.Units))}})
+		}
+		fields[i] = fbTable{
+			fbOffset(fbString(c.Name)), // name
+			fbBool(true),               // nullable
+			typeType,                   // type_type
+			fbOffset(typ),              // type
+			{},                         // dictionary
+			fbOffset(fbTables{}),       // children
+			meta,                       // custom_metadata
+		}
+	}
+	schema := fbTable{
+		fbInt16(0),       // endianness: little
+		fbOffset(fields), // fields
+	}
+	return fbTable{
+		fbInt16(arrowMetadataV5),   // version
+		fbUint8(arrowHeaderSchema), // header_type
+		fbOffset(schema),           // header
+		fbInt64(0),                 // bodyLength
+	}
+}
+
+// arrowRecordBatch returns the record batch message and body for the table.
+// Every column has a validity bitmap, omitted if there are no nulls, and a
+// buffer of 64-bit values.
+func (t *Table) arrowRecordBatch() (fbTable, []byte) {
+	var nodes, buffers []byte
+	var body []byte
+	addBuffer := func(data []byte) {
+		buffers = appendInt64s(buffers, int64(len(body)), int64(len(data)))
+		body = append(body, data...)
+		for len(body)%8 != 0 {
+			body = append(body, 0)
+		}
+	}
+
+	for _, c := range t.Columns {
+		validity := make([]byte, (t.rows+7)/8)
+		values := make([]byte, 8*t.rows)
+		nulls := 0
+		for i, v := range c.Values {
+			if math.IsNaN(v) {
+				nulls++
+				continue
+			}
+			validity[i/8] |= 1 << (i % 8)
+			if c.Time {
+				binary.LittleEndian.PutUint64(values[8*i:], uint64(int64(v)))
+			} else {
+				binary.LittleEndian.PutUint64(values[8*i:], math.Float64bits(v))
+			}
+		}
+		if nulls == 0 {
+			validity = nil
+		}
+		nodes = appendInt64s(nodes, int64(t.rows), int64(nulls))
+		addBuffer(validity)
+		addBuffer(values)
+	}
+
+	batch := fbTable{
+		fbInt64(int64(t.rows)),                                    // length
+		fbOffset(fbStructs{n: len(t.Columns), data: nodes}),       // nodes
+		fbOffset(fbStructs{n: 2 * len(t.Columns), data: buffers}), // buffers
+	}
+	return fbTable{
+		fbInt16(arrowMetadataV5),        // version
+		fbUint8(arrowHeaderRecordBatch), // header_type
+		fbOffset(batch),                 // header
+		fbInt64(int64(len(body))),       // bodyLength
+	}, body
+}
+
+// writeArrowMessage writes an encapsulated IPC message: a continuation
+// marker, the size of the metadata, the metadata padded to 8 bytes and the
+// message body.
+func writeArrowMessage(w io.Writer, msg fbTable, body []byte) error {
+	meta := fbFinish(msg)
+	for len(meta)%8 != 0 {
+		meta = append(meta, 0)
+	}
+	var prefix [8]byte
+	binary.LittleEndian.PutUint32(prefix[:], arrowContinuation)
+	binary.LittleEndian.PutUint32(prefix[4:], uint32(len(meta)))
+	for _, b := range [][]byte{prefix[:], meta, body} {
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func appendInt64s(b []byte, vs ...int64) []byte {
+	for _, v := range vs {
+		var buf [8]byte
+		binary.LittleEndian.PutUint64(buf[:], uint64(v))
+		b = append(b, buf[:]...)
+	}
+	return b
+}
@@ -0,0 +1,44 @@
+package timeseries
+
+import (
+	"encoding/csv"
+	"io"
+	"math"
+	"strconv"
+	"time"
+)
+
+// WriteCSV writes the table to w as CSV, with a header row of column names.
+// Times are written in RFC 3339 format in UTC, and invalid values as empty
+// fields.
+func (t *Table) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	record := make([]string, len(t.Columns))
+	for i, c := range t.Columns {
+		record[i] = c.Name
+	}
+	if err := cw.Write(record); err != nil {
+		return err
+	}
+	for row := 0; row < t.rows; row++ {
+		for i, c := range t.Columns {
+			record[i] = formatValue(c, c.Values[row])
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func formatValue(c *Column, v float64) string {
+	switch {
+	case math.IsNaN(v):
+		return ""
+	case c.Time:
+		return time.Unix(int64(v), 0).UTC().Format(time.RFC3339)
+	default:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+}
@@ -0,0 +1,164 @@
+package timeseries
+
+import "encoding/binary"
+
+// This file implements the subset of the FlatBuffers binary format needed to
+// write Arrow IPC metadata. Objects are written front to back: a table is
+// preceded by its vtable and followed by the objects it references, so that
+// all unsigned offsets point forward as required by the format.
+
+// fbObject is an object that can be referenced by an offset.
+type fbObject interface {
+	// write appends the object to b and returns its position.
+	write(b *fbBuilder) int
+}
+
+// fbField is a table field: either an inline scalar or an offset to an
+// object. The zero value is an absent field.
+type fbField struct {
+	scalar []byte
+	obj    fbObject
+}
+
+func fbUint8(v uint8) fbField {
+	return fbField{scalar: []byte{v}}
+}
+
+func fbBool(v bool) fbField {
+	if v {
+		return fbUint8(1)
+	}
+	return fbUint8(0)
+}
+
+func fbInt16(v int16) fbField {
+	b := make([]byte, 2)
+	binary.LittleEndian.PutUint16(b, uint16(v))
+	return fbField{scalar: b}
+}
+
+func fbInt64(v int64) fbField {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, uint64(v))
+	return fbField{scalar: b}
+}
+
+func fbOffset(obj fbObject) fbField {
+	return fbField{obj: obj}
+}
+
+// fbTable is a table with fields indexed by field id.
+type fbTable []fbField
+
+func (t fbTable) write(b *fbBuilder) int {
+	// The vtable: its size, the inline size of the table and the offset
+	// of every field from the start of the table.
+	b.align(2)
+	vt := b.reserve(4 + 2*len(t))
+
+	// Tables start with the offset to the vtable. The table is aligned to
+	// 8 bytes so that fields can be aligned by their offset in the table.
+	b.align(8)
+	start := b.reserve(4)
+	binary.LittleEndian.PutUint32(b.buf[start:], uint32(start-vt))
+
+	offsets := make([]int, len(t))
+	for i, f := range t {
+		switch {
+		case f.scalar != nil:
+			b.align(len(f.scalar))
+			offsets[i] = b.reserve(len(f.scalar))
+			copy(b.buf[offsets[i]:], f.scalar)
+		case f.obj != nil:
+			b.align(4)
+			offsets[i] = b.reserve(4)
+		}
+	}
+	end := len(b.buf)
+
+	binary.LittleEndian.PutUint16(b.buf[vt:], uint16(4+2*len(t)))
+	binary.LittleEndian.PutUint16(b.buf[vt+2:], uint16(end-start))
+	for i, off := range offsets {
+		if off != 0 {
+			binary.LittleEndian.PutUint16(b.buf[vt+4+2*i:], uint16(off-start))
+		}
+	}
+
+	for i, f := range t {
+		if f.obj != nil {
+			b.patch(offsets[i], f.obj.write(b))
+		}
+	}
+	return start
+}
+
+// fbString is a string.
+type fbString string
+
+func (s fbString) write(b *fbBuilder) int {
+	b.align(4)
+	start := b.reserve(4 + len(s) + 1)
+	binary.LittleEndian.PutUint32(b.buf[start:], uint32(len(s)))
+	copy(b.buf[start+4:], s)
+	return start
+}
+
+// fbTables is a vector of tables.
+type fbTables []fbTable
+
+func (v fbTables) write(b *fbBuilder) int {
+	b.align(4)
+	start := b.reserve(4 + 4*len(v))
+	binary.LittleEndian.PutUint32(b.buf[start:], uint32(len(v)))
+	for i, t := range v {
+		b.patch(start+4+4*i, t.write(b))
+	}
+	return start
+}
+
+// fbStructs is a vector of structs with 8 byte alignment, given as their
+// encoded bytes.
+type fbStructs struct {
+	n    int
+	data []byte
+}
+
+func (v fbStructs) write(b *fbBuilder) int {
+	// The elements following the length must be aligned.
+	for len(b.buf)%8 != 4 {
+		b.buf = append(b.buf, 0)
+	}
+	start := b.reserve(4 + len(v.data))
+	binary.LittleEndian.PutUint32(b.buf[start:], uint32(v.n))
+	copy(b.buf[start+4:], v.data)
+	return start
+}
+
+type fbBuilder struct {
+	buf []byte
+}
+
+// fbFinish returns the encoded buffer with root as the root table.
+func fbFinish(root fbTable) []byte {
+	b := &fbBuilder{buf: make([]byte, 4)}
+	b.patch(0, root.write(b))
+	return b.buf
+}
+
+func (b *fbBuilder) align(n int) {
+	for len(b.buf)%n != 0 {
+		b.buf = append(b.buf, 0)
+	}
+}
+
+// reserve appends n zero bytes and returns their position.
+func (b *fbBuilder) reserve(n int) int {
+	pos := len(b.buf)
+	b.buf = append(b.buf, make([]byte, n)...)
+	return pos
+}
+
+// patch sets the unsigned offset at pos to point to target.
+func (b *fbBuilder) patch(pos, target int) {
+	binary.LittleEndian.PutUint32(b.buf[pos:], uint32(target-pos))
+}
@@ -0,0 +1,295 @@
+// Package timeseries converts FIT messages to column-oriented tables for
+// analysis, with writers for CSV and the Apache Arrow IPC stream format.
+package timeseries
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+// Table is a column-oriented table of messages of a single type, with one
+// row per message.
+type Table struct {
+	// Mesg is the profile name of the messages, e.g. "record".
+	Mesg string
+
+	// Columns holds the columns of the table, in profile field order.
+	Columns []*Column
+
+	rows int
+}
+
+// Column is a single column of a table.
+type Column struct {
+	// Name and Units are the profile name and units of the field, e.g.
+	// "speed" and "m/s". Positions are given in degrees.
+	Name  string
+	Units string
+
+	// Time reports whether the column holds times, given as seconds since
+	// the Unix epoch.
+	Time bool
+
+	// Values holds the scaled value of the field for every row, or NaN if
+	// the field is invalid.
+	Values []float64
+}
+
+// Len returns the number of rows in the table.
+func (t *Table) Len() int {
+	return t.rows
+}
+
+// Column returns the column with the given name, or nil if the table has no
+// such column.
+func (t *Table) Column(name string) *Column {
+	for _, c := range t.Columns {
+		if c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// Times returns the values of a time column as times in UTC. Invalid values
+// are returned as the zero time.
+func (c *Column) Times() []time.Time {
+	ts := make([]time.Time, len(c.Values))
+	for i, v := range c.Values {
+		if !math.IsNaN(v) {
+			ts[i] = time.Unix(int64(v), 0).UTC()
+		}
+	}
+	return ts
+}
+
+// FromMessages returns a table of msgs, which must be a slice of messages or
+// pointers to messages of a single type, e.g. ActivityFile.Records of type
+// []*fit.RecordMsg.
+//
+// Every numeric, time and position field becomes a column, with scaled
+// values. Array fields become one column per element, named with the element
+// index, e.g. "time_in_hr_zone_0". Messages with a single array field, such
+// as hrv, are instead flattened to one row per valid element. String fields,
+// and columns that are invalid for all rows, are omitted.
+//
+// Hrv messages have no timestamp. Their table is given an "elapsed_time"
+// column in seconds, which is the sum of the intervals up to and including
+// each row, i.e. the time of the beat ending the interval relative to the
+// start of the first interval. Use package hrv to get absolute beat times
+// for an activity.
+func FromMessages(msgs interface{}) (*Table, error) {
+	v := reflect.ValueOf(msgs)
+	if v.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("timeseries: not a slice: %T", msgs)
+	}
+	mn, found := fit.MesgNumOf(reflect.Zero(v.Type().Elem()).Interface())
+	if !found {
+		return nil, fmt.Errorf("timeseries: not a slice of messages: %T", msgs)
+	}
+	pfs := fit.ProfileFields(mn)
+	st := v.Type().Elem()
+	if st.Kind() == reflect.Ptr {
+		st = st.Elem()
+	}
+
+	var rows []reflect.Value
+	for i := 0; i < v.Len(); i++ {
+		mv := v.Index(i)
+		if mv.Kind() == reflect.Ptr {
+			if mv.IsNil() {
+				continue
+			}
+			mv = mv.Elem()
+		}
+		rows = append(rows, mv)
+	}
+
+	t := &Table{Mesg: fit.MesgName(mn)}
+	if len(pfs) == 1 && pfs[0].Array {
+		t.flatten(mn, pfs[0], st.Field(pfs[0].Index).Type.Elem(), rows)
+		return t, nil
+	}
+
+	t.rows = len(rows)
+	for _, pf := range pfs {
+		typ := st.Field(pf.Index).Type
+		if pf.Array {
+			t.addArray(pf, typ.Elem(), rows)
+			continue
+		}
+		c := newColumn(pf, typ, "", len(rows))
+		if c == nil {
+			continue
+		}
+		valid := false
+		for i, mv := range rows {
+			c.Values[i] = value(pf, mv.Field(pf.Index))
+			valid = valid || !math.IsNaN(c.Values[i])
+		}
+		if valid {
+			t.Columns = append(t.Columns, c)
+		}
+	}
+	return t, nil
+}
+
+// intervalFields holds the names of the array fields of flattened messages
+// that hold intervals in seconds.
+var intervalFields = map[fit.MesgNum]string{
+	fit.MesgNumHrv: "time",
+}
+
+// flatten adds a single column with one row for every valid element of the
+// array field pf of message mn with elements of type typ. For fields holding
+// intervals, an elapsed time column with the running sum of the intervals is
+// added before it.
+func (t *Table) flatten(mn fit.MesgNum, pf fit.ProfileField, typ reflect.Type, rows []reflect.Value) {
+	c := newColumn(pf, typ, "", 0)
+	if c == nil {
+		return
+	}
+	var elapsed *Column
+	if name, found := intervalFields[mn]; found && pf.Name == name {
+		elapsed = &Column{Name: "elapsed_time", Units: "s"}
+	}
+	sum := 0.0
+	for _, mv := range rows {
+		arr := mv.Field(pf.Index)
+		for j := 0; j < arr.Len(); j++ {
+			x := value(pf, arr.Index(j))
+			if math.IsNaN(x) {
+				continue
+			}
+			c.Values = append(c.Values, x)
+			if elapsed != nil {
+				sum += x
+				elapsed.Values = append(elapsed.Values, sum)
+			}
+		}
+	}
+	t.rows = len(c.Values)
+	if t.rows == 0 {
+		return
+	}
+	if elapsed != nil {
+		t.Columns = append(t.Columns, elapsed)
+	}
+	t.Columns = append(t.Columns, c)
+}
+
+// addArray adds one column for every element index of the array field pf
+// with elements of type typ.
+func (t *Table) addArray(pf fit.ProfileField, typ reflect.Type, rows []reflect.Value) {
+	n := 0
+	for _, mv := range rows {
+		if l := mv.Field(pf.Index).Len(); l > n {
+			n = l
+		}
+	}
+	for j := 0; j < n; j++ {
+		c := newColumn(pf, typ, "_"+strconv.Itoa(j), len(rows))
+		if c == nil {
+			return
+		}
+		valid := false
+		for i, mv := range rows {
+			c.Values[i] = math.NaN()
+			if arr := mv.Field(pf.Index); j < arr.Len() {
+				c.Values[i] = value(pf, arr.Index(j))
+			}
+			valid = valid || !math.IsNaN(c.Values[i])
+		}
+		if valid {
+			t.Columns = append(t.Columns, c)
+		}
+	}
+}
+
+// newColumn returns an empty column for the field pf of type typ, or nil if
+// the field can not be represented as a column.
+func newColumn(pf fit.ProfileField, typ reflect.Type, suffix string, rows int) *Column {
+	c := &Column{
+		Name:   pf.Name + suffix,
+		Units:  pf.Units,
+		Values: make([]float64, rows),
+	}
+	switch typ {
+	case timeType:
+		c.Time = true
+		c.Units = "s"
+		return c
+	case latitudeType, longitudeType:
+		c.Units = "degrees"
+		return c
+	}
+	switch typ.Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return c
+	default:
+		return nil
+	}
+}
+
+var (
+	timeType      = reflect.TypeOf(time.Time{})
+	latitudeType  = reflect.TypeOf(fit.Latitude{})
+	longitudeType = reflect.TypeOf(fit.Longitude{})
+)
+
+// value returns the scaled value of v, which is a field or array element of
+// the field pf, or NaN if the value is invalid or not numeric.
+func value(pf fit.ProfileField, v reflect.Value) float64 {
+	switch x := v.Interface().(type) {
+	case time.Time:
+		if x.IsZero() || fit.IsBaseTime(x) {
+			return math.NaN()
+		}
+		return float64(x.Unix())
+	case fit.Latitude:
+		if x.Invalid() {
+			return math.NaN()
+		}
+		return x.Degrees()
+	case fit.Longitude:
+		if x.Invalid() {
+			return math.NaN()
+		}
+		return x.Degrees()
+	}
+
+	var f float64
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		f = v.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return math.NaN()
+		}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if pf.IsInvalid(v) {
+			return math.NaN()
+		}
+		f = float64(v.Int())
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if pf.IsInvalid(v) {
+			return math.NaN()
+		}
+		f = float64(v.Uint())
+	default:
+		return math.NaN()
+	}
+	if pf.Scale == 0 {
+		return f
+	}
+	// Equal to f/Scale - Offset, but avoids rounding errors for the
+	// common case of integral offsets, e.g. 132.2 instead of
+	// 132.20000000000005 for an altitude.
+	return (f - pf.Offset*pf.Scale) / pf.Scale
+}
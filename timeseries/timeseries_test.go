@@ -0,0 +1,480 @@
+package timeseries_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/csv"
+	"flag"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/timeseries"
+)
+
+var update = flag.Bool("update", false, "update .arrow golden files")
+
+func decodeFile(t *testing.T, path ...string) *fit.File {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join(append([]string{"..", "testdata"}, path...)...))
+	if err != nil {
+		t.Fatalf("reading file failed: %v", err)
+	}
+	file, err := fit.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("decode: got error, want none; error is: %v", err)
+	}
+	return file
+}
+
+func TestFromMessagesRecords(t *testing.T) {
+	activity, err := decodeFile(t, "dcrainmaker", "Edge810-Vector-2013-08-16-15-35-10.fit").Activity()
+	if err != nil {
+		t.Fatalf("activity: got error, want none; error is: %v", err)
+	}
+	table, err := timeseries.FromMessages(activity.Records)
+	if err != nil {
+		t.Fatalf("FromMessages: got error, want none; error is: %v", err)
+	}
+	if table.Mesg != "record" {
+		t.Errorf("got message %q, want record", table.Mesg)
+	}
+	if table.Len() != len(activity.Records) {
+		t.Fatalf("got %d rows, want %d", table.Len(), len(activity.Records))
+	}
+
+	tests := []struct {
+		name  string
+		units string
+		want  func(r *fit.RecordMsg) float64
+	}{
+		{"timestamp", "s", func(r *fit.RecordMsg) float64 { return float64(r.Timestamp.Unix()) }},
+		{"position_lat", "degrees", func(r *fit.RecordMsg) float64 {
+			if r.PositionLat.Invalid() {
+				return math.NaN()
+			}
+			return r.PositionLat.Degrees()
+		}},
+		{"speed", "m/s", (*fit.RecordMsg).GetSpeedScaled},
+		{"distance", "m", (*fit.RecordMsg).GetDistanceScaled},
+		{"heart_rate", "bpm", func(r *fit.RecordMsg) float64 {
+			if r.HeartRate == 0xFF {
+				return math.NaN()
+			}
+			return float64(r.HeartRate)
+		}},
+		{"power", "watts", func(r *fit.RecordMsg) float64 {
+			if r.Power == 0xFFFF {
+				return math.NaN()
+			}
+			return float64(r.Power)
+		}},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			c := table.Column(test.name)
+			if c == nil {
+				t.Fatalf("no column %q", test.name)
+			}
+			if c.Units != test.units {
+				t.Errorf("units: got %q, want %q", c.Units, test.units)
+			}
+			for i, r := range activity.Records {
+				want := test.want(r)
+				got := c.Values[i]
+				if got != want && !(math.IsNaN(got) && math.IsNaN(want)) {
+					t.Fatalf("row %d: got %v, want %v", i, got, want)
+				}
+			}
+		})
+	}
+
+	ts := table.Column("timestamp")
+	if !ts.Time {
+		t.Errorf("timestamp column is not a time column")
+	}
+	if got, want := ts.Times()[0], activity.Records[0].Timestamp; !got.Equal(want) {
+		t.Errorf("first time: got %v, want %v", got, want)
+	}
+	for _, c := range table.Columns {
+		valid := false
+		for _, v := range c.Values {
+			valid = valid || !math.IsNaN(v)
+		}
+		if !valid {
+			t.Errorf("column %q has no valid values", c.Name)
+		}
+	}
+}
+
+func TestFromMessagesHrv(t *testing.T) {
+	file := decodeFile(t, "bpg", "garmin.fit")
+	activity, err := file.Activity()
+	if err != nil {
+		t.Fatalf("activity: got error, want none; error is: %v", err)
+	}
+	table, err := timeseries.FromMessages(activity.Hrvs)
+	if err != nil {
+		t.Fatalf("FromMessages: got error, want none; error is: %v", err)
+	}
+	want := 0
+	for _, h := range activity.Hrvs {
+		for _, v := range h.Time {
+			if v != 0xFFFF {
+				want++
+			}
+		}
+	}
+	if table.Len() != want || len(table.Columns) != 2 {
+		t.Fatalf("got %d rows and %d columns, want %d rows and 2 columns", table.Len(), len(table.Columns), want)
+	}
+	elapsed, rr := table.Columns[0], table.Columns[1]
+	if elapsed.Name != "elapsed_time" || elapsed.Units != "s" || elapsed.Time {
+		t.Errorf("got first column %q (%s, time %t), want elapsed_time (s)", elapsed.Name, elapsed.Units, elapsed.Time)
+	}
+	if rr.Name != "time" || rr.Units != "s" || rr.Values[0] != float64(activity.Hrvs[0].Time[0])/1000 {
+		t.Errorf("got column %q (%s) starting with %v", rr.Name, rr.Units, rr.Values[0])
+	}
+	sum := 0.0
+	for i, v := range rr.Values {
+		sum += v
+		if math.Abs(elapsed.Values[i]-sum) > 1e-9 {
+			t.Fatalf("row %d: elapsed time: got %v, want %v", i, elapsed.Values[i], sum)
+		}
+	}
+}
+
+func TestFromMessagesArrays(t *testing.T) {
+	s := fit.NewSessionMsg()
+	s.TimeInHrZone = []uint32{1000, 0xFFFFFFFF, 3000}
+	table, err := timeseries.FromMessages([]fit.SessionMsg{*s, *fit.NewSessionMsg()})
+	if err != nil {
+		t.Fatalf("FromMessages: got error, want none; error is: %v", err)
+	}
+	var names []string
+	for _, c := range table.Columns {
+		names = append(names, c.Name)
+	}
+	if len(names) != 2 || names[0] != "time_in_hr_zone_0" || names[1] != "time_in_hr_zone_2" {
+		t.Fatalf("got columns %v, want time_in_hr_zone_0 and time_in_hr_zone_2", names)
+	}
+	if v := table.Columns[1].Values; v[0] != 3 || !math.IsNaN(v[1]) {
+		t.Errorf("time_in_hr_zone_2: got %v, want [3 NaN]", v)
+	}
+}
+
+func TestFromMessagesErrors(t *testing.T) {
+	for _, in := range []interface{}{nil, fit.NewRecordMsg(), []int{1}} {
+		if _, err := timeseries.FromMessages(in); err == nil {
+			t.Errorf("%T: got no error, want one", in)
+		}
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	activity, err := decodeFile(t, "fitsdk", "Activity.fit").Activity()
+	if err != nil {
+		t.Fatalf("activity: got error, want none; error is: %v", err)
+	}
+	table, err := timeseries.FromMessages(activity.Records)
+	if err != nil {
+		t.Fatalf("FromMessages: got error, want none; error is: %v", err)
+	}
+	buf := new(bytes.Buffer)
+	if err = table.WriteCSV(buf); err != nil {
+		t.Fatalf("WriteCSV: got error, want none; error is: %v", err)
+	}
+	records, err := csv.NewReader(buf).ReadAll()
+	if err != nil {
+		t.Fatalf("reading CSV: got error, want none; error is: %v", err)
+	}
+	if len(records) != 1+table.Len() {
+		t.Fatalf("got %d CSV records, want %d", len(records), 1+table.Len())
+	}
+	for i, c := range table.Columns {
+		if records[0][i] != c.Name {
+			t.Errorf("header %d: got %q, want %q", i, records[0][i], c.Name)
+		}
+		if c.Name == "timestamp" {
+			want := activity.Records[0].Timestamp.UTC().Format(time.RFC3339)
+			if records[1][i] != want {
+				t.Errorf("timestamp: got %q, want %q", records[1][i], want)
+			}
+		}
+		if c.Name == "speed" {
+			got, err := strconv.ParseFloat(records[1][i], 64)
+			if err != nil || got != activity.Records[0].GetSpeedScaled() {
+				t.Errorf("speed: got %q, want %v", records[1][i], activity.Records[0].GetSpeedScaled())
+			}
+		}
+	}
+}
+
+func TestWriteArrow(t *testing.T) {
+	activity, err := decodeFile(t, "dcrainmaker", "Edge810-Vector-2013-08-16-15-35-10.fit").Activity()
+	if err != nil {
+		t.Fatalf("activity: got error, want none; error is: %v", err)
+	}
+	table, err := timeseries.FromMessages(activity.Records)
+	if err != nil {
+		t.Fatalf("FromMessages: got error, want none; error is: %v", err)
+	}
+	buf := new(bytes.Buffer)
+	if err = table.WriteArrow(buf); err != nil {
+		t.Fatalf("WriteArrow: got error, want none; error is: %v", err)
+	}
+	data := buf.Bytes()
+
+	schema, _, data := readMessage(t, data)
+	if typ := schema.uint8(1); typ != 1 {
+		t.Fatalf("first message: got header type %d, want schema", typ)
+	}
+	fields := schema.table(2).vector(1)
+	if len(fields) != len(table.Columns) {
+		t.Fatalf("got %d fields, want %d", len(fields), len(table.Columns))
+	}
+	for i, f := range fields {
+		c := table.Columns[i]
+		if name := f.string(0); name != c.Name {
+			t.Errorf("field %d: got name %q, want %q", i, name, c.Name)
+		}
+		wantType := uint8(3)
+		if c.Time {
+			wantType = 10
+		}
+		if typ := f.uint8(2); typ != wantType {
+			t.Errorf("field %q: got type %d, want %d", c.Name, typ, wantType)
+		}
+		if c.Units != "" {
+			kv := f.vector(6)
+			if len(kv) != 1 || kv[0].string(0) != "units" || kv[0].string(1) != c.Units {
+				t.Errorf("field %q: units metadata missing", c.Name)
+			}
+		}
+	}
+
+	batch, body, data := readMessage(t, data)
+	if typ := batch.uint8(1); typ != 3 {
+		t.Fatalf("second message: got header type %d, want record batch", typ)
+	}
+	rb := batch.table(2)
+	if n := rb.int64(0); n != int64(table.Len()) {
+		t.Errorf("got batch length %d, want %d", n, table.Len())
+	}
+	nodes := rb.structs(1, 16)
+	buffers := rb.structs(2, 16)
+	if len(nodes) != len(table.Columns) || len(buffers) != 2*len(table.Columns) {
+		t.Fatalf("got %d nodes and %d buffers, want %d and %d",
+			len(nodes), len(buffers), len(table.Columns), 2*len(table.Columns))
+	}
+	for i, c := range table.Columns {
+		nulls := int(binary.LittleEndian.Uint64(nodes[i][8:]))
+		validity := bufferData(t, body, buffers[2*i])
+		values := bufferData(t, body, buffers[2*i+1])
+		wantNulls := 0
+		for j, v := range c.Values {
+			valid := len(validity) == 0 || validity[j/8]&(1<<(j%8)) != 0
+			if math.IsNaN(v) {
+				wantNulls++
+				if valid {
+					t.Fatalf("column %q, row %d: got valid, want null", c.Name, j)
+				}
+				continue
+			}
+			bits := binary.LittleEndian.Uint64(values[8*j:])
+			got := math.Float64frombits(bits)
+			if c.Time {
+				got = float64(int64(bits))
+			}
+			if !valid || got != v {
+				t.Fatalf("column %q, row %d: got %v (valid %t), want %v", c.Name, j, got, valid, v)
+			}
+		}
+		if nulls != wantNulls {
+			t.Errorf("column %q: got %d nulls, want %d", c.Name, nulls, wantNulls)
+		}
+	}
+
+	if !bytes.Equal(data, []byte{0xFF, 0xFF, 0xFF, 0xFF, 0, 0, 0, 0}) {
+		t.Errorf("got stream end %x, want end-of-stream marker", data)
+	}
+}
+
+// readMessage reads an encapsulated Arrow IPC message from data, and returns
+// the root table of the metadata, the body and the remaining data.
+func readMessage(t *testing.T, data []byte) (fbTable, []byte, []byte) {
+	t.Helper()
+	if len(data) < 8 || binary.LittleEndian.Uint32(data) != 0xFFFFFFFF {
+		t.Fatalf("missing continuation marker")
+	}
+	size := int(binary.LittleEndian.Uint32(data[4:]))
+	if size%8 != 0 {
+		t.Fatalf("metadata size %d is not a multiple of 8", size)
+	}
+	meta := data[8 : 8+size]
+	msg := fbTable{t: t, buf: meta, pos: int(binary.LittleEndian.Uint32(meta))}
+	if v := msg.int16(0); v != 4 {
+		t.Fatalf("got metadata version %d, want V5", v)
+	}
+	bodyLen := int(msg.int64(3))
+	if bodyLen%8 != 0 {
+		t.Fatalf("body length %d is not a multiple of 8", bodyLen)
+	}
+	data = data[8+size:]
+	return msg, data[:bodyLen], data[bodyLen:]
+}
+
+func bufferData(t *testing.T, body []byte, buf []byte) []byte {
+	t.Helper()
+	off := int(binary.LittleEndian.Uint64(buf))
+	n := int(binary.LittleEndian.Uint64(buf[8:]))
+	if off%8 != 0 || off+n > len(body) {
+		t.Fatalf("invalid buffer at offset %d with length %d", off, n)
+	}
+	return body[off : off+n]
+}
+
+// fbTable is a minimal FlatBuffers table reader that checks alignment.
+type fbTable struct {
+	t   *testing.T
+	buf []byte
+	pos int
+}
+
+func (tb fbTable) field(id, align int) (int, bool) {
+	tb.t.Helper()
+	if tb.pos%4 != 0 {
+		tb.t.Fatalf("table at %d is not aligned", tb.pos)
+	}
+	vt := tb.pos - int(int32(binary.LittleEndian.Uint32(tb.buf[tb.pos:])))
+	if 4+2*id >= int(binary.LittleEndian.Uint16(tb.buf[vt:])) {
+		return 0, false
+	}
+	off := int(binary.LittleEndian.Uint16(tb.buf[vt+4+2*id:]))
+	if off == 0 {
+		return 0, false
+	}
+	if (tb.pos+off)%align != 0 {
+		tb.t.Fatalf("field %d at %d is not aligned to %d", id, tb.pos+off, align)
+	}
+	return tb.pos + off, true
+}
+
+func (tb fbTable) uint8(id int) uint8 {
+	pos, ok := tb.field(id, 1)
+	if !ok {
+		return 0
+	}
+	return tb.buf[pos]
+}
+
+func (tb fbTable) int16(id int) int16 {
+	pos, ok := tb.field(id, 2)
+	if !ok {
+		return 0
+	}
+	return int16(binary.LittleEndian.Uint16(tb.buf[pos:]))
+}
+
+func (tb fbTable) int64(id int) int64 {
+	pos, ok := tb.field(id, 8)
+	if !ok {
+		return 0
+	}
+	return int64(binary.LittleEndian.Uint64(tb.buf[pos:]))
+}
+
+func (tb fbTable) deref(id int) int {
+	tb.t.Helper()
+	pos, ok := tb.field(id, 4)
+	if !ok {
+		tb.t.Fatalf("missing field %d", id)
+	}
+	return pos + int(binary.LittleEndian.Uint32(tb.buf[pos:]))
+}
+
+func (tb fbTable) table(id int) fbTable {
+	return fbTable{t: tb.t, buf: tb.buf, pos: tb.deref(id)}
+}
+
+func (tb fbTable) string(id int) string {
+	pos := tb.deref(id)
+	n := int(binary.LittleEndian.Uint32(tb.buf[pos:]))
+	return string(tb.buf[pos+4 : pos+4+n])
+}
+
+func (tb fbTable) vector(id int) []fbTable {
+	pos := tb.deref(id)
+	n := int(binary.LittleEndian.Uint32(tb.buf[pos:]))
+	tables := make([]fbTable, n)
+	for i := range tables {
+		elem := pos + 4 + 4*i
+		tables[i] = fbTable{t: tb.t, buf: tb.buf, pos: elem + int(binary.LittleEndian.Uint32(tb.buf[elem:]))}
+	}
+	return tables
+}
+
+func (tb fbTable) structs(id, size int) [][]byte {
+	pos := tb.deref(id)
+	if (pos+4)%8 != 0 {
+		tb.t.Fatalf("struct vector %d at %d is not aligned", id, pos)
+	}
+	n := int(binary.LittleEndian.Uint32(tb.buf[pos:]))
+	out := make([][]byte, n)
+	for i := range out {
+		out[i] = tb.buf[pos+4+size*i : pos+4+size*(i+1)]
+	}
+	return out
+}
+
+// TestWriteArrowGolden compares the output of WriteArrow with golden files.
+// The golden files have been read back and checked using the ipc package of
+// the Apache Arrow Go implementation.
+func TestWriteArrowGolden(t *testing.T) {
+	activity, err := decodeFile(t, "fitsdk", "Activity.fit").Activity()
+	if err != nil {
+		t.Fatalf("activity: got error, want none; error is: %v", err)
+	}
+	hrvActivity, err := decodeFile(t, "bpg", "garmin.fit").Activity()
+	if err != nil {
+		t.Fatalf("activity: got error, want none; error is: %v", err)
+	}
+	tests := []struct {
+		golden string
+		msgs   interface{}
+	}{
+		{"records.arrow", activity.Records},
+		{"hrv.arrow", hrvActivity.Hrvs[:10]},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.golden, func(t *testing.T) {
+			table, err := timeseries.FromMessages(test.msgs)
+			if err != nil {
+				t.Fatalf("FromMessages: got error, want none; error is: %v", err)
+			}
+			buf := new(bytes.Buffer)
+			if err = table.WriteArrow(buf); err != nil {
+				t.Fatalf("WriteArrow: got error, want none; error is: %v", err)
+			}
+			path := filepath.Join("testdata", test.golden)
+			if *update {
+				if err = os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+					t.Fatalf("updating golden file failed: %v", err)
+				}
+			}
+			want, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("reading golden file failed: %v", err)
+			}
+			if !bytes.Equal(buf.Bytes(), want) {
+				t.Errorf("output differs from golden file %s", path)
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package workout
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/tormoder/fit"
+)
+
+// jsonStep is the JSON form of a step, with durations, targets and
+// conditions written as in the text form parsed by Parse.
+type jsonStep struct {
+	Name      string `json:"name,omitempty"`
+	Notes     string `json:"notes,omitempty"`
+	Intensity string `json:"intensity,omitempty"`
+	Duration  string `json:"duration,omitempty"`
+	Target    string `json:"target,omitempty"`
+
+	// Repeat is the number of repeats, and Until the condition, of a
+	// repeat block.
+	Repeat uint32 `json:"repeat,omitempty"`
+	Until  string `json:"until,omitempty"`
+	Steps  []Step `json:"steps,omitempty"`
+}
+
+type jsonWorkout struct {
+	Name     string `json:"name"`
+	Sport    string `json:"sport"`
+	SubSport string `json:"sub_sport"`
+	Steps    []Step `json:"steps"`
+}
+
+// MarshalJSON implements json.Marshaler. An error is returned for
+// durations or targets that can not be written as text.
+func (s Step) MarshalJSON() ([]byte, error) {
+	js := jsonStep{Name: s.Name, Notes: s.Notes}
+	var err error
+	switch {
+	case !s.IsRepeat():
+		if js.Duration, err = formatDuration(s.Duration); err != nil {
+			return nil, err
+		}
+		if js.Target, err = formatTarget(s.Target); err != nil {
+			return nil, err
+		}
+		js.Intensity = intensityNames[s.Intensity]
+	case s.Until.Type == fit.WktStepDurationRepeatUntilStepsCmplt:
+		js.Repeat, js.Steps = s.Until.Value, s.Steps
+	default:
+		if js.Until, err = formatUntil(s.Until); err != nil {
+			return nil, err
+		}
+		js.Steps = s.Steps
+	}
+	return json.Marshal(js)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Steps without an intensity
+// are active.
+func (s *Step) UnmarshalJSON(data []byte) error {
+	var js jsonStep
+	if err := json.Unmarshal(data, &js); err != nil {
+		return err
+	}
+	switch {
+	case js.Repeat != 0 || js.Until != "":
+		if js.Repeat != 0 && js.Until != "" {
+			return errors.New("repeat block with both repeat count and condition")
+		}
+		if len(js.Steps) == 0 {
+			return errors.New("repeat block without steps")
+		}
+		if js.Repeat != 0 {
+			*s = Repeat(js.Repeat, js.Steps...)
+			break
+		}
+		until, err := parseDuration(js.Until)
+		if err != nil {
+			return err
+		}
+		*s = RepeatUntil(until, js.Steps...)
+		if !isRepeat(s.Until.Type) {
+			return fmt.Errorf("%q is not a repeat condition", js.Until)
+		}
+	default:
+		if js.Steps != nil {
+			return errors.New("steps without repeat count or condition")
+		}
+		*s = Step{Intensity: fit.IntensityActive, Target: NoTarget()}
+		if js.Intensity != "" {
+			i, found := intensityByName(js.Intensity)
+			if !found {
+				return fmt.Errorf("unknown intensity %q", js.Intensity)
+			}
+			s.Intensity = i
+		}
+		d, err := parseDuration(strings.TrimSuffix(js.Duration, " only"))
+		if err != nil {
+			return err
+		}
+		if isRepeat(d.Type) {
+			return fmt.Errorf("%q is only valid as a repeat condition", js.Duration)
+		}
+		if strings.HasSuffix(js.Duration, " only") {
+			if d.Type != fit.WktStepDurationTime {
+				return fmt.Errorf("invalid duration %q", js.Duration)
+			}
+			d.Type = fit.WktStepDurationTimeOnly
+		}
+		s.Duration = d
+		if js.Target != "" {
+			words := strings.Fields(js.Target)
+			var average string
+			switch len(words) {
+			case 1:
+			case 2:
+				average = strings.ToLower(words[0])
+			default:
+				return fmt.Errorf("invalid target %q", js.Target)
+			}
+			if s.Target, err = parseTarget(average, words[len(words)-1]); err != nil {
+				return err
+			}
+		}
+	}
+	s.Name, s.Notes = js.Name, js.Notes
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. Durations, targets and conditions
+// are written as in the text form parsed by Parse, and the sport and sub
+// sport by name, as in the JSON form of FIT files. An example:
+//
+//	{
+//		"name": "Threshold",
+//		"sport": "Cycling",
+//		"sub_sport": "Generic",
+//		"steps": [
+//			{"intensity": "warmup", "duration": "10m", "target": "Z2"},
+//			{"repeat": 5, "steps": [
+//				{"duration": "3m", "target": "300W"},
+//				{"intensity": "recovery", "duration": "2m", "notes": "Easy"}
+//			]},
+//			{"until": "hr>170bpm", "steps": [{"duration": "1km", "target": "PZ4"}]},
+//			{"intensity": "cooldown", "duration": "open"}
+//		]
+//	}
+func (w *Workout) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonWorkout{
+		Name:     w.Name,
+		Sport:    w.Sport.String(),
+		SubSport: w.SubSport.String(),
+		Steps:    w.Steps,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A missing sport or sub sport
+// is generic.
+func (w *Workout) UnmarshalJSON(data []byte) error {
+	var jw jsonWorkout
+	if err := json.Unmarshal(data, &jw); err != nil {
+		return err
+	}
+	*w = Workout{Name: jw.Name, Sport: fit.SportGeneric, SubSport: fit.SubSportGeneric, Steps: jw.Steps}
+	if jw.Sport != "" {
+		v, err := enumByName(jw.Sport, func(v uint8) string { return fit.Sport(v).String() })
+		if err != nil {
+			return fmt.Errorf("sport: %w", err)
+		}
+		w.Sport = fit.Sport(v)
+	}
+	if jw.SubSport != "" {
+		v, err := enumByName(jw.SubSport, func(v uint8) string { return fit.SubSport(v).String() })
+		if err != nil {
+			return fmt.Errorf("sub sport: %w", err)
+		}
+		w.SubSport = fit.SubSport(v)
+	}
+	return nil
+}
+
+// enumByName returns the value of a type with the given name, as returned
+// by the String method of the type.
+func enumByName(name string, str func(uint8) string) (uint8, error) {
+	for v := 0; v <= math.MaxUint8; v++ {
+		if str(uint8(v)) == name {
+			return uint8(v), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown name %q", name)
+}
@@ -0,0 +1,95 @@
+package workout_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/workout"
+)
+
+func TestJSON(t *testing.T) {
+	const data = `{
+		"name": "Threshold",
+		"sport": "Cycling",
+		"steps": [
+			{"intensity": "warmup", "duration": "10m", "target": "Z2"},
+			{"repeat": 5, "name": "Main", "steps": [
+				{"duration": "3m", "target": "290-310W"},
+				{"intensity": "recovery", "duration": "2m", "notes": "Easy"}
+			]},
+			{"until": "hr>170bpm", "steps": [{"duration": "10m only", "target": "lap PZ4"}]},
+			{"intensity": "cooldown", "duration": "open"}
+		]
+	}`
+	var w workout.Workout
+	if err := json.Unmarshal([]byte(data), &w); err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	lapPower := workout.PowerZone(4)
+	lapPower.Type = fit.WktStepTargetPowerLap
+	want := workout.New("Threshold", fit.SportCycling).Add(
+		workout.WarmUp(workout.Time(10*time.Minute), workout.HeartRateZone(2)),
+		workout.Repeat(5,
+			workout.Active(workout.Time(3*time.Minute), workout.PowerRange(workout.Watts(290), workout.Watts(310))),
+			workout.Recovery(workout.Time(2*time.Minute), workout.NoTarget()).WithNotes("Easy"),
+		).Named("Main"),
+		workout.RepeatUntil(workout.HeartRateAbove(workout.BPM(170)),
+			workout.Active(workout.TimeOnly(10*time.Minute), lapPower),
+		),
+		workout.CoolDown(workout.Open(), workout.NoTarget()),
+	)
+	if !reflect.DeepEqual(&w, want) {
+		t.Errorf("got:\n%+v\nwant:\n%+v", &w, want)
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	for _, name := range []string{"WorkoutRepeatSteps.fit", "WorkoutRepeatGreaterThanStep.fit", "WorkoutCustomTargetValues.fit"} {
+		t.Run(name, func(t *testing.T) {
+			wf := readWorkout(t, name)
+			w, err := workout.FromMessages(wf.Workout, wf.WorkoutSteps)
+			if err != nil {
+				t.Fatalf("got error, want none; error is: %v", err)
+			}
+			data, err := json.Marshal(w)
+			if err != nil {
+				t.Fatalf("got error, want none; error is: %v", err)
+			}
+			var got workout.Workout
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatalf("got error, want none; error is: %v", err)
+			}
+			if !reflect.DeepEqual(&got, w) {
+				t.Errorf("got:\n%+v\nwant:\n%+v\njson: %s", &got, w, data)
+			}
+		})
+	}
+}
+
+func TestJSONErrors(t *testing.T) {
+	tests := []string{
+		`{"sport": "Curling"}`,
+		`{"sub_sport": "Curling"}`,
+		`{"steps": [{"duration": "forever"}]}`,
+		`{"steps": [{"duration": "1m", "intensity": "hard"}]}`,
+		`{"steps": [{"duration": "1m", "target": "300"}]}`,
+		`{"steps": [{"duration": "1m", "target": "lap 300W extra"}]}`,
+		`{"steps": [{"duration": "1km only"}]}`,
+		`{"steps": [{"duration": "maxlappower<300W"}]}`,
+		`{"steps": [{"repeat": 2, "until": "1h", "steps": [{"duration": "1m"}]}]}`,
+		`{"steps": [{"repeat": 2}]}`,
+		`{"steps": [{"until": "open", "steps": [{"duration": "1m"}]}]}`,
+		`{"steps": [{"duration": "1m", "steps": [{"duration": "1m"}]}]}`,
+	}
+	for _, data := range tests {
+		t.Run(data, func(t *testing.T) {
+			var w workout.Workout
+			if err := json.Unmarshal([]byte(data), &w); err == nil {
+				t.Errorf("got no error, want error; workout is: %+v", w)
+			}
+		})
+	}
+}
@@ -0,0 +1,862 @@
+package workout
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+// Parse parses workout steps written as text, e.g.
+//
+//	warmup 10m @ Z2, 5x(3m @ 300W, recovery 2m @ 150W), cooldown 10m @ Z1
+//
+// Steps are separated by commas. A step is an optional intensity, a
+// duration, an optional target, optionally preceded by @, and an optional
+// name given as a quoted string:
+//
+//	[intensity] duration [[@] target] ["name"]
+//
+// Intensities are warmup, active, interval, recovery, rest, cooldown and
+// other. Steps without an intensity are active.
+//
+// Durations are written as:
+//
+//	1h30m, 3m, 45s             time, as parsed by time.ParseDuration
+//	10m only                   time, ignoring the lap button
+//	5km, 400mtr, 2mi, 100yd    distance; m is minutes, as for time
+//	300kcal                    calories
+//	open                       until the lap button is pressed
+//	12reps, 30s/rep            repetitions, and time per repetition
+//	50tss                      TrainingPeaks training stress score
+//	hr<140bpm, hr>85%          heart rate, in bpm or percent of max
+//	power<200W, power>105%     power, in watts or percent of FTP
+//	power3s>400W               3, 10 or 30 second average power
+//	lappower<250W              lap average power
+//
+// Targets are written as:
+//
+//	Z2, PZ3, SZ4, CZ2           heart rate, power, speed and cadence zones
+//	140-150bpm, 70-80%HR        heart rate range
+//	300W, 90-95%FTP             power range, a single value for both ends
+//	3.5-4m/s, 12-14km/h         speed range
+//	5:00-4:30/km, 8:00/mi       pace range, from the slowest pace
+//	85-95rpm                    cadence range
+//	grade:3, resistance:2-4     grade and resistance, as FIT values
+//	stroke:freestyle            swim stroke
+//	open                        no target, as an omitted target
+//
+// Heart rate, power and speed targets can be preceded by lap, for the
+// average over the lap, and power targets by 3s, 10s or 30s, for averages
+// over those times, e.g. "lap 250-260W".
+//
+// Repeat blocks are written as a count or a condition and parenthesized
+// steps, optionally followed by a name:
+//
+//	5x(3m @ 300W, 2m @ 150W)
+//	(1km @ PZ4, 2m) until 1h
+//	(3m @ PZ5, 3m) until hr>170bpm "Until tired"
+//
+// Conditions are the durations above with repeat counterparts: time,
+// distance, calories, training stress score, heart rate, power and lap
+// power below a value, and additionally maxlappower<300W, repeating until
+// the maximum power of the last lap is below a value.
+func Parse(text string) ([]Step, error) {
+	toks, err := tokenize(text)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{toks: toks, end: len(text)}
+	steps, err := p.steps()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.text != "" {
+		return nil, p.errorf(t, "unexpected %q", t.text)
+	}
+	return steps, nil
+}
+
+// Format formats workout steps as text parsed by Parse. Steps without an
+// intensity are formatted as active, and step notes are not formatted.
+// Speed targets are formatted in m/s, as FIT does not record whether they
+// were given as a pace, so 5:00-4:30/km is formatted as 3.333-3.704m/s.
+// An error is returned for durations or targets that can not be written as
+// text, such as unknown types.
+func Format(steps []Step) (string, error) {
+	var sb strings.Builder
+	if err := formatSteps(&sb, steps); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+func formatSteps(sb *strings.Builder, steps []Step) error {
+	for i, s := range steps {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		if err := formatStep(sb, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func formatStep(sb *strings.Builder, s Step) error {
+	if s.IsRepeat() {
+		if s.Until.Type == fit.WktStepDurationRepeatUntilStepsCmplt {
+			fmt.Fprintf(sb, "%dx(", s.Until.Value)
+			if err := formatSteps(sb, s.Steps); err != nil {
+				return err
+			}
+			sb.WriteString(")")
+		} else {
+			until, err := formatUntil(s.Until)
+			if err != nil {
+				return err
+			}
+			sb.WriteString("(")
+			if err := formatSteps(sb, s.Steps); err != nil {
+				return err
+			}
+			sb.WriteString(") until ")
+			sb.WriteString(until)
+		}
+	} else {
+		d, err := formatDuration(s.Duration)
+		if err != nil {
+			return err
+		}
+		t, err := formatTarget(s.Target)
+		if err != nil {
+			return err
+		}
+		if name, found := intensityNames[s.Intensity]; found && s.Intensity != fit.IntensityActive {
+			sb.WriteString(name)
+			sb.WriteString(" ")
+		}
+		sb.WriteString(d)
+		if t != "" {
+			sb.WriteString(" @ ")
+			sb.WriteString(t)
+		}
+	}
+	if s.Name != "" {
+		sb.WriteString(" ")
+		sb.WriteString(strconv.Quote(s.Name))
+	}
+	return nil
+}
+
+var intensityNames = map[fit.Intensity]string{
+	fit.IntensityWarmup:   "warmup",
+	fit.IntensityActive:   "active",
+	fit.IntensityInterval: "interval",
+	fit.IntensityRecovery: "recovery",
+	fit.IntensityRest:     "rest",
+	fit.IntensityCooldown: "cooldown",
+	fit.IntensityOther:    "other",
+}
+
+func intensityByName(name string) (fit.Intensity, bool) {
+	for i, n := range intensityNames {
+		if strings.EqualFold(name, n) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+type token struct {
+	pos  int
+	text string
+}
+
+// tokenize splits text into punctuation, quoted strings and words.
+func tokenize(text string) ([]token, error) {
+	var toks []token
+	for i := 0; i < len(text); {
+		c := text[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case strings.IndexByte(",()@", c) >= 0:
+			toks = append(toks, token{i, text[i : i+1]})
+			i++
+		case c == '"':
+			j := i + 1
+			for j < len(text) && text[j] != '"' {
+				if text[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j >= len(text) {
+				return nil, fmt.Errorf("offset %d: unterminated string", i)
+			}
+			toks = append(toks, token{i, text[i : j+1]})
+			i = j + 1
+		default:
+			j := i
+			for j < len(text) && strings.IndexByte(" \t\n\r,()@\"", text[j]) < 0 {
+				j++
+			}
+			toks = append(toks, token{i, text[i:j]})
+			i = j
+		}
+	}
+	return toks, nil
+}
+
+type parser struct {
+	toks []token
+	i    int
+	end  int
+}
+
+// peek returns the next token, or a token with empty text at the end.
+func (p *parser) peek() token {
+	if p.i < len(p.toks) {
+		return p.toks[p.i]
+	}
+	return token{pos: p.end}
+}
+
+func (p *parser) next() token {
+	t := p.peek()
+	if p.i < len(p.toks) {
+		p.i++
+	}
+	return t
+}
+
+func (p *parser) errorf(t token, format string, args ...interface{}) error {
+	return fmt.Errorf("offset %d: %s", t.pos, fmt.Sprintf(format, args...))
+}
+
+func isWord(t token) bool {
+	return t.text != "" && strings.IndexByte(",()@\"", t.text[0]) < 0
+}
+
+func (p *parser) expect(text string) error {
+	if t := p.next(); t.text != text {
+		if t.text == "" {
+			return p.errorf(t, "expected %q, got end of text", text)
+		}
+		return p.errorf(t, "expected %q, got %q", text, t.text)
+	}
+	return nil
+}
+
+func (p *parser) steps() ([]Step, error) {
+	var steps []Step
+	for {
+		s, err := p.step()
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, s)
+		if p.peek().text != "," {
+			return steps, nil
+		}
+		p.next()
+	}
+}
+
+func (p *parser) step() (Step, error) {
+	var s Step
+	t := p.peek()
+	switch {
+	case t.text == "(":
+		p.next()
+		steps, err := p.steps()
+		if err != nil {
+			return s, err
+		}
+		if err := p.expect(")"); err != nil {
+			return s, err
+		}
+		if err := p.expect("until"); err != nil {
+			return s, err
+		}
+		t = p.next()
+		d, err := parseDuration(t.text)
+		if err != nil {
+			return s, p.errorf(t, "%v", err)
+		}
+		s = RepeatUntil(d, steps...)
+		if !isRepeat(s.Until.Type) {
+			return s, p.errorf(t, "%q is not a repeat condition", t.text)
+		}
+	case isWord(t) && strings.HasSuffix(t.text, "x") && p.i+1 < len(p.toks) && p.toks[p.i+1].text == "(":
+		n, err := strconv.ParseUint(strings.TrimSuffix(t.text, "x"), 10, 32)
+		if err != nil || n == 0 {
+			return s, p.errorf(t, "invalid repeat count %q", t.text)
+		}
+		p.next()
+		p.next()
+		steps, err := p.steps()
+		if err != nil {
+			return s, err
+		}
+		if err := p.expect(")"); err != nil {
+			return s, err
+		}
+		s = Repeat(uint32(n), steps...)
+	default:
+		var err error
+		if s, err = p.plainStep(); err != nil {
+			return s, err
+		}
+	}
+
+	if t := p.peek(); strings.HasPrefix(t.text, `"`) {
+		p.next()
+		name, err := strconv.Unquote(t.text)
+		if err != nil {
+			return s, p.errorf(t, "invalid name %s", t.text)
+		}
+		s.Name = name
+	}
+	return s, nil
+}
+
+func (p *parser) plainStep() (Step, error) {
+	s := Step{Intensity: fit.IntensityActive, Target: NoTarget()}
+	t := p.next()
+	if !isWord(t) {
+		return s, p.errorf(t, "expected step, got %q", t.text)
+	}
+	if i, found := intensityByName(t.text); found {
+		s.Intensity = i
+		t = p.next()
+	}
+	var err error
+	if s.Duration, err = parseDuration(t.text); err != nil {
+		return s, p.errorf(t, "%v", err)
+	}
+	if isRepeat(s.Duration.Type) {
+		return s, p.errorf(t, "%q is only valid as a repeat condition", t.text)
+	}
+	if s.Duration.Type == fit.WktStepDurationTime && p.peek().text == "only" {
+		p.next()
+		s.Duration.Type = fit.WktStepDurationTimeOnly
+	}
+
+	at := p.peek().text == "@"
+	if at {
+		p.next()
+	}
+	t = p.peek()
+	if !isWord(t) {
+		if at {
+			return s, p.errorf(t, "expected target after @")
+		}
+		return s, nil
+	}
+	p.next()
+	var average string
+	if _, found := averages[strings.ToLower(t.text)]; found {
+		average = strings.ToLower(t.text)
+		t = p.next()
+	}
+	if s.Target, err = parseTarget(average, t.text); err != nil {
+		return s, p.errorf(t, "%v", err)
+	}
+	return s, nil
+}
+
+// conditions are the duration types with a quantity compared to a value.
+var conditions = []struct {
+	name          string
+	less, greater fit.WktStepDuration
+	hr            bool
+}{
+	{"hr", fit.WktStepDurationHrLessThan, fit.WktStepDurationHrGreaterThan, true},
+	{"power", fit.WktStepDurationPowerLessThan, fit.WktStepDurationPowerGreaterThan, false},
+	{"power3s", fit.WktStepDurationPower3sLessThan, fit.WktStepDurationPower3sGreaterThan, false},
+	{"power10s", fit.WktStepDurationPower10sLessThan, fit.WktStepDurationPower10sGreaterThan, false},
+	{"power30s", fit.WktStepDurationPower30sLessThan, fit.WktStepDurationPower30sGreaterThan, false},
+	{"lappower", fit.WktStepDurationPowerLapLessThan, fit.WktStepDurationPowerLapGreaterThan, false},
+	{"maxlappower", fit.WktStepDurationRepeatUntilMaxPowerLastLapLessThan, fit.WktStepDurationInvalid, false},
+}
+
+// distanceUnits are the distance units in meters.
+var distanceUnits = []struct {
+	suffix string
+	meters float64
+}{
+	{"km", 1000},
+	{"mtr", 1},
+	{"mi", 1609.344},
+	{"yd", 0.9144},
+}
+
+func parseDuration(s string) (Duration, error) {
+	ls := strings.ToLower(s)
+	if i := strings.IndexAny(ls, "<>"); i > 0 {
+		for _, c := range conditions {
+			if ls[:i] != c.name {
+				continue
+			}
+			t := c.less
+			if ls[i] == '>' {
+				t = c.greater
+			}
+			if t == fit.WktStepDurationInvalid {
+				return Duration{}, fmt.Errorf("invalid condition %q", s)
+			}
+			var v uint32
+			var err error
+			if c.hr {
+				v, err = parseValue(ls[i+1:], "bpm", "%", parseBPM, parsePercentMaxHR)
+			} else {
+				v, err = parseValue(ls[i+1:], "w", "%", parseWatts, parsePercentFTP)
+			}
+			if err != nil {
+				return Duration{}, err
+			}
+			return Duration{Type: t, Value: v}, nil
+		}
+		return Duration{}, fmt.Errorf("unknown condition %q", s)
+	}
+
+	switch {
+	case ls == "open":
+		return Open(), nil
+	case strings.HasSuffix(ls, "kcal"):
+		v, err := parseUint(strings.TrimSuffix(ls, "kcal"))
+		return Calories(v), err
+	case strings.HasSuffix(ls, "reps"):
+		v, err := parseUint(strings.TrimSuffix(ls, "reps"))
+		return Reps(v), err
+	case strings.HasSuffix(ls, "tss"):
+		v, err := parseUint(strings.TrimSuffix(ls, "tss"))
+		return TrainingPeaksTSS(v), err
+	case strings.HasSuffix(ls, "/rep"):
+		d, err := parseTime(strings.TrimSuffix(ls, "/rep"))
+		return RepetitionTime(d), err
+	}
+	for _, u := range distanceUnits {
+		if !strings.HasSuffix(ls, u.suffix) {
+			continue
+		}
+		f, err := strconv.ParseFloat(strings.TrimSuffix(ls, u.suffix), 64)
+		if err != nil || f < 0 || f*u.meters*100 > math.MaxUint32 {
+			return Duration{}, fmt.Errorf("invalid distance %q", s)
+		}
+		return Distance(f * u.meters), nil
+	}
+	d, err := parseTime(ls)
+	if err != nil {
+		return Duration{}, fmt.Errorf("unknown duration %q", s)
+	}
+	return Time(d), nil
+}
+
+func parseTime(s string) (time.Duration, error) {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, err
+	}
+	if d < 0 || d/time.Millisecond > math.MaxUint32 {
+		return 0, fmt.Errorf("invalid time %q", s)
+	}
+	return d, nil
+}
+
+func parseUint(s string) (uint32, error) {
+	v, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q", s)
+	}
+	return uint32(v), nil
+}
+
+// parseValue parses a value with one of two unit suffixes to a FIT value,
+// with the function for the unit.
+func parseValue(s, unit1, unit2 string, conv1, conv2 func(string) (uint32, error)) (uint32, error) {
+	conv := conv1
+	switch {
+	case strings.HasSuffix(s, unit1):
+		s = strings.TrimSuffix(s, unit1)
+	case strings.HasSuffix(s, unit2):
+		s, conv = strings.TrimSuffix(s, unit2), conv2
+	default:
+		return 0, fmt.Errorf("value %q without unit %s or %s", s, unit1, unit2)
+	}
+	return conv(s)
+}
+
+// parseBPM parses a heart rate in bpm. FIT has no value for 0 bpm, as it
+// would be read as 100% of max heart rate.
+func parseBPM(s string) (uint32, error) {
+	v, err := parseUint(s)
+	if err != nil {
+		return 0, err
+	}
+	if v == 0 {
+		return 0, fmt.Errorf("invalid heart rate %sbpm", s)
+	}
+	return uint32(BPM(v)), nil
+}
+
+func parsePercentMaxHR(s string) (uint32, error) {
+	v, err := parseUint(s)
+	return uint32(PercentMaxHR(v)), err
+}
+
+// parseWatts parses a power in watts. FIT has no value for 0 W, as it
+// would be read as 1000% of FTP.
+func parseWatts(s string) (uint32, error) {
+	v, err := parseUint(s)
+	if err != nil {
+		return 0, err
+	}
+	if v == 0 {
+		return 0, fmt.Errorf("invalid power %sW", s)
+	}
+	return uint32(Watts(v)), nil
+}
+
+func parsePercentFTP(s string) (uint32, error) {
+	v, err := parseUint(s)
+	return uint32(PercentFTP(v)), err
+}
+
+// formatUntil formats the condition of a repeat block, other than a
+// number of repeats.
+func formatUntil(d Duration) (string, error) {
+	if d.Type != fit.WktStepDurationRepeatUntilMaxPowerLastLapLessThan {
+		found := false
+		for t, rt := range repeatTypes {
+			if rt == d.Type {
+				d.Type, found = t, true
+				break
+			}
+		}
+		if !found {
+			return "", fmt.Errorf("invalid repeat condition %v", d.Type)
+		}
+	}
+	return formatDuration(d)
+}
+
+func formatDuration(d Duration) (string, error) {
+	v := d.Value
+	switch d.Type {
+	case fit.WktStepDurationTime:
+		return formatTime(v), nil
+	case fit.WktStepDurationTimeOnly:
+		return formatTime(v) + " only", nil
+	case fit.WktStepDurationRepetitionTime:
+		return formatTime(v) + "/rep", nil
+	case fit.WktStepDurationDistance:
+		if v%100000 == 0 {
+			return fmt.Sprintf("%dkm", v/100000), nil
+		}
+		return formatFloat(float64(v)/100) + "mtr", nil
+	case fit.WktStepDurationCalories:
+		return fmt.Sprintf("%dkcal", v), nil
+	case fit.WktStepDurationOpen:
+		return "open", nil
+	case fit.WktStepDurationReps:
+		return fmt.Sprintf("%dreps", v), nil
+	case fit.WktStepDurationTrainingPeaksTss:
+		return fmt.Sprintf("%dtss", v), nil
+	}
+	for _, c := range conditions {
+		op := "<"
+		switch d.Type {
+		case c.less:
+		case fit.WktStepDurationInvalid:
+			continue
+		case c.greater:
+			op = ">"
+		default:
+			continue
+		}
+		if c.hr {
+			return c.name + op + formatHR(fit.WorkoutHr(v), "%"), nil
+		}
+		return c.name + op + formatPower(fit.WorkoutPower(v), "W", "%"), nil
+	}
+	return "", fmt.Errorf("unknown duration type %v", d.Type)
+}
+
+// formatTime formats a time in milliseconds.
+func formatTime(ms uint32) string {
+	if ms == 0 {
+		return "0s"
+	}
+	var sb strings.Builder
+	if h := ms / 3600000; h > 0 {
+		fmt.Fprintf(&sb, "%dh", h)
+	}
+	if m := ms % 3600000 / 60000; m > 0 {
+		fmt.Fprintf(&sb, "%dm", m)
+	}
+	if s := ms % 60000; s > 0 {
+		sb.WriteString(formatFloat(float64(s) / 1000))
+		sb.WriteString("s")
+	}
+	return sb.String()
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func formatHR(hr fit.WorkoutHr, percent string) string {
+	if hr > fit.WorkoutHrBpmOffset {
+		return fmt.Sprintf("%dbpm", hr-fit.WorkoutHrBpmOffset)
+	}
+	return fmt.Sprintf("%d%s", hr, percent)
+}
+
+func formatPower(p fit.WorkoutPower, watts, percent string) string {
+	if p > fit.WorkoutPowerWattsOffset {
+		return fmt.Sprintf("%d%s", p-fit.WorkoutPowerWattsOffset, watts)
+	}
+	return fmt.Sprintf("%d%s", p, percent)
+}
+
+// averages maps the prefixes of averaged targets to the target types for
+// heart rate, power and speed targets.
+var averages = map[string]map[fit.WktStepTarget]fit.WktStepTarget{
+	"lap": {
+		fit.WktStepTargetHeartRate: fit.WktStepTargetHeartRateLap,
+		fit.WktStepTargetPower:     fit.WktStepTargetPowerLap,
+		fit.WktStepTargetSpeed:     fit.WktStepTargetSpeedLap,
+	},
+	"3s":  {fit.WktStepTargetPower: fit.WktStepTargetPower3s},
+	"10s": {fit.WktStepTargetPower: fit.WktStepTargetPower10s},
+	"30s": {fit.WktStepTargetPower: fit.WktStepTargetPower30s},
+}
+
+// zonePrefixes are the prefixes of zone targets, with z last as it is a
+// suffix of the others.
+var zonePrefixes = []struct {
+	prefix string
+	target fit.WktStepTarget
+}{
+	{"pz", fit.WktStepTargetPower},
+	{"sz", fit.WktStepTargetSpeed},
+	{"cz", fit.WktStepTargetCadence},
+	{"z", fit.WktStepTargetHeartRate},
+}
+
+// rangeUnits are the units of custom range targets, with functions
+// converting values to FIT values.
+var rangeUnits = []struct {
+	suffix string
+	target fit.WktStepTarget
+	conv   func(string) (uint32, error)
+}{
+	{"bpm", fit.WktStepTargetHeartRate, parseBPM},
+	{"%hr", fit.WktStepTargetHeartRate, parsePercentMaxHR},
+	{"%ftp", fit.WktStepTargetPower, parsePercentFTP},
+	{"w", fit.WktStepTargetPower, parseWatts},
+	{"rpm", fit.WktStepTargetCadence, parseUint},
+	{"m/s", fit.WktStepTargetSpeed, func(s string) (uint32, error) {
+		return parseSpeed(s, 1)
+	}},
+	{"km/h", fit.WktStepTargetSpeed, func(s string) (uint32, error) {
+		return parseSpeed(s, 1/3.6)
+	}},
+	{"/km", fit.WktStepTargetSpeed, func(s string) (uint32, error) {
+		return parsePace(s, 1000)
+	}},
+	{"/mi", fit.WktStepTargetSpeed, func(s string) (uint32, error) {
+		return parsePace(s, 1609.344)
+	}},
+}
+
+// rawTargets are the targets given by FIT values.
+var rawTargets = map[string]fit.WktStepTarget{
+	"grade":      fit.WktStepTargetGrade,
+	"resistance": fit.WktStepTargetResistance,
+}
+
+func parseTarget(average, s string) (Target, error) {
+	ls := strings.ToLower(s)
+	t, err := parseBaseTarget(ls)
+	if err != nil {
+		return t, err
+	}
+	if average != "" {
+		at, found := averages[average][t.Type]
+		if !found {
+			return t, fmt.Errorf("invalid %s target %q", average, s)
+		}
+		t.Type = at
+	}
+	return t, nil
+}
+
+func parseBaseTarget(s string) (Target, error) {
+	if s == "open" {
+		return NoTarget(), nil
+	}
+	if strings.HasPrefix(s, "stroke:") {
+		name := strings.TrimPrefix(s, "stroke:")
+		for st := fit.SwimStrokeFreestyle; st < fit.SwimStrokeInvalid; st++ {
+			if strings.EqualFold(st.String(), name) {
+				return zone(fit.WktStepTargetSwimStroke, uint32(st)), nil
+			}
+		}
+		return Target{}, fmt.Errorf("unknown swim stroke %q", name)
+	}
+	for name, rt := range rawTargets {
+		if strings.HasPrefix(s, name+":") {
+			return parseRange(strings.TrimPrefix(s, name+":"), rt, true, parseUint)
+		}
+	}
+	for _, z := range zonePrefixes {
+		if !strings.HasPrefix(s, z.prefix) {
+			continue
+		}
+		if v, err := strconv.ParseUint(strings.TrimPrefix(s, z.prefix), 10, 32); err == nil {
+			return zone(z.target, uint32(v)), nil
+		}
+	}
+	for _, u := range rangeUnits {
+		if strings.HasSuffix(s, u.suffix) {
+			return parseRange(strings.TrimSuffix(s, u.suffix), u.target, false, u.conv)
+		}
+	}
+	return Target{}, fmt.Errorf("unknown target %q", s)
+}
+
+// parseRange parses a range low-high or a single value, converting values
+// with conv. If zoned is set, a single value is a zone target.
+func parseRange(s string, t fit.WktStepTarget, zoned bool, conv func(string) (uint32, error)) (Target, error) {
+	parts := strings.Split(s, "-")
+	if len(parts) > 2 {
+		return Target{}, fmt.Errorf("invalid range %q", s)
+	}
+	low, err := conv(parts[0])
+	if err != nil {
+		return Target{}, err
+	}
+	if len(parts) == 1 {
+		if zoned {
+			return zone(t, low), nil
+		}
+		return custom(t, low, low), nil
+	}
+	high, err := conv(parts[1])
+	if err != nil {
+		return Target{}, err
+	}
+	return custom(t, low, high), nil
+}
+
+// parseSpeed parses a speed given in units of factor m/s.
+func parseSpeed(s string, factor float64) (uint32, error) {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || f < 0 || f*factor*1000 > math.MaxUint32 {
+		return 0, fmt.Errorf("invalid speed %q", s)
+	}
+	return uint32(math.Round(f * factor * 1000)), nil
+}
+
+// parsePace parses a pace given as m:ss per the given meters.
+func parsePace(s string, meters float64) (uint32, error) {
+	i := strings.IndexByte(s, ':')
+	if i < 0 {
+		return 0, fmt.Errorf("invalid pace %q", s)
+	}
+	m, err := strconv.ParseUint(s[:i], 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid pace %q", s)
+	}
+	sec, err := strconv.ParseFloat(s[i+1:], 64)
+	if err != nil || sec < 0 || sec >= 60 {
+		return 0, fmt.Errorf("invalid pace %q", s)
+	}
+	total := float64(m)*60 + sec
+	if total == 0 {
+		return 0, fmt.Errorf("invalid pace %q", s)
+	}
+	return uint32(math.Round(meters / total * 1000)), nil
+}
+
+func formatTarget(t Target) (string, error) {
+	var prefix string
+	for name, types := range averages {
+		for base, at := range types {
+			if t.Type == at {
+				prefix, t.Type = name+" ", base
+			}
+		}
+	}
+	isCustom := t.Value == 0 && t.Low != invalid && t.High != invalid
+
+	switch t.Type {
+	case fit.WktStepTargetOpen, fit.WktStepTargetInvalid:
+		return "", nil
+	case fit.WktStepTargetSwimStroke:
+		return "stroke:" + strings.ToLower(fit.SwimStroke(t.Value).String()), nil
+	case fit.WktStepTargetGrade, fit.WktStepTargetResistance:
+		for name, rt := range rawTargets {
+			if rt != t.Type {
+				continue
+			}
+			if !isCustom {
+				return fmt.Sprintf("%s:%d", name, t.Value), nil
+			}
+			return fmt.Sprintf("%s:%s", name, formatRange(strconv.FormatUint(uint64(t.Low), 10), strconv.FormatUint(uint64(t.High), 10), "")), nil
+		}
+	}
+
+	if !isCustom {
+		for _, z := range zonePrefixes {
+			if z.target == t.Type {
+				return fmt.Sprintf("%s%s%d", prefix, strings.ToUpper(z.prefix), t.Value), nil
+			}
+		}
+	}
+
+	var low, high, unit string
+	switch t.Type {
+	case fit.WktStepTargetHeartRate:
+		low, high = formatHR(fit.WorkoutHr(t.Low), "%HR"), formatHR(fit.WorkoutHr(t.High), "%HR")
+		low, high, unit = splitUnit(low, high, "bpm", "%HR")
+	case fit.WktStepTargetPower:
+		low, high = formatPower(fit.WorkoutPower(t.Low), "W", "%FTP"), formatPower(fit.WorkoutPower(t.High), "W", "%FTP")
+		low, high, unit = splitUnit(low, high, "W", "%FTP")
+	case fit.WktStepTargetSpeed:
+		low, high, unit = formatFloat(float64(t.Low)/1000), formatFloat(float64(t.High)/1000), "m/s"
+	case fit.WktStepTargetCadence:
+		low, high, unit = strconv.FormatUint(uint64(t.Low), 10), strconv.FormatUint(uint64(t.High), 10), "rpm"
+	default:
+		return "", fmt.Errorf("unknown target type %v", t.Type)
+	}
+	if unit == "" {
+		return "", fmt.Errorf("target range %s-%s with mixed units", low, high)
+	}
+	return prefix + formatRange(low, high, unit), nil
+}
+
+// splitUnit returns low and high without their common unit, one of unit1
+// and unit2. The unit is empty if they have different units.
+func splitUnit(low, high, unit1, unit2 string) (string, string, string) {
+	for _, u := range []string{unit2, unit1} {
+		if strings.HasSuffix(low, u) && strings.HasSuffix(high, u) {
+			return strings.TrimSuffix(low, u), strings.TrimSuffix(high, u), u
+		}
+	}
+	return low, high, ""
+}
+
+func formatRange(low, high, unit string) string {
+	if low == high {
+		return low + unit
+	}
+	return low + "-" + high + unit
+}
@@ -0,0 +1,207 @@
+package workout_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/workout"
+)
+
+func TestParse(t *testing.T) {
+	got, err := workout.Parse(`10m Z2, 5x(3m @ 300W, 2m @ 150W), 10m Z1`)
+	if err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	want := []workout.Step{
+		workout.Active(workout.Time(10*time.Minute), workout.HeartRateZone(2)),
+		workout.Repeat(5,
+			workout.Active(workout.Time(3*time.Minute), workout.PowerRange(workout.Watts(300), workout.Watts(300))),
+			workout.Active(workout.Time(2*time.Minute), workout.PowerRange(workout.Watts(150), workout.Watts(150))),
+		),
+		workout.Active(workout.Time(10*time.Minute), workout.HeartRateZone(1)),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got:\n%+v\nwant:\n%+v", got, want)
+	}
+
+	got, err = workout.Parse(`warmup 1h30m@lap 5:00-4:30/km "Easy", (interval 400mtr @ 30s PZ5, rest 90s) until hr>170bpm "Tired"`)
+	if err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	pace := workout.PaceRange(5*time.Minute, 4*time.Minute+30*time.Second)
+	pace.Type = fit.WktStepTargetSpeedLap
+	power := workout.PowerZone(5)
+	power.Type = fit.WktStepTargetPower30s
+	want = []workout.Step{
+		workout.WarmUp(workout.Time(90*time.Minute), pace).Named("Easy"),
+		workout.RepeatUntil(workout.HeartRateAbove(workout.BPM(170)),
+			workout.Interval(workout.Distance(400), power),
+			workout.Rest(workout.Time(90*time.Second), workout.NoTarget()),
+		).Named("Tired"),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got:\n%+v\nwant:\n%+v", got, want)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	// Canonical texts, formatted as parsed.
+	tests := []string{
+		`10m @ Z2, 5x(3m @ 300W, 2m @ 150W), 10m @ Z1`,
+		`warmup 1h30m15.5s, 10m only @ PZ3, 5km @ SZ4, 400.5mtr @ CZ2, 300kcal, cooldown open`,
+		`12reps "Squats", rest 30s/rep, 50tss, other 0s`,
+		`hr<140bpm @ 140-150bpm, hr>85% @ 70-80%HR, power<200W @ 90-95%FTP, power>105% @ 100%FTP`,
+		`power3s>400W @ 3s 390-410W, power10s<350W @ 10s PZ4, power30s>300W @ 30s 300W, lappower<250W @ lap 250-260W, lappower>90% @ lap Z3`,
+		`recovery 2m @ 3.5-4.167m/s, 2m @ lap 4.5m/s, 2m @ lap SZ2, 2m @ 85-95rpm`,
+		`interval 1m @ grade:3, 1m @ grade:2-4, 1m @ resistance:5, 100mtr @ stroke:freestyle, 100mtr @ stroke:im`,
+		`2x(3x(1km, 1m) "Inner", 5m) "Outer", (1km) until 1h, (1km) until 20km, (1km) until 500kcal, (1km) until 100tss`,
+		`(1m) until hr<120bpm, (1m) until hr>90%, (1m) until power<200W, (1m) until power>300W, (1m) until lappower<250W, (1m) until maxlappower<300W`,
+	}
+	for _, text := range tests {
+		t.Run(text, func(t *testing.T) {
+			steps, err := workout.Parse(text)
+			if err != nil {
+				t.Fatalf("Parse: got error, want none; error is: %v", err)
+			}
+			got, err := workout.Format(steps)
+			if err != nil {
+				t.Fatalf("Format: got error, want none; error is: %v", err)
+			}
+			if got != text {
+				t.Errorf("got:\n%s\nwant:\n%s", got, text)
+			}
+			if _, _, err := workout.New("", fit.SportGeneric).Add(steps...).Messages(); err != nil {
+				t.Errorf("Messages: got error, want none; error is: %v", err)
+			}
+		})
+	}
+}
+
+func TestFormatPace(t *testing.T) {
+	steps, err := workout.Parse(`1km @ 5:00-4:30/km`)
+	if err != nil {
+		t.Fatalf("Parse: got error, want none; error is: %v", err)
+	}
+	got, err := workout.Format(steps)
+	if err != nil {
+		t.Fatalf("Format: got error, want none; error is: %v", err)
+	}
+	if want := `1km @ 3.333-3.704m/s`; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestFormatSDK(t *testing.T) {
+	tests := []struct {
+		file string
+		text string
+	}{
+		{
+			"WorkoutIndividualSteps.fit",
+			`warmup 1m @ Z2 "_A_", 500mtr @ PZ5 "B1_", 500mtr @ PZ3 "B2_", cooldown hr<125bpm @ PZ1 "_C_"`,
+		},
+		{
+			"WorkoutRepeatSteps.fit",
+			`warmup 1m @ Z2 "_A_", 3x(500mtr @ PZ5 "B1_", 500mtr @ PZ3 "B2_") "Rep", cooldown hr<125bpm @ PZ1 "_C_"`,
+		},
+		{
+			"WorkoutRepeatGreaterThanStep.fit",
+			`warmup 1m @ Z2 "_A_", (500mtr @ PZ5 "B1_", 500mtr @ PZ3 "B2_") until hr>80% "Rep", cooldown hr<125bpm @ PZ1 "_C_"`,
+		},
+		{
+			"WorkoutCustomTargetValues.fit",
+			`warmup 1m @ 50-60%HR "_A_", 500mtr @ 300-310W "B1_", 500mtr @ 260-270W "B2_", cooldown hr<125bpm @ 220-230W "_C_"`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.file, func(t *testing.T) {
+			wf := readWorkout(t, test.file)
+			w, err := workout.FromMessages(wf.Workout, wf.WorkoutSteps)
+			if err != nil {
+				t.Fatalf("got error, want none; error is: %v", err)
+			}
+			got, err := workout.Format(w.Steps)
+			if err != nil {
+				t.Fatalf("got error, want none; error is: %v", err)
+			}
+			if got != test.text {
+				t.Errorf("got:\n%s\nwant:\n%s", got, test.text)
+			}
+
+			steps, err := workout.Parse(got)
+			if err != nil {
+				t.Fatalf("got error, want none; error is: %v", err)
+			}
+			if !reflect.DeepEqual(steps, w.Steps) {
+				t.Errorf("parsed:\ngot:  %+v\nwant: %+v", steps, w.Steps)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []string{
+		``,
+		`10m,`,
+		`10x`,
+		`warmup`,
+		`10 minutes`,
+		`10m @`,
+		`10m @ 300`,
+		`10m @ Z2 Z3`,
+		`10m @ 140bpm-80%HR`,
+		`10m @ lap 85rpm`,
+		`10m @ 3s Z2`,
+		`10m @ stroke:crawl`,
+		`10m @ 5:75/km`,
+		`1m @ 0W`,
+		`1m @ 0-200W`,
+		`1m @ 0bpm`,
+		`power<0W`,
+		`hr>0bpm`,
+		`hr<140`,
+		`cadence<90rpm`,
+		`maxlappower<300W`,
+		`maxlappower>300W`,
+		`open only`,
+		`0x(1m)`,
+		`3x(1m`,
+		`3x()`,
+		`(1m) until open`,
+		`(1m) 10m`,
+		`(1m)`,
+		`1m "name`,
+		`1m) `,
+		`-5km`,
+		`99999999h`,
+	}
+	for _, text := range tests {
+		t.Run(text, func(t *testing.T) {
+			if steps, err := workout.Parse(text); err == nil {
+				t.Errorf("got no error, want error; steps are: %+v", steps)
+			}
+		})
+	}
+}
+
+func TestFormatErrors(t *testing.T) {
+	mixed := workout.HeartRateRange(workout.BPM(140), workout.PercentMaxHR(80))
+	tests := []struct {
+		name string
+		step workout.Step
+	}{
+		{"mixed units", workout.Active(workout.Open(), mixed)},
+		{"unknown duration", workout.Active(workout.Duration{Type: fit.WktStepDurationInvalid}, workout.NoTarget())},
+		{"unknown target", workout.Active(workout.Open(), workout.Target{Type: 100})},
+		{"invalid repeat", workout.RepeatUntil(workout.Open(), workout.Active(workout.Open(), workout.NoTarget()))},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if text, err := workout.Format([]workout.Step{test.step}); err == nil {
+				t.Errorf("got no error, want error; text is: %s", text)
+			}
+		})
+	}
+}
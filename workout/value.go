@@ -0,0 +1,227 @@
+package workout
+
+import (
+	"math"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+// Duration is the duration of a workout step, or the condition ending a
+// repeat block. Value is the FIT duration value for the type, e.g.
+// milliseconds for fit.WktStepDurationTime. Use the functions below to
+// create durations from values in real units.
+type Duration struct {
+	Type  fit.WktStepDuration
+	Value uint32
+}
+
+// Time returns a duration of the given time.
+func Time(d time.Duration) Duration {
+	return Duration{Type: fit.WktStepDurationTime, Value: uint32(d / time.Millisecond)}
+}
+
+// Distance returns a duration of the given distance in meters.
+func Distance(meters float64) Duration {
+	return Duration{Type: fit.WktStepDurationDistance, Value: uint32(math.Round(meters * 100))}
+}
+
+// Calories returns a duration until the given calories are spent.
+func Calories(kcal uint32) Duration {
+	return Duration{Type: fit.WktStepDurationCalories, Value: kcal}
+}
+
+// Open returns a duration lasting until the lap button is pressed.
+func Open() Duration {
+	return Duration{Type: fit.WktStepDurationOpen}
+}
+
+// HeartRateBelow and HeartRateAbove return durations lasting until the
+// heart rate is below or above hr.
+func HeartRateBelow(hr fit.WorkoutHr) Duration {
+	return Duration{Type: fit.WktStepDurationHrLessThan, Value: uint32(hr)}
+}
+
+func HeartRateAbove(hr fit.WorkoutHr) Duration {
+	return Duration{Type: fit.WktStepDurationHrGreaterThan, Value: uint32(hr)}
+}
+
+// PowerBelow and PowerAbove return durations lasting until the power is
+// below or above p.
+func PowerBelow(p fit.WorkoutPower) Duration {
+	return Duration{Type: fit.WktStepDurationPowerLessThan, Value: uint32(p)}
+}
+
+func PowerAbove(p fit.WorkoutPower) Duration {
+	return Duration{Type: fit.WktStepDurationPowerGreaterThan, Value: uint32(p)}
+}
+
+// LapPowerBelow and LapPowerAbove return durations lasting until the
+// average power of the lap is below or above p. As the condition of a
+// repeat block, LapPowerBelow repeats until the average power of the last
+// lap is below p.
+func LapPowerBelow(p fit.WorkoutPower) Duration {
+	return Duration{Type: fit.WktStepDurationPowerLapLessThan, Value: uint32(p)}
+}
+
+func LapPowerAbove(p fit.WorkoutPower) Duration {
+	return Duration{Type: fit.WktStepDurationPowerLapGreaterThan, Value: uint32(p)}
+}
+
+// MaxLapPowerBelow returns the condition for repeating until the maximum
+// power of the last lap is below p. It is only valid for repeat blocks.
+func MaxLapPowerBelow(p fit.WorkoutPower) Duration {
+	return Duration{Type: fit.WktStepDurationRepeatUntilMaxPowerLastLapLessThan, Value: uint32(p)}
+}
+
+// TrainingPeaksTSS returns a duration until the given TrainingPeaks
+// training stress score is reached.
+func TrainingPeaksTSS(tss uint32) Duration {
+	return Duration{Type: fit.WktStepDurationTrainingPeaksTss, Value: tss}
+}
+
+// Reps returns a duration of the given number of repetitions, for strength
+// training.
+func Reps(n uint32) Duration {
+	return Duration{Type: fit.WktStepDurationReps, Value: n}
+}
+
+// RepetitionTime returns a duration of the given time per repetition, for
+// strength training.
+func RepetitionTime(d time.Duration) Duration {
+	return Duration{Type: fit.WktStepDurationRepetitionTime, Value: uint32(d / time.Millisecond)}
+}
+
+// TimeOnly returns a duration of the given time, ignoring the lap button.
+func TimeOnly(d time.Duration) Duration {
+	return Duration{Type: fit.WktStepDurationTimeOnly, Value: uint32(d / time.Millisecond)}
+}
+
+// repeatTypes maps step duration types to the repeat types with the same
+// condition.
+var repeatTypes = map[fit.WktStepDuration]fit.WktStepDuration{
+	fit.WktStepDurationTime:             fit.WktStepDurationRepeatUntilTime,
+	fit.WktStepDurationDistance:         fit.WktStepDurationRepeatUntilDistance,
+	fit.WktStepDurationCalories:         fit.WktStepDurationRepeatUntilCalories,
+	fit.WktStepDurationHrLessThan:       fit.WktStepDurationRepeatUntilHrLessThan,
+	fit.WktStepDurationHrGreaterThan:    fit.WktStepDurationRepeatUntilHrGreaterThan,
+	fit.WktStepDurationPowerLessThan:    fit.WktStepDurationRepeatUntilPowerLessThan,
+	fit.WktStepDurationPowerGreaterThan: fit.WktStepDurationRepeatUntilPowerGreaterThan,
+	fit.WktStepDurationPowerLapLessThan: fit.WktStepDurationRepeatUntilPowerLastLapLessThan,
+	fit.WktStepDurationTrainingPeaksTss: fit.WktStepDurationRepeatUntilTrainingPeaksTss,
+}
+
+// repeatTargets maps repeat types with a heart rate or power condition to
+// the target type of their repeat steps, as in the FIT SDK examples.
+var repeatTargets = map[fit.WktStepDuration]fit.WktStepTarget{
+	fit.WktStepDurationRepeatUntilHrLessThan:              fit.WktStepTargetHeartRate,
+	fit.WktStepDurationRepeatUntilHrGreaterThan:           fit.WktStepTargetHeartRate,
+	fit.WktStepDurationRepeatUntilPowerLessThan:           fit.WktStepTargetPower,
+	fit.WktStepDurationRepeatUntilPowerGreaterThan:        fit.WktStepTargetPower,
+	fit.WktStepDurationRepeatUntilPowerLastLapLessThan:    fit.WktStepTargetPower,
+	fit.WktStepDurationRepeatUntilMaxPowerLastLapLessThan: fit.WktStepTargetPower,
+}
+
+// isRepeat reports whether t is a repeat duration type.
+func isRepeat(t fit.WktStepDuration) bool {
+	if t == fit.WktStepDurationRepeatUntilStepsCmplt || t == fit.WktStepDurationRepeatUntilMaxPowerLastLapLessThan {
+		return true
+	}
+	for _, rt := range repeatTypes {
+		if t == rt {
+			return true
+		}
+	}
+	return false
+}
+
+// BPM and PercentMaxHR return heart rates in beats per minute and in percent
+// of the maximum heart rate.
+func BPM(bpm uint32) fit.WorkoutHr {
+	return fit.WorkoutHr(bpm) + fit.WorkoutHrBpmOffset
+}
+
+func PercentMaxHR(pct uint32) fit.WorkoutHr {
+	return fit.WorkoutHr(pct)
+}
+
+// Watts and PercentFTP return powers in watts and in percent of the
+// functional threshold power.
+func Watts(w uint32) fit.WorkoutPower {
+	return fit.WorkoutPower(w) + fit.WorkoutPowerWattsOffset
+}
+
+func PercentFTP(pct uint32) fit.WorkoutPower {
+	return fit.WorkoutPower(pct)
+}
+
+// Target is the target of a workout step: a zone given by Value, or a custom
+// range from Low to High. Values are FIT target values for the type, e.g.
+// fit.WorkoutPower values for fit.WktStepTargetPower. Use the functions
+// below to create targets from values in real units.
+type Target struct {
+	Type      fit.WktStepTarget
+	Value     uint32
+	Low, High uint32
+}
+
+const invalid = 0xFFFFFFFF
+
+// NoTarget returns an open target.
+func NoTarget() Target {
+	return Target{Type: fit.WktStepTargetOpen, Value: invalid, Low: invalid, High: invalid}
+}
+
+func zone(t fit.WktStepTarget, z uint32) Target {
+	return Target{Type: t, Value: z, Low: invalid, High: invalid}
+}
+
+func custom(t fit.WktStepTarget, low, high uint32) Target {
+	return Target{Type: t, Value: 0, Low: low, High: high}
+}
+
+// HeartRateZone and HeartRateRange return heart rate targets: a heart rate
+// zone, from 1 to 5, or a range of heart rates.
+func HeartRateZone(z uint32) Target {
+	return zone(fit.WktStepTargetHeartRate, z)
+}
+
+func HeartRateRange(low, high fit.WorkoutHr) Target {
+	return custom(fit.WktStepTargetHeartRate, uint32(low), uint32(high))
+}
+
+// PowerZone and PowerRange return power targets: a power zone, from 1 to
+// 7, or a range of powers.
+func PowerZone(z uint32) Target {
+	return zone(fit.WktStepTargetPower, z)
+}
+
+func PowerRange(low, high fit.WorkoutPower) Target {
+	return custom(fit.WktStepTargetPower, uint32(low), uint32(high))
+}
+
+// SpeedZone and SpeedRange return speed targets: a speed zone, from 1 to
+// 10, or a range of speeds in m/s.
+func SpeedZone(z uint32) Target {
+	return zone(fit.WktStepTargetSpeed, z)
+}
+
+func SpeedRange(low, high float64) Target {
+	return custom(fit.WktStepTargetSpeed, uint32(math.Round(low*1000)), uint32(math.Round(high*1000)))
+}
+
+// PaceRange returns a speed target for a range of paces, given as time per
+// kilometer, from the slowest to the fastest pace.
+func PaceRange(slowest, fastest time.Duration) Target {
+	return SpeedRange(1000/slowest.Seconds(), 1000/fastest.Seconds())
+}
+
+// CadenceZone and CadenceRange return cadence targets: a cadence zone, or a
+// range of cadences in rpm.
+func CadenceZone(z uint32) Target {
+	return zone(fit.WktStepTargetCadence, z)
+}
+
+func CadenceRange(low, high uint32) Target {
+	return custom(fit.WktStepTargetCadence, low, high)
+}
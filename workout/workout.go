@@ -0,0 +1,295 @@
+// Package workout builds workout files from steps given in real units, and
+// reads workouts from workout files.
+//
+// A workout is a list of steps, each with a duration, a target and an
+// intensity. Steps can be grouped in repeat blocks, which may be nested:
+//
+//	w := workout.New("5x3min", fit.SportCycling).Add(
+//		workout.WarmUp(workout.Time(10*time.Minute), workout.HeartRateZone(2)),
+//		workout.Repeat(5,
+//			workout.Active(workout.Time(3*time.Minute), workout.PowerRange(workout.Watts(290), workout.Watts(310))),
+//			workout.Recovery(workout.Time(2*time.Minute), workout.PowerZone(1)),
+//		),
+//		workout.CoolDown(workout.Open(), workout.NoTarget()),
+//	)
+//	file, err := w.File()
+//
+// In workout files, the steps of a repeat block are followed by a repeat
+// step, which refers back to the first step of the block by message index.
+// The repeat step holds the condition value as its target value, with a
+// heart rate or power target type for heart rate and power conditions.
+//
+// Steps can also be written as text, as parsed by Parse and written by
+// Format, e.g. "10m Z2, 5x(3m @ 300W, 2m @ 150W), 10m Z1", and workouts as
+// JSON.
+package workout
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+// Step is a workout step, or a repeat block if Steps is not nil.
+type Step struct {
+	Name      string
+	Notes     string
+	Intensity fit.Intensity
+	Duration  Duration
+	Target    Target
+
+	// Steps are the steps of a repeat block, and Until the condition
+	// ending the repeats, with a repeat duration type, e.g.
+	// fit.WktStepDurationRepeatUntilStepsCmplt with the number of
+	// repeats as value.
+	Steps []Step
+	Until Duration
+}
+
+// Named returns s with the given name.
+func (s Step) Named(name string) Step {
+	s.Name = name
+	return s
+}
+
+// WithNotes returns s with the given notes.
+func (s Step) WithNotes(notes string) Step {
+	s.Notes = notes
+	return s
+}
+
+// IsRepeat reports whether s is a repeat block.
+func (s Step) IsRepeat() bool {
+	return s.Steps != nil
+}
+
+func step(intensity fit.Intensity, d Duration, t Target) Step {
+	return Step{Intensity: intensity, Duration: d, Target: t}
+}
+
+// WarmUp, Active, Interval, Recovery, Rest and CoolDown return steps with the
+// given duration and target, and the intensity given by their names.
+func WarmUp(d Duration, t Target) Step {
+	return step(fit.IntensityWarmup, d, t)
+}
+
+func Active(d Duration, t Target) Step {
+	return step(fit.IntensityActive, d, t)
+}
+
+func Interval(d Duration, t Target) Step {
+	return step(fit.IntensityInterval, d, t)
+}
+
+func Recovery(d Duration, t Target) Step {
+	return step(fit.IntensityRecovery, d, t)
+}
+
+func Rest(d Duration, t Target) Step {
+	return step(fit.IntensityRest, d, t)
+}
+
+func CoolDown(d Duration, t Target) Step {
+	return step(fit.IntensityCooldown, d, t)
+}
+
+// Repeat returns a repeat block doing steps n times.
+func Repeat(n uint32, steps ...Step) Step {
+	return RepeatUntil(Duration{Type: fit.WktStepDurationRepeatUntilStepsCmplt, Value: n}, steps...)
+}
+
+// RepeatUntil returns a repeat block doing steps until the condition given
+// by until is met, e.g. RepeatUntil(HeartRateAbove(BPM(170)), steps...) or
+// RepeatUntil(Time(time.Hour), steps...). Conditions are step durations,
+// given by the functions creating them, or repeat durations.
+func RepeatUntil(until Duration, steps ...Step) Step {
+	if rt, found := repeatTypes[until.Type]; found {
+		until.Type = rt
+	}
+	if steps == nil {
+		steps = []Step{}
+	}
+	return Step{Intensity: fit.IntensityActive, Steps: steps, Until: until}
+}
+
+// Workout is a workout with a name, a sport and steps.
+type Workout struct {
+	Name     string
+	Sport    fit.Sport
+	SubSport fit.SubSport
+	Steps    []Step
+}
+
+// New returns a new workout with the given name and sport, and no steps.
+func New(name string, sport fit.Sport) *Workout {
+	return &Workout{Name: name, Sport: sport, SubSport: fit.SubSportGeneric}
+}
+
+// Add adds steps to the workout and returns the workout.
+func (w *Workout) Add(steps ...Step) *Workout {
+	w.Steps = append(w.Steps, steps...)
+	return w
+}
+
+// maxSteps is the maximum number of workout step messages, given by the
+// range of message indexes.
+const maxSteps = 0xFFFF
+
+// Messages returns the workout and workout step messages of the workout. An
+// error is returned if a repeat block has no steps or an invalid condition,
+// or a step has a repeat duration.
+func (w *Workout) Messages() (*fit.WorkoutMsg, []*fit.WorkoutStepMsg, error) {
+	msgs, err := appendSteps(nil, w.Steps)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(msgs) == 0 {
+		return nil, nil, errors.New("workout has no steps")
+	}
+	if len(msgs) > maxSteps {
+		return nil, nil, fmt.Errorf("workout has %d steps, more than %d", len(msgs), maxSteps)
+	}
+	wm := fit.NewWorkoutMsg()
+	wm.WktName = w.Name
+	wm.Sport = w.Sport
+	wm.SubSport = w.SubSport
+	wm.NumValidSteps = uint16(len(msgs))
+	return wm, msgs, nil
+}
+
+func appendSteps(msgs []*fit.WorkoutStepMsg, steps []Step) ([]*fit.WorkoutStepMsg, error) {
+	for _, s := range steps {
+		index := len(msgs)
+		if !s.IsRepeat() {
+			if isRepeat(s.Duration.Type) {
+				return nil, fmt.Errorf("step %d: repeat duration %v for step", index, s.Duration.Type)
+			}
+			msgs = append(msgs, stepMsg(index, s))
+			continue
+		}
+		if len(s.Steps) == 0 {
+			return nil, fmt.Errorf("step %d: repeat block without steps", index)
+		}
+		if !isRepeat(s.Until.Type) {
+			return nil, fmt.Errorf("step %d: invalid repeat condition %v", index, s.Until.Type)
+		}
+		var err error
+		msgs, err = appendSteps(msgs, s.Steps)
+		if err != nil {
+			return nil, err
+		}
+		rs := s
+		rs.Duration = Duration{Type: s.Until.Type, Value: uint32(index)}
+		rs.Target = NoTarget()
+		rs.Target.Value = s.Until.Value
+		if tt, found := repeatTargets[s.Until.Type]; found {
+			rs.Target.Type = tt
+		}
+		rm := stepMsg(len(msgs), rs)
+		msgs = append(msgs, rm)
+	}
+	return msgs, nil
+}
+
+func stepMsg(index int, s Step) *fit.WorkoutStepMsg {
+	m := fit.NewWorkoutStepMsg()
+	m.MessageIndex = fit.MessageIndex(index)
+	m.WktStepName = s.Name
+	m.Notes = s.Notes
+	m.Intensity = s.Intensity
+	m.DurationType = s.Duration.Type
+	m.DurationValue = s.Duration.Value
+	m.TargetType = s.Target.Type
+	m.TargetValue = s.Target.Value
+	m.CustomTargetValueLow = s.Target.Low
+	m.CustomTargetValueHigh = s.Target.High
+	return m
+}
+
+// File returns a new workout file for the workout, with the file id time
+// created set to the current time.
+func (w *Workout) File() (*fit.File, error) {
+	wm, steps, err := w.Messages()
+	if err != nil {
+		return nil, err
+	}
+	file, err := fit.NewFile(fit.FileTypeWorkout, fit.NewHeader(fit.V20, true))
+	if err != nil {
+		return nil, err
+	}
+	file.FileId.Manufacturer = fit.ManufacturerDevelopment
+	file.FileId.TimeCreated = time.Now().UTC().Truncate(time.Second)
+	wf, err := file.Workout()
+	if err != nil {
+		return nil, err
+	}
+	wf.Workout = wm
+	wf.WorkoutSteps = steps
+	return file, nil
+}
+
+// FromFile returns the workout of a workout file.
+func FromFile(file *fit.File) (*Workout, error) {
+	wf, err := file.Workout()
+	if err != nil {
+		return nil, err
+	}
+	if wf.Workout == nil {
+		return nil, errors.New("no workout message")
+	}
+	return FromMessages(wf.Workout, wf.WorkoutSteps)
+}
+
+// FromMessages returns the workout given by workout and workout step
+// messages, with repeat steps turned into repeat blocks. Step fields not
+// represented by Step, such as equipment and secondary targets, are not
+// kept. An error is returned if a repeat step does not refer to the first
+// step of a block of preceding steps.
+func FromMessages(wm *fit.WorkoutMsg, msgs []*fit.WorkoutStepMsg) (*Workout, error) {
+	// starts holds the index of the first step message of the steps of
+	// the workout built so far.
+	var (
+		steps  []Step
+		starts []int
+	)
+	for i, m := range msgs {
+		s := Step{
+			Name:      m.WktStepName,
+			Notes:     m.Notes,
+			Intensity: m.Intensity,
+		}
+		if !isRepeat(m.DurationType) {
+			s.Duration = Duration{Type: m.DurationType, Value: m.DurationValue}
+			s.Target = Target{
+				Type:  m.TargetType,
+				Value: m.TargetValue,
+				Low:   m.CustomTargetValueLow,
+				High:  m.CustomTargetValueHigh,
+			}
+			steps = append(steps, s)
+			starts = append(starts, i)
+			continue
+		}
+
+		from := int(m.DurationValue)
+		k := len(starts)
+		for k > 0 && starts[k-1] >= from {
+			k--
+		}
+		if k == len(starts) || starts[k] != from {
+			return nil, fmt.Errorf("step %d: repeat from step %d, which does not start a block", i, from)
+		}
+		s.Steps = append([]Step(nil), steps[k:]...)
+		s.Until = Duration{Type: m.DurationType, Value: m.TargetValue}
+		steps = append(steps[:k], s)
+		starts = append(starts[:k], from)
+	}
+	return &Workout{
+		Name:     wm.WktName,
+		Sport:    wm.Sport,
+		SubSport: wm.SubSport,
+		Steps:    steps,
+	}, nil
+}
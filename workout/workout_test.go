@@ -0,0 +1,244 @@
+package workout_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/workout"
+)
+
+func readWorkout(t *testing.T, name string) *fit.WorkoutFile {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("..", "testdata", "fitsdk", name))
+	if err != nil {
+		t.Fatalf("reading file failed: %v", err)
+	}
+	file, err := fit.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	wf, err := file.Workout()
+	if err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	return wf
+}
+
+// sdkWorkout returns a workout with the name of the FIT SDK example
+// workouts, which have no sport.
+func sdkWorkout(name string, steps ...workout.Step) *workout.Workout {
+	return &workout.Workout{
+		Name:     name,
+		Sport:    fit.SportInvalid,
+		SubSport: fit.SubSportInvalid,
+		Steps:    steps,
+	}
+}
+
+func TestMessagesSDK(t *testing.T) {
+	warmUp := workout.WarmUp(workout.Time(time.Minute), workout.HeartRateZone(2)).Named("_A_")
+	b1 := workout.Active(workout.Distance(500), workout.PowerZone(5)).Named("B1_")
+	b2 := workout.Active(workout.Distance(500), workout.PowerZone(3)).Named("B2_")
+	coolDown := workout.CoolDown(workout.HeartRateBelow(workout.BPM(125)), workout.PowerZone(1)).Named("_C_")
+
+	tests := []struct {
+		file string
+		w    *workout.Workout
+	}{
+		{
+			"WorkoutIndividualSteps.fit",
+			sdkWorkout("Example 1", warmUp, b1, b2, coolDown),
+		},
+		{
+			"WorkoutRepeatSteps.fit",
+			sdkWorkout("Example 2",
+				warmUp,
+				workout.Repeat(3, b1, b2).Named("Rep"),
+				coolDown,
+			),
+		},
+		{
+			"WorkoutRepeatGreaterThanStep.fit",
+			sdkWorkout("Example 2",
+				warmUp,
+				workout.RepeatUntil(workout.HeartRateAbove(workout.PercentMaxHR(80)), b1, b2).Named("Rep"),
+				coolDown,
+			),
+		},
+		{
+			"WorkoutCustomTargetValues.fit",
+			sdkWorkout("Example 1",
+				workout.WarmUp(workout.Time(time.Minute), workout.HeartRateRange(workout.PercentMaxHR(50), workout.PercentMaxHR(60))).Named("_A_"),
+				workout.Active(workout.Distance(500), workout.PowerRange(workout.Watts(300), workout.Watts(310))).Named("B1_"),
+				workout.Active(workout.Distance(500), workout.PowerRange(workout.Watts(260), workout.Watts(270))).Named("B2_"),
+				workout.CoolDown(workout.HeartRateBelow(workout.BPM(125)), workout.PowerRange(workout.Watts(220), workout.Watts(230))).Named("_C_"),
+			),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.file, func(t *testing.T) {
+			want := readWorkout(t, test.file)
+			wm, steps, err := test.w.Messages()
+			if err != nil {
+				t.Fatalf("got error, want none; error is: %v", err)
+			}
+
+			// The SDK examples set the number of valid steps to 4 for
+			// workouts with 5 steps.
+			if wm.NumValidSteps != uint16(len(steps)) {
+				t.Errorf("num valid steps: got %d, want %d", wm.NumValidSteps, len(steps))
+			}
+			wantWorkout := *want.Workout
+			wantWorkout.NumValidSteps = wm.NumValidSteps
+			if !reflect.DeepEqual(*wm, wantWorkout) {
+				t.Errorf("workout:\ngot:  %+v\nwant: %+v", *wm, wantWorkout)
+			}
+			if len(steps) != len(want.WorkoutSteps) {
+				t.Fatalf("got %d steps, want %d", len(steps), len(want.WorkoutSteps))
+			}
+			for i, s := range steps {
+				if !reflect.DeepEqual(s, want.WorkoutSteps[i]) {
+					t.Errorf("step %d:\ngot:  %+v\nwant: %+v", i, *s, *want.WorkoutSteps[i])
+				}
+			}
+
+			file, err := fit.NewFile(fit.FileTypeWorkout, fit.NewHeader(fit.V20, true))
+			if err != nil {
+				t.Fatalf("got error, want none; error is: %v", err)
+			}
+			wf, err := file.Workout()
+			if err != nil {
+				t.Fatalf("got error, want none; error is: %v", err)
+			}
+			wf.Workout, wf.WorkoutSteps = want.Workout, want.WorkoutSteps
+			read, err := workout.FromFile(file)
+			if err != nil {
+				t.Fatalf("FromFile: got error, want none; error is: %v", err)
+			}
+			if !reflect.DeepEqual(read, test.w) {
+				t.Errorf("FromFile:\ngot:  %+v\nwant: %+v", read, test.w)
+			}
+		})
+	}
+}
+
+func TestNestedRepeats(t *testing.T) {
+	w := workout.New("Nested", fit.SportRunning).Add(
+		workout.WarmUp(workout.Open(), workout.NoTarget()),
+		workout.Repeat(2,
+			workout.Repeat(4,
+				workout.Interval(workout.Distance(400), workout.PaceRange(4*time.Minute, 3*time.Minute+50*time.Second)),
+				workout.Recovery(workout.Time(90*time.Second), workout.NoTarget()),
+			),
+			workout.Rest(workout.Time(3*time.Minute), workout.HeartRateRange(workout.BPM(100), workout.BPM(130))),
+		),
+		workout.RepeatUntil(workout.Time(10*time.Minute),
+			workout.Active(workout.Distance(1000), workout.SpeedZone(3)),
+		),
+		workout.CoolDown(workout.Calories(50), workout.CadenceRange(80, 90)).WithNotes("Easy"),
+	)
+	_, steps, err := w.Messages()
+	if err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+
+	type step struct {
+		durationType  fit.WktStepDuration
+		durationValue uint32
+		targetValue   uint32
+	}
+	want := []step{
+		{fit.WktStepDurationOpen, 0, 0xFFFFFFFF},
+		{fit.WktStepDurationDistance, 40000, 0},
+		{fit.WktStepDurationTime, 90000, 0xFFFFFFFF},
+		{fit.WktStepDurationRepeatUntilStepsCmplt, 1, 4},
+		{fit.WktStepDurationTime, 180000, 0},
+		{fit.WktStepDurationRepeatUntilStepsCmplt, 1, 2},
+		{fit.WktStepDurationDistance, 100000, 3},
+		{fit.WktStepDurationRepeatUntilTime, 6, 600000},
+		{fit.WktStepDurationCalories, 50, 0},
+	}
+	var got []step
+	for i, s := range steps {
+		if s.MessageIndex != fit.MessageIndex(i) {
+			t.Errorf("step %d: got message index %v", i, s.MessageIndex)
+		}
+		got = append(got, step{s.DurationType, s.DurationValue, s.TargetValue})
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("steps:\ngot:  %v\nwant: %v", got, want)
+	}
+	if low, high := steps[1].CustomTargetValueLow, steps[1].CustomTargetValueHigh; low != 4167 || high != 4348 {
+		t.Errorf("pace range: got %d-%d mm/s, want 4167-4348", low, high)
+	}
+
+	// Encode and decode the file, and read back the workout.
+	file, err := w.File()
+	if err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := fit.Encode(&buf, file, binary.LittleEndian); err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	decoded, err := fit.Decode(&buf)
+	if err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	read, err := workout.FromFile(decoded)
+	if err != nil {
+		t.Fatalf("got error, want none; error is: %v", err)
+	}
+	if !reflect.DeepEqual(read, w) {
+		t.Errorf("read workout:\ngot:  %+v\nwant: %+v", read, w)
+	}
+}
+
+func TestMessagesErrors(t *testing.T) {
+	active := workout.Active(workout.Time(time.Minute), workout.NoTarget())
+	tests := []struct {
+		name  string
+		steps []workout.Step
+	}{
+		{"no steps", nil},
+		{"empty repeat", []workout.Step{workout.Repeat(3)}},
+		{"repeat duration for step", []workout.Step{
+			workout.Active(workout.MaxLapPowerBelow(workout.Watts(300)), workout.NoTarget()),
+		}},
+		{"invalid repeat condition", []workout.Step{
+			workout.RepeatUntil(workout.Open(), active),
+		}},
+		{"invalid nested repeat", []workout.Step{
+			workout.Repeat(2, active, workout.Repeat(2)),
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := workout.New(test.name, fit.SportGeneric).Add(test.steps...)
+			if _, _, err := w.Messages(); err == nil {
+				t.Error("got no error, want error")
+			}
+		})
+	}
+}
+
+func TestFromMessagesErrors(t *testing.T) {
+	steps := make([]*fit.WorkoutStepMsg, 3)
+	for i := range steps {
+		steps[i] = fit.NewWorkoutStepMsg()
+		steps[i].MessageIndex = fit.MessageIndex(i)
+		steps[i].DurationType = fit.WktStepDurationOpen
+	}
+	steps[2].DurationType = fit.WktStepDurationRepeatUntilStepsCmplt
+	steps[2].DurationValue = 5
+	if _, err := workout.FromMessages(fit.NewWorkoutMsg(), steps); err == nil {
+		t.Error("got no error, want error")
+	}
+}
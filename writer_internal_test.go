@@ -435,18 +435,24 @@ func TestGetEncodeMesgDef(t *testing.T) {
 				num:    0,
 				t:      types.Fit(0),
 				length: 1,
+				name:   "type",
+				scale:  1,
 			},
 			{
 				sindex: 1,
 				num:    1,
 				t:      types.Fit(4),
 				length: 1,
+				name:   "manufacturer",
+				scale:  1,
 			},
 			{
 				sindex: 2,
 				num:    2,
 				t:      types.Fit(4),
 				length: 1,
+				name:   "product",
+				scale:  1,
 			},
 		},
 	}
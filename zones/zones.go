@@ -0,0 +1,262 @@
+// Package zones computes the time spent in heart rate, speed, cadence and
+// power zones from the records of an activity.
+//
+// Zones are given by their high boundaries, like in the zone messages of a
+// sport file. Zone i contains values above the high boundary of zone i-1 up
+// to and including its own high boundary. The time in zone arrays of the
+// computed time in zone messages have one more element than the boundaries,
+// holding the time above the highest boundary.
+package zones
+
+import (
+	"math"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/internal/activity"
+)
+
+// maxGap is the longest time between two records that is counted towards
+// the zone of the first record. Longer gaps are counted as one second.
+const maxGap = 10 * time.Second
+
+// Boundaries holds the high boundaries of the zones of each kind, sorted in
+// increasing order. Kinds without boundaries are not computed.
+type Boundaries struct {
+	HeartRate []float64 // bpm
+	Speed     []float64 // m/s
+	Cadence   []float64 // rpm
+	Power     []float64 // watts
+}
+
+// Default zones as percentages of the reference value, from the lowest high
+// boundary.
+var (
+	percentMaxHr = []float64{50, 60, 70, 80, 90, 100}
+	percentLthr  = []float64{81, 89, 93, 99, 102, 106}
+	percentFtp   = []float64{55, 75, 90, 105, 120, 150}
+)
+
+// FromSport returns the zone boundaries of a sport file.
+func FromSport(s *fit.SportFile) Boundaries {
+	var b Boundaries
+	for _, z := range s.HrZones {
+		if z.HighBpm != 0xFF {
+			b.HeartRate = append(b.HeartRate, float64(z.HighBpm))
+		}
+	}
+	for _, z := range s.SpeedZones {
+		if v := z.GetHighValueScaled(); !math.IsNaN(v) {
+			b.Speed = append(b.Speed, v)
+		}
+	}
+	for _, z := range s.CadenceZones {
+		if z.HighValue != 0xFF {
+			b.Cadence = append(b.Cadence, float64(z.HighValue))
+		}
+	}
+	for _, z := range s.PowerZones {
+		if z.HighValue != 0xFFFF {
+			b.Power = append(b.Power, float64(z.HighValue))
+		}
+	}
+	return b
+}
+
+// FromZonesTarget returns heart rate and power zone boundaries derived from
+// the thresholds of a zones target message. Heart rate zones are
+// percentages of the threshold heart rate if the calculation type is
+// percent of lactate threshold, and of the maximum heart rate otherwise.
+// Power zones are percentages of the functional threshold power. Zones are
+// left empty if the needed threshold is invalid.
+func FromZonesTarget(zt *fit.ZonesTargetMsg) Boundaries {
+	var b Boundaries
+	switch {
+	case zt.HrCalcType == fit.HrZoneCalcPercentLthr && zt.ThresholdHeartRate != 0xFF:
+		b.HeartRate = percentages(percentLthr, float64(zt.ThresholdHeartRate))
+	case zt.MaxHeartRate != 0xFF:
+		b.HeartRate = percentages(percentMaxHr, float64(zt.MaxHeartRate))
+	}
+	if zt.FunctionalThresholdPower != 0xFFFF && zt.FunctionalThresholdPower != 0 {
+		b.Power = percentages(percentFtp, float64(zt.FunctionalThresholdPower))
+	}
+	return b
+}
+
+// FromActivity returns the zone boundaries derived from the first zones
+// target message of the activity. It reports false if the activity has no
+// zones target message with a usable threshold.
+func FromActivity(a *fit.ActivityFile) (Boundaries, bool) {
+	for _, zt := range a.ZoneTargets {
+		b := FromZonesTarget(zt)
+		if len(b.HeartRate) > 0 || len(b.Power) > 0 {
+			return b, true
+		}
+	}
+	return Boundaries{}, false
+}
+
+func percentages(percents []float64, ref float64) []float64 {
+	b := make([]float64, len(percents))
+	for i, p := range percents {
+		b[i] = math.Round(p * ref / 100)
+	}
+	return b
+}
+
+// Compute returns the time spent in each zone from start to end, inclusive.
+// Each record counts for the time until the next record, or one second for
+// the last record and for gaps longer than 10 seconds, but never beyond end
+// or the time the timer was stopped according to the events. Records while
+// the timer was stopped, and records with invalid values, are not counted.
+// Records must be sorted by time. The returned message has the timestamp set
+// to end, and the boundaries set for every kind with zones.
+func Compute(recs []*fit.RecordMsg, events []*fit.EventMsg, start, end time.Time, b Boundaries) *fit.TimeInZoneMsg {
+	periods := activity.TimerPeriods(events)
+
+	// limit returns the end of the running timer period containing t, or
+	// false if the timer was stopped at t.
+	limit := func(t time.Time) (time.Time, bool) {
+		if len(periods) == 0 {
+			return end, true
+		}
+		p, found := periods.Containing(t)
+		if !found {
+			return time.Time{}, false
+		}
+		if !p.End.IsZero() && p.End.Before(end) {
+			return p.End, true
+		}
+		return end, true
+	}
+
+	hr := newBuckets(b.HeartRate)
+	speed := newBuckets(b.Speed)
+	cad := newBuckets(b.Cadence)
+	power := newBuckets(b.Power)
+	for i, r := range recs {
+		if r.Timestamp.Before(start) {
+			continue
+		}
+		if r.Timestamp.After(end) {
+			break
+		}
+		stop, running := limit(r.Timestamp)
+		if !running {
+			continue
+		}
+		dt := time.Second
+		if i+1 < len(recs) {
+			if gap := recs[i+1].Timestamp.Sub(r.Timestamp); gap <= maxGap {
+				dt = gap
+			}
+		}
+		if max := stop.Sub(r.Timestamp); dt > max {
+			dt = max
+		}
+		if r.HeartRate != 0xFF {
+			hr.add(float64(r.HeartRate), dt)
+		}
+		if v := r.GetEnhancedSpeedScaled(); !math.IsNaN(v) {
+			speed.add(v, dt)
+		} else if v := r.GetSpeedScaled(); !math.IsNaN(v) {
+			speed.add(v, dt)
+		}
+		if r.Cadence != 0xFF {
+			cad.add(float64(r.Cadence), dt)
+		}
+		if r.Power != 0xFFFF {
+			power.add(float64(r.Power), dt)
+		}
+	}
+
+	msg := fit.NewTimeInZoneMsg()
+	msg.Timestamp = end
+	msg.TimeInHrZone = hr.times()
+	msg.TimeInSpeedZone = speed.times()
+	msg.TimeInCadenceZone = cad.times()
+	msg.TimeInPowerZone = power.times()
+	for _, v := range b.HeartRate {
+		msg.HrZoneHighBoundary = append(msg.HrZoneHighBoundary, uint8(activity.Raw(v, 1, 0, 0xFF)))
+	}
+	for _, v := range b.Speed {
+		msg.SpeedZoneHighBoundary = append(msg.SpeedZoneHighBoundary, uint16(activity.Raw(v, 1000, 0, 0xFFFF)))
+	}
+	for _, v := range b.Cadence {
+		msg.CadenceZoneHighBondary = append(msg.CadenceZoneHighBondary, uint8(activity.Raw(v, 1, 0, 0xFF)))
+	}
+	for _, v := range b.Power {
+		msg.PowerZoneHighBoundary = append(msg.PowerZoneHighBoundary, uint16(activity.Raw(v, 1, 0, 0xFFFF)))
+	}
+	return msg
+}
+
+// Laps computes the time in zones for every lap of an activity. The returned
+// messages reference the laps by message index.
+func Laps(a *fit.ActivityFile, b Boundaries) []*fit.TimeInZoneMsg {
+	msgs := make([]*fit.TimeInZoneMsg, len(a.Laps))
+	for i, l := range a.Laps {
+		msg := Compute(a.Records, a.Events, l.StartTime, activity.LapEnd(l), b)
+		msg.ReferenceMesg = fit.MesgNumLap
+		msg.ReferenceIndex = l.MessageIndex
+		msgs[i] = msg
+	}
+	return msgs
+}
+
+// Sessions computes the time in zones for every session of an activity. The
+// returned messages reference the sessions by message index.
+func Sessions(a *fit.ActivityFile, b Boundaries) []*fit.TimeInZoneMsg {
+	msgs := make([]*fit.TimeInZoneMsg, len(a.Sessions))
+	for i, s := range a.Sessions {
+		msg := Compute(a.Records, a.Events, s.StartTime, activity.SessionEnd(s), b)
+		msg.ReferenceMesg = fit.MesgNumSession
+		msg.ReferenceIndex = s.MessageIndex
+		msgs[i] = msg
+	}
+	return msgs
+}
+
+// buckets accumulates the time in zones for a set of boundaries.
+type buckets struct {
+	bounds []float64
+	dur    []time.Duration
+}
+
+func newBuckets(bounds []float64) *buckets {
+	if len(bounds) == 0 {
+		return &buckets{}
+	}
+	return &buckets{bounds: bounds, dur: make([]time.Duration, len(bounds)+1)}
+}
+
+func (b *buckets) add(v float64, dt time.Duration) {
+	if b.dur == nil {
+		return
+	}
+	b.dur[Zone(b.bounds, v)] += dt
+}
+
+// Zone returns the index of the zone containing v, given the high boundaries
+// of the zones sorted in increasing order. Values above the highest boundary
+// are in zone len(bounds).
+func Zone(bounds []float64, v float64) int {
+	i := 0
+	for i < len(bounds) && v > bounds[i] {
+		i++
+	}
+	return i
+}
+
+// times returns the time in each zone in milliseconds, or nil if there are
+// no zones.
+func (b *buckets) times() []uint32 {
+	if b.dur == nil {
+		return nil
+	}
+	t := make([]uint32, len(b.dur))
+	for i, d := range b.dur {
+		t[i] = uint32(activity.Raw(d.Seconds(), 1000, 0, 0xFFFFFFFF))
+	}
+	return t
+}
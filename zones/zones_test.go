@@ -0,0 +1,217 @@
+package zones_test
+
+import (
+	"bytes"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/zones"
+)
+
+func TestCompute(t *testing.T) {
+	start := time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)
+	at := func(secs int) time.Time { return start.Add(time.Duration(secs) * time.Second) }
+
+	var events []*fit.EventMsg
+	for _, ev := range []struct {
+		secs int
+		typ  fit.EventType
+	}{{0, fit.EventTypeStart}, {30, fit.EventTypeStop}, {40, fit.EventTypeStart}, {60, fit.EventTypeStopAll}} {
+		e := fit.NewEventMsg()
+		e.Timestamp = at(ev.secs)
+		e.Event = fit.EventTimer
+		e.EventType = ev.typ
+		events = append(events, e)
+	}
+
+	// A record every second: 120 bpm and 150 W for the first 20 seconds,
+	// then 160 bpm and 250 W. Heart rate is 190 bpm while stopped. The
+	// record at 50 seconds is missing.
+	var recs []*fit.RecordMsg
+	for i := 0; i < 60; i++ {
+		if i == 50 {
+			continue
+		}
+		r := fit.NewRecordMsg()
+		r.Timestamp = at(i)
+		r.HeartRate, r.Power = 120, 150
+		if i >= 20 {
+			r.HeartRate, r.Power = 160, 250
+		}
+		if i > 30 && i < 40 {
+			r.HeartRate = 190
+		}
+		recs = append(recs, r)
+	}
+
+	sport := &fit.SportFile{
+		HrZones: []*fit.HrZoneMsg{
+			{MessageIndex: 0, HighBpm: 100},
+			{MessageIndex: 1, HighBpm: 140},
+			{MessageIndex: 2, HighBpm: 180},
+		},
+		PowerZones: []*fit.PowerZoneMsg{
+			{MessageIndex: 0, HighValue: 200},
+		},
+	}
+	b := zones.FromSport(sport)
+	msg := zones.Compute(recs, events, at(0), at(60), b)
+
+	if got, want := msg.TimeInHrZone, []uint32{0, 20000, 30000, 0}; !reflect.DeepEqual(got, want) {
+		t.Errorf("time in hr zone: got %v, want %v", got, want)
+	}
+	if got, want := msg.TimeInPowerZone, []uint32{20000, 30000}; !reflect.DeepEqual(got, want) {
+		t.Errorf("time in power zone: got %v, want %v", got, want)
+	}
+	if msg.TimeInSpeedZone != nil || msg.TimeInCadenceZone != nil {
+		t.Errorf("got time in speed zone %v and cadence zone %v, want none", msg.TimeInSpeedZone, msg.TimeInCadenceZone)
+	}
+	if got, want := msg.HrZoneHighBoundary, []uint8{100, 140, 180}; !reflect.DeepEqual(got, want) {
+		t.Errorf("hr zone boundaries: got %v, want %v", got, want)
+	}
+	if got, want := msg.PowerZoneHighBoundary, []uint16{200}; !reflect.DeepEqual(got, want) {
+		t.Errorf("power zone boundaries: got %v, want %v", got, want)
+	}
+	if !msg.Timestamp.Equal(at(60)) {
+		t.Errorf("timestamp: got %v, want %v", msg.Timestamp, at(60))
+	}
+}
+
+func TestZone(t *testing.T) {
+	bounds := []float64{100, 150, 200}
+	for _, test := range []struct {
+		v    float64
+		want int
+	}{
+		{0, 0}, {100, 0}, {100.5, 1}, {150, 1}, {200, 2}, {201, 3},
+	} {
+		if got := zones.Zone(bounds, test.v); got != test.want {
+			t.Errorf("Zone(%v): got %d, want %d", test.v, got, test.want)
+		}
+	}
+	if got := zones.Zone(nil, 42); got != 0 {
+		t.Errorf("Zone without boundaries: got %d, want 0", got)
+	}
+}
+
+func TestFromZonesTarget(t *testing.T) {
+	tests := []struct {
+		name      string
+		target    fit.ZonesTargetMsg
+		heartRate []float64
+		power     []float64
+	}{
+		{
+			name: "max heart rate",
+			target: fit.ZonesTargetMsg{
+				MaxHeartRate:             200,
+				ThresholdHeartRate:       0xFF,
+				FunctionalThresholdPower: 300,
+				HrCalcType:               fit.HrZoneCalcPercentMaxHr,
+			},
+			heartRate: []float64{100, 120, 140, 160, 180, 200},
+			power:     []float64{165, 225, 270, 315, 360, 450},
+		},
+		{
+			name: "lactate threshold",
+			target: fit.ZonesTargetMsg{
+				MaxHeartRate:             200,
+				ThresholdHeartRate:       170,
+				FunctionalThresholdPower: 0xFFFF,
+				HrCalcType:               fit.HrZoneCalcPercentLthr,
+			},
+			heartRate: []float64{138, 151, 158, 168, 173, 180},
+		},
+		{
+			name: "no thresholds",
+			target: fit.ZonesTargetMsg{
+				MaxHeartRate:             0xFF,
+				ThresholdHeartRate:       0xFF,
+				FunctionalThresholdPower: 0xFFFF,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b := zones.FromZonesTarget(&test.target)
+			if !reflect.DeepEqual(b.HeartRate, test.heartRate) {
+				t.Errorf("heart rate: got %v, want %v", b.HeartRate, test.heartRate)
+			}
+			if !reflect.DeepEqual(b.Power, test.power) {
+				t.Errorf("power: got %v, want %v", b.Power, test.power)
+			}
+		})
+	}
+}
+
+func TestLapsAndSessions(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "testdata", "dcrainmaker", "Edge810-Vector-2013-08-16-15-35-10.fit"))
+	if err != nil {
+		t.Fatalf("reading file failed: %v", err)
+	}
+	file, err := fit.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("decode: got error, want none; error is: %v", err)
+	}
+	activity, err := file.Activity()
+	if err != nil {
+		t.Fatalf("activity: got error, want none; error is: %v", err)
+	}
+	if _, ok := zones.FromActivity(activity); ok {
+		t.Fatalf("FromActivity: got zones for activity without zones target")
+	}
+
+	b := zones.Boundaries{
+		HeartRate: []float64{120, 140, 160, 180},
+		Speed:     []float64{5, 8.5, 11},
+		Cadence:   []float64{70, 90},
+		Power:     []float64{150, 250, 350},
+	}
+	sessions := zones.Sessions(activity, b)
+	if len(sessions) != 1 {
+		t.Fatalf("got %d sessions, want 1", len(sessions))
+	}
+	msg := sessions[0]
+	if msg.ReferenceMesg != fit.MesgNumSession || msg.ReferenceIndex != activity.Sessions[0].MessageIndex {
+		t.Errorf("got reference %v %d, want session %d", msg.ReferenceMesg, msg.ReferenceIndex, activity.Sessions[0].MessageIndex)
+	}
+
+	// The device records every second, so the time in zones should add up
+	// to the timer time.
+	timer := activity.Sessions[0].GetTotalTimerTimeScaled()
+	for name, times := range map[string][]float64{
+		"hr":    msg.GetTimeInHrZoneScaled(),
+		"power": msg.GetTimeInPowerZoneScaled(),
+	} {
+		total := 0.0
+		for _, v := range times {
+			total += v
+		}
+		if math.Abs(total-timer) > 0.01*timer {
+			t.Errorf("%s: got total time %v, want about timer time %v", name, total, timer)
+		}
+	}
+
+	laps := zones.Laps(activity, b)
+	if len(laps) != len(activity.Laps) {
+		t.Fatalf("got %d laps, want %d", len(laps), len(activity.Laps))
+	}
+	var lapTotal uint32
+	for _, l := range laps {
+		for _, v := range l.TimeInPowerZone {
+			lapTotal += v
+		}
+	}
+	var sessionTotal uint32
+	for _, v := range msg.TimeInPowerZone {
+		sessionTotal += v
+	}
+	if d := math.Abs(float64(lapTotal) - float64(sessionTotal)); d > 0.01*float64(sessionTotal) {
+		t.Errorf("got total lap time in power zones %d ms, session %d ms", lapTotal, sessionTotal)
+	}
+}